@@ -2,16 +2,21 @@ package abci
 
 import (
 	"fmt"
+	"time"
 
 	"cosmossdk.io/log"
 	abci "github.com/cometbft/cometbft/abci/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 
 	"github.com/skip-mev/block-sdk/v2/block"
+	"github.com/skip-mev/block-sdk/v2/block/metrics"
 	"github.com/skip-mev/block-sdk/v2/block/proposals"
-	"github.com/skip-mev/block-sdk/v2/block/utils"
+	proposalstypes "github.com/skip-mev/block-sdk/v2/block/proposals/types"
 )
 
 type (
@@ -23,9 +28,75 @@ type (
 		txEncoder                sdk.TxEncoder
 		mempool                  block.Mempool
 		useCustomProcessProposal bool
+		useShadowProcessProposal bool
+		useProposalInfo          bool
+		metrics                  metrics.Metrics
+		tracer                   trace.Tracer
 	}
+
+	// ProposalHandlerOption defines a function that can be used to configure the
+	// proposal handler.
+	ProposalHandlerOption func(*ProposalHandler)
 )
 
+// WithProposalInfo configures the proposal handler to include the proposal info (see
+// proposals.ProposalInfo) in the first slot of every proposal it prepares. When processing
+// a proposal, the proposal info is stripped from the proposal and the proposal is rejected
+// if the number of transactions included by each lane, the block size or the gas limit
+// declared in the proposal info do not match the verified proposal.
+//
+// NOTE: All validators on the network must use the same setting. The proposal info is only
+// verified if the proposal handler uses custom process proposal logic. The proposal info
+// is not a valid transaction and fails to decode when the block is finalized, so the
+// application's FinalizeBlock must be wrapped with FinalizeBlockHandler to report it as a
+// successful transaction.
+func WithProposalInfo() ProposalHandlerOption {
+	return func(h *ProposalHandler) {
+		h.useProposalInfo = true
+	}
+}
+
+// WithShadowProcessProposal configures the proposal handler to verify every proposal in full
+// (exactly as with custom process proposal logic) but to always accept it. Proposals that
+// would have been rejected are reported with structured logs and metrics that identify the
+// lane, the index of the offending transaction and the invariant that was violated. This
+// can be used to measure how often proposals would be rejected before enabling custom
+// process proposal logic.
+//
+// NOTE: This takes precedence over the useCustomProcessProposal setting of the proposal
+// handler, i.e. proposals are never rejected in shadow mode.
+func WithShadowProcessProposal() ProposalHandlerOption {
+	return func(h *ProposalHandler) {
+		h.useShadowProcessProposal = true
+	}
+}
+
+// WithMetrics sets the metrics the proposal handler reports to (e.g. the latency of
+// preparing and processing proposals and the lanes that failed to prepare their partial
+// proposals). By default, the proposal handler reports to the Cosmos SDK telemetry.
+func WithMetrics(m metrics.Metrics) ProposalHandlerOption {
+	return func(h *ProposalHandler) {
+		if m == nil {
+			panic("metrics cannot be nil")
+		}
+
+		h.metrics = m
+	}
+}
+
+// WithTracer sets the OpenTelemetry tracer used to trace the preparation and verification of
+// proposals. Each lane's partial proposal is traced in a child span, using the lane's own
+// tracer (see base.LaneConfig). By default, proposals are not traced.
+func WithTracer(tracer trace.Tracer) ProposalHandlerOption {
+	return func(h *ProposalHandler) {
+		if tracer == nil {
+			panic("tracer cannot be nil")
+		}
+
+		h.tracer = tracer
+	}
+}
+
 // NewDefaultProposalHandler returns a new ABCI++ proposal handler. This proposal handler will
 // iteratively call each of the lanes in the chain to prepare and process the proposal. This
 // will not use custom process proposal logic.
@@ -34,14 +105,9 @@ func NewDefaultProposalHandler(
 	txDecoder sdk.TxDecoder,
 	txEncoder sdk.TxEncoder,
 	mempool block.Mempool,
+	opts ...ProposalHandlerOption,
 ) *ProposalHandler {
-	return &ProposalHandler{
-		logger:                   logger,
-		txDecoder:                txDecoder,
-		txEncoder:                txEncoder,
-		mempool:                  mempool,
-		useCustomProcessProposal: false,
-	}
+	return New(logger, txDecoder, txEncoder, mempool, false, opts...)
 }
 
 // New returns a new ABCI++ proposal handler with the ability to use custom process proposal logic.
@@ -54,14 +120,29 @@ func New(
 	txEncoder sdk.TxEncoder,
 	mempool block.Mempool,
 	useCustomProcessProposal bool,
+	opts ...ProposalHandlerOption,
 ) *ProposalHandler {
-	return &ProposalHandler{
+	h := &ProposalHandler{
 		logger:                   logger,
 		txDecoder:                txDecoder,
 		txEncoder:                txEncoder,
 		mempool:                  mempool,
 		useCustomProcessProposal: useCustomProcessProposal,
+		metrics:                  metrics.NewTelemetryMetrics(),
+		tracer:                   noop.NewTracerProvider().Tracer(""),
 	}
+
+	for _, opt := range opts {
+		opt(h)
+	}
+
+	return h
+}
+
+// UsesProposalInfo returns true if the proposal handler includes the proposal info in the
+// first slot of every proposal (see WithProposalInfo).
+func (h *ProposalHandler) UsesProposalInfo() bool {
+	return h.useProposalInfo
 }
 
 // PrepareProposalHandler prepares the proposal by selecting transactions from each lane
@@ -76,33 +157,73 @@ func (h *ProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHandler {
 			return &abci.ResponsePrepareProposal{Txs: req.Txs}, nil
 		}
 
+		ctx, span := h.startSpan(ctx, "PrepareProposal", req.Height, len(req.Txs))
+		defer func() {
+			if resp != nil {
+				span.SetAttributes(attribute.Int("num_proposal_txs", len(resp.Txs)))
+			}
+
+			endSpan(span, err)
+		}()
+
 		// In the case where there is a panic, we recover here and return an empty proposal.
 		defer func() {
 			if rec := recover(); rec != nil {
 				h.logger.Error("failed to prepare proposal", "err", err)
 
-				// TODO: Should we attempt to return a empty proposal here with empty proposal info?
-				resp = &abci.ResponsePrepareProposal{Txs: make([][]byte, 0)}
+				resp = h.emptyPrepareProposalResponse(ctx, req)
 				err = fmt.Errorf("failed to prepare proposal: %v", rec)
 			}
 		}()
 
+		defer func(start time.Time) {
+			h.metrics.ObservePrepareProposalLatency(time.Since(start))
+		}(time.Now())
+
+		distribution := h.mempool.GetTxDistribution()
+		for lane, numTxs := range distribution {
+			h.metrics.SetLaneSize(lane, int(numTxs))
+		}
+
 		h.logger.Info(
 			"mempool distribution before proposal creation",
-			"distribution", h.mempool.GetTxDistribution(),
+			"distribution", distribution,
 			"height", req.Height,
 		)
 
+		// Retrieve the lanes, ordered and configured according to the lane configurations
+		// stored on-chain (if any).
+		lanes := h.mempool.GetProposalLanes(ctx)
+
 		// Get the max gas limit and max block size for the proposal.
 		_, maxGasLimit := proposals.GetBlockLimits(ctx)
-		proposal := proposals.NewProposal(h.logger, req.MaxTxBytes, maxGasLimit)
+		maxBlockSize, err := h.getMaxBlockSize(req.MaxTxBytes, lanes.Lanes)
+		if err != nil {
+			h.logger.Error("failed to get max block size", "err", err)
+			return h.emptyPrepareProposalResponse(ctx, req), err
+		}
+
+		proposal := lanes.NewProposal(h.logger, maxBlockSize, maxGasLimit)
 
 		// Fill the proposal with transactions from each lane.
-		prepareLanesHandler := ChainPrepareLanes(h.mempool.Registry())
+		prepareLanesHandler := chainPrepareLanes(lanes.Lanes, h.metrics)
 		finalProposal, err := prepareLanesHandler(ctx, proposal)
 		if err != nil {
 			h.logger.Error("failed to prepare proposal", "err", err)
-			return &abci.ResponsePrepareProposal{Txs: make([][]byte, 0)}, err
+			return h.emptyPrepareProposalResponse(ctx, req), err
+		}
+
+		// Report the depth of each lane's mempool before the proposal was built, e.g. to be
+		// used as a demand signal by the adaptive allocation policy.
+		finalProposal.Info.MempoolDepthByLane = make(map[string]uint64, len(lanes.Lanes))
+		for _, lane := range lanes.Lanes {
+			finalProposal.Info.MempoolDepthByLane[lane.Name()] = distribution[lane.Name()]
+		}
+
+		txs, err := h.getProposalTxs(finalProposal)
+		if err != nil {
+			h.logger.Error("failed to get proposal txs", "err", err)
+			return h.emptyPrepareProposalResponse(ctx, req), err
 		}
 
 		h.logger.Info(
@@ -122,7 +243,7 @@ func (h *ProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHandler {
 		)
 
 		return &abci.ResponsePrepareProposal{
-			Txs: finalProposal.Txs,
+			Txs: txs,
 		}, nil
 	}
 }
@@ -134,6 +255,10 @@ func (h *ProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHandler {
 // verify all transactions in the proposal that belong to the lane and pass any remaining transactions
 // to the next lane in the chain.
 func (h *ProposalHandler) ProcessProposalHandler() sdk.ProcessProposalHandler {
+	if h.useShadowProcessProposal {
+		return h.shadowProcessProposalHandler()
+	}
+
 	if !h.useCustomProcessProposal {
 		return baseapp.NoOpProcessProposal()
 	}
@@ -143,6 +268,11 @@ func (h *ProposalHandler) ProcessProposalHandler() sdk.ProcessProposalHandler {
 			return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_ACCEPT}, nil
 		}
 
+		ctx, span := h.startSpan(ctx, "ProcessProposal", req.Height, len(req.Txs))
+		defer func() {
+			endSpan(span, err)
+		}()
+
 		// In the case where any of the lanes panic, we recover here and return a reject status.
 		defer func() {
 			if rec := recover(); rec != nil {
@@ -153,24 +283,8 @@ func (h *ProposalHandler) ProcessProposalHandler() sdk.ProcessProposalHandler {
 			}
 		}()
 
-		// Decode the transactions in the proposal. These will be verified by each lane in a greedy fashion.
-		decodedTxs, err := utils.GetDecodedTxs(h.txDecoder, req.Txs)
-		if err != nil {
-			h.logger.Error("failed to decode txs", "err", err)
-			return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}, err
-		}
-
-		// Build handler that will verify the partial proposals according to each lane's verification logic.
-		processLanesHandler := ChainProcessLanes(h.mempool.Registry())
-
-		// Verify the proposal.
-		finalProposal, err := processLanesHandler(
-			ctx,
-			proposals.NewProposalWithContext(ctx, h.logger),
-			decodedTxs,
-		)
+		finalProposal, err := h.verifyProposal(ctx, req)
 		if err != nil {
-			h.logger.Error("failed to validate the proposal", "err", err)
 			return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}, err
 		}
 
@@ -187,3 +301,167 @@ func (h *ProposalHandler) ProcessProposalHandler() sdk.ProcessProposalHandler {
 		return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_ACCEPT}, nil
 	}
 }
+
+// verifyProposal verifies the proposal according to each lane's verification logic and returns
+// the verified proposal. Errors identify the violated invariant (see proposals.InvariantError)
+// and the index of the offending transaction in the proposal (including the proposal info).
+func (h *ProposalHandler) verifyProposal(
+	ctx sdk.Context,
+	req *abci.RequestProcessProposal,
+) (proposals.Proposal, error) {
+	defer func(start time.Time) {
+		h.metrics.ObserveProcessProposalLatency(time.Since(start))
+	}(time.Now())
+
+	// Strip the proposal info (if any) from the proposal.
+	var (
+		txs  = req.Txs
+		info proposalstypes.ProposalInfo
+		err  error
+	)
+
+	if h.useProposalInfo {
+		info, txs, err = proposals.GetProposalInfo(req.Txs)
+		if err != nil {
+			h.logger.Error("failed to get proposal info", "err", err)
+			return proposals.Proposal{}, err
+		}
+	}
+
+	// Retrieve the lanes, ordered and configured according to the lane configurations
+	// stored on-chain (if any).
+	lanes := h.mempool.GetProposalLanes(ctx)
+
+	maxBlockSize, maxGasLimit := proposals.GetBlockLimits(ctx)
+	maxBlockSize, err = h.getMaxBlockSize(maxBlockSize, lanes.Lanes)
+	if err != nil {
+		h.logger.Error("failed to get max block size", "err", err)
+		return proposals.Proposal{}, err
+	}
+
+	// Verify the proposal.
+	finalProposal, err := h.processLanes(
+		ctx,
+		lanes.Lanes,
+		lanes.NewProposal(h.logger, maxBlockSize, maxGasLimit),
+		info,
+		txs,
+	)
+	if err != nil {
+		h.logger.Error("failed to validate the proposal", "err", err)
+
+		// The transaction indices are relative to the proposal without the proposal info.
+		if h.useProposalInfo {
+			err = proposals.OffsetInvariantError("", 1, err)
+		}
+
+		return finalProposal, err
+	}
+
+	// Ensure that the proposal was built as declared by the proposer.
+	if h.useProposalInfo {
+		if err := finalProposal.VerifyProposalInfo(info); err != nil {
+			h.logger.Error("proposal does not match proposal info", "err", err)
+			return finalProposal, err
+		}
+	}
+
+	return finalProposal, nil
+}
+
+// processLanes verifies the transactions in the proposal according to each lane's verification
+// logic. If the proposal info is included in proposals, the lanes' partial proposals are known
+// up front and verified in parallel (see ProcessLanesInParallel). Otherwise, the proposal is
+// verified in a greedy fashion, with each lane verifying the transactions that belong to it.
+func (h *ProposalHandler) processLanes(
+	ctx sdk.Context,
+	registry []block.Lane,
+	proposal proposals.Proposal,
+	info proposalstypes.ProposalInfo,
+	txs [][]byte,
+) (proposals.Proposal, error) {
+	if h.useProposalInfo {
+		return ProcessLanesInParallel(ctx, registry, h.txDecoder, proposal, info, txs)
+	}
+
+	// Decode the transactions in the proposal. These will be verified by each lane in a greedy fashion.
+	decodedTxs, err := decodeTxs(h.txDecoder, "", txs)
+	if err != nil {
+		return proposal, err
+	}
+
+	// Build handler that will verify the partial proposals according to each lane's verification logic.
+	processLanesHandler := ChainProcessLanes(registry)
+
+	return processLanesHandler(ctx, proposal, decodedTxs)
+}
+
+// startSpan starts a span for the preparation or verification of the proposal at the given
+// height and returns the context with the span.
+func (h *ProposalHandler) startSpan(ctx sdk.Context, name string, height int64, numTxs int) (sdk.Context, trace.Span) {
+	spanCtx, span := h.tracer.Start(
+		ctx.Context(),
+		name,
+		trace.WithAttributes(
+			attribute.Int64("height", height),
+			attribute.Int("num_txs", numTxs),
+		),
+	)
+
+	return ctx.WithContext(spanCtx), span
+}
+
+// getMaxBlockSize returns the number of bytes that can be used by the transactions in a
+// proposal. If the proposal info is included in proposals, the space it may take up is
+// reserved.
+func (h *ProposalHandler) getMaxBlockSize(maxBlockSize int64, registry []block.Lane) (int64, error) {
+	if !h.useProposalInfo {
+		return maxBlockSize, nil
+	}
+
+	laneNames := make([]string, len(registry))
+	for i, lane := range registry {
+		laneNames[i] = lane.Name()
+	}
+
+	infoSize, err := proposals.GetMaxProposalInfoSize(laneNames)
+	if err != nil {
+		return 0, err
+	}
+
+	if infoSize > maxBlockSize {
+		return 0, fmt.Errorf("max block size %d is too small to fit the proposal info", maxBlockSize)
+	}
+
+	return maxBlockSize - infoSize, nil
+}
+
+// getProposalTxs returns the transactions of the given proposal, preceded by the proposal
+// info if it is included in proposals.
+func (h *ProposalHandler) getProposalTxs(proposal proposals.Proposal) ([][]byte, error) {
+	if !h.useProposalInfo {
+		return proposal.Txs, nil
+	}
+
+	return proposal.GetProposalWithInfo()
+}
+
+// emptyPrepareProposalResponse returns a response to PrepareProposal that does not contain
+// any transactions. If the proposal info is included in proposals, the response contains
+// the proposal info of an empty proposal so that the proposal is still accepted by the
+// network.
+func (h *ProposalHandler) emptyPrepareProposalResponse(
+	ctx sdk.Context,
+	req *abci.RequestPrepareProposal,
+) *abci.ResponsePrepareProposal {
+	_, maxGasLimit := proposals.GetBlockLimits(ctx)
+	proposal := proposals.NewProposal(h.logger, req.MaxTxBytes, maxGasLimit)
+
+	txs, err := h.getProposalTxs(proposal)
+	if err != nil {
+		h.logger.Error("failed to get proposal txs", "err", err)
+		return &abci.ResponsePrepareProposal{Txs: make([][]byte, 0)}
+	}
+
+	return &abci.ResponsePrepareProposal{Txs: txs}
+}
@@ -1,7 +1,9 @@
 package abci_test
 
 import (
+	"bytes"
 	"context"
+	"fmt"
 	"math/rand"
 	"testing"
 
@@ -9,12 +11,23 @@ import (
 	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 	cometabci "github.com/cometbft/cometbft/abci/types"
+	comettypes "github.com/cometbft/cometbft/types"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 
 	"github.com/skip-mev/block-sdk/v2/abci"
+	signeradaptors "github.com/skip-mev/block-sdk/v2/adapters/signer_extraction_adapter"
 	"github.com/skip-mev/block-sdk/v2/block"
+	"github.com/skip-mev/block-sdk/v2/block/base"
+	metricsmocks "github.com/skip-mev/block-sdk/v2/block/metrics/mocks"
+	"github.com/skip-mev/block-sdk/v2/block/proposals"
+	proposalstypes "github.com/skip-mev/block-sdk/v2/block/proposals/types"
 	"github.com/skip-mev/block-sdk/v2/lanes/free"
 	testutils "github.com/skip-mev/block-sdk/v2/testutils"
 )
@@ -1366,6 +1379,503 @@ func (s *ProposalsTestSuite) TestPrepareProcessParity() {
 	s.Require().NoError(err)
 }
 
+func (s *ProposalsTestSuite) TestPrepareProcessBlockSpaceAllocation() {
+	// createTxs creates n transactions from the given account that each consume 10 gas.
+	createTxs := func(account testutils.Account, n int) []sdk.Tx {
+		txs := make([]sdk.Tx, n)
+		for i := range txs {
+			tx, err := testutils.CreateRandomTx(
+				s.encodingConfig.TxConfig,
+				account,
+				uint64(i),
+				1,
+				0,
+				10,
+				sdk.NewCoin(s.gasTokenDenom, math.NewInt(1000000)),
+			)
+			s.Require().NoError(err)
+
+			txs[i] = tx
+		}
+
+		return txs
+	}
+
+	s.Run("unused block space is redistributed to later lanes", func() {
+		s.setBlockParams(40, 1000000000000)
+
+		laneBTxs := createTxs(s.accounts[1], 2)
+		defaultTxs := createTxs(s.accounts[2], 2)
+
+		expectedExecution := make(map[sdk.Tx]bool)
+		for _, tx := range append(laneBTxs, defaultTxs...) {
+			expectedExecution[tx] = true
+		}
+
+		// Lane a does not use any of its 10 gas, all of which is passed down to lane b.
+		laneA := s.setUpAllocatedLane("a", &s.accounts[0], math.LegacyMustNewDecFromStr("0.25"), math.LegacyZeroDec(), 1, expectedExecution)
+		laneB := s.setUpAllocatedLane("b", &s.accounts[1], math.LegacyMustNewDecFromStr("0.25"), math.LegacyZeroDec(), 1, expectedExecution)
+		defaultLane := s.setUpAllocatedLane("default", nil, math.LegacyZeroDec(), math.LegacyZeroDec(), 0, expectedExecution)
+
+		for _, tx := range laneBTxs {
+			s.Require().NoError(laneB.Insert(sdk.Context{}, tx))
+		}
+		for _, tx := range defaultTxs {
+			s.Require().NoError(defaultLane.Insert(sdk.Context{}, tx))
+		}
+
+		handler := s.setUpProposalHandlers([]block.Lane{laneA, laneB, defaultLane})
+
+		maxTxBytes := s.ctx.ConsensusParams().Block.MaxBytes
+		resp, err := handler.PrepareProposalHandler()(s.ctx, &cometabci.RequestPrepareProposal{Height: 2, MaxTxBytes: maxTxBytes})
+		s.Require().NoError(err)
+		s.Require().Equal(s.getTxBytes(append(laneBTxs, defaultTxs...)...), resp.Txs)
+
+		processResp, err := handler.ProcessProposalHandler()(s.ctx, &cometabci.RequestProcessProposal{Txs: resp.Txs, Height: 2})
+		s.Require().NoError(err)
+		s.Require().Equal(cometabci.ResponseProcessProposal_ACCEPT, processResp.Status)
+	})
+
+	s.Run("reserved block space cannot be consumed by earlier lanes", func() {
+		s.setBlockParams(40, 1000000000000)
+
+		laneATxs := createTxs(s.accounts[0], 2)
+		defaultTxs := createTxs(s.accounts[2], 3)
+
+		expectedExecution := make(map[sdk.Tx]bool)
+		for _, tx := range append(laneATxs, defaultTxs...) {
+			expectedExecution[tx] = true
+		}
+
+		// Lane a may use up to 20 gas but 30 gas is reserved for the default lane.
+		laneA := s.setUpAllocatedLane("a", &s.accounts[0], math.LegacyMustNewDecFromStr("0.5"), math.LegacyZeroDec(), 0, expectedExecution)
+		defaultLane := s.setUpAllocatedLane("default", nil, math.LegacyZeroDec(), math.LegacyMustNewDecFromStr("0.75"), 0, expectedExecution)
+
+		for _, tx := range laneATxs {
+			s.Require().NoError(laneA.Insert(sdk.Context{}, tx))
+		}
+		for _, tx := range defaultTxs {
+			s.Require().NoError(defaultLane.Insert(sdk.Context{}, tx))
+		}
+
+		handler := s.setUpProposalHandlers([]block.Lane{laneA, defaultLane})
+
+		maxTxBytes := s.ctx.ConsensusParams().Block.MaxBytes
+		resp, err := handler.PrepareProposalHandler()(s.ctx, &cometabci.RequestPrepareProposal{Height: 2, MaxTxBytes: maxTxBytes})
+		s.Require().NoError(err)
+		s.Require().Equal(s.getTxBytes(laneATxs[0], defaultTxs[0], defaultTxs[1], defaultTxs[2]), resp.Txs)
+
+		processResp, err := handler.ProcessProposalHandler()(s.ctx, &cometabci.RequestProcessProposal{Txs: resp.Txs, Height: 2})
+		s.Require().NoError(err)
+		s.Require().Equal(cometabci.ResponseProcessProposal_ACCEPT, processResp.Status)
+
+		// A proposal where lane a consumes the reserved block space must be rejected.
+		invalidProposal := s.getTxBytes(laneATxs[0], laneATxs[1], defaultTxs[0])
+		processResp, err = handler.ProcessProposalHandler()(s.ctx, &cometabci.RequestProcessProposal{Txs: invalidProposal, Height: 2})
+		s.Require().Error(err)
+		s.Require().Equal(cometabci.ResponseProcessProposal_REJECT, processResp.Status)
+	})
+}
+
+func (s *ProposalsTestSuite) TestPrepareProcessProposalInfo() {
+	// createTxs creates n transactions from the given account that each consume 10 gas.
+	createTxs := func(account testutils.Account, n int) []sdk.Tx {
+		txs := make([]sdk.Tx, n)
+		for i := range txs {
+			tx, err := testutils.CreateRandomTx(
+				s.encodingConfig.TxConfig,
+				account,
+				uint64(i),
+				1,
+				0,
+				10,
+				sdk.NewCoin(s.gasTokenDenom, math.NewInt(1000000)),
+			)
+			s.Require().NoError(err)
+
+			txs[i] = tx
+		}
+
+		return txs
+	}
+
+	// setUpHandler returns a proposal handler that includes the proposal info in proposals
+	// along with the transactions that are expected to be included in the proposal.
+	setUpHandler := func() (*abci.ProposalHandler, []sdk.Tx, []sdk.Tx) {
+		laneATxs := createTxs(s.accounts[0], 2)
+		defaultTxs := createTxs(s.accounts[1], 3)
+
+		expectedExecution := make(map[sdk.Tx]bool)
+		for _, tx := range append(laneATxs, defaultTxs...) {
+			expectedExecution[tx] = true
+		}
+
+		laneA := s.setUpAllocatedLane("a", &s.accounts[0], math.LegacyMustNewDecFromStr("0.5"), math.LegacyZeroDec(), 0, expectedExecution)
+		defaultLane := s.setUpAllocatedLane("default", nil, math.LegacyZeroDec(), math.LegacyZeroDec(), 0, expectedExecution)
+
+		for _, tx := range laneATxs {
+			s.Require().NoError(laneA.Insert(sdk.Context{}, tx))
+		}
+		for _, tx := range defaultTxs {
+			s.Require().NoError(defaultLane.Insert(sdk.Context{}, tx))
+		}
+
+		return s.setUpProposalHandlers([]block.Lane{laneA, defaultLane}, abci.WithProposalInfo()), laneATxs, defaultTxs
+	}
+
+	// prepare prepares a proposal and returns the proposal info and the remaining transactions.
+	prepare := func(handler *abci.ProposalHandler) (proposalstypes.ProposalInfo, [][]byte) {
+		maxTxBytes := s.ctx.ConsensusParams().Block.MaxBytes
+		resp, err := handler.PrepareProposalHandler()(s.ctx, &cometabci.RequestPrepareProposal{Height: 2, MaxTxBytes: maxTxBytes})
+		s.Require().NoError(err)
+
+		info, txs, err := proposals.GetProposalInfo(resp.Txs)
+		s.Require().NoError(err)
+
+		return info, txs
+	}
+
+	// process processes the proposal with the given proposal info and transactions.
+	process := func(handler *abci.ProposalHandler, info proposalstypes.ProposalInfo, txs [][]byte) (*cometabci.ResponseProcessProposal, error) {
+		infoBz, err := info.Marshal()
+		s.Require().NoError(err)
+
+		return handler.ProcessProposalHandler()(s.ctx, &cometabci.RequestProcessProposal{Txs: append([][]byte{infoBz}, txs...), Height: 2})
+	}
+
+	s.Run("can prepare and process a proposal with proposal info", func() {
+		s.setBlockParams(100, 1000000000000)
+
+		handler, laneATxs, defaultTxs := setUpHandler()
+		info, txs := prepare(handler)
+
+		s.Require().Equal(s.getTxBytes(append(laneATxs, defaultTxs...)...), txs)
+		s.Require().Equal(map[string]uint64{"a": 2, "default": 3}, info.TxsByLane)
+		s.Require().Equal(map[string]uint64{"a": 2, "default": 3}, info.MempoolDepthByLane)
+		s.Require().Equal(uint64(50), info.GasLimit)
+
+		var size int64
+		for _, tx := range txs {
+			size += int64(len(tx))
+		}
+		s.Require().Equal(size, info.BlockSize)
+
+		resp, err := process(handler, info, txs)
+		s.Require().NoError(err)
+		s.Require().Equal(cometabci.ResponseProcessProposal_ACCEPT, resp.Status)
+	})
+
+	s.Run("can prepare and process an empty proposal with proposal info", func() {
+		s.setBlockParams(100, 1000000000000)
+
+		handler := s.setUpProposalHandlers([]block.Lane{s.setUpStandardLane(math.LegacyZeroDec(), nil)}, abci.WithProposalInfo())
+		info, txs := prepare(handler)
+		s.Require().Empty(txs)
+		s.Require().Empty(info.TxsByLane)
+
+		resp, err := process(handler, info, txs)
+		s.Require().NoError(err)
+		s.Require().Equal(cometabci.ResponseProcessProposal_ACCEPT, resp.Status)
+	})
+
+	s.Run("reserves space for the proposal info", func() {
+		handler, laneATxs, defaultTxs := setUpHandler()
+
+		var size int64
+		for _, tx := range s.getTxBytes(append(laneATxs, defaultTxs...)...) {
+			size += int64(len(tx))
+		}
+
+		// The block is large enough to fit all of the transactions but not the proposal info.
+		s.setBlockParams(100, size)
+		info, txs := prepare(handler)
+		s.Require().Less(len(txs), len(laneATxs)+len(defaultTxs))
+
+		infoBz, err := info.Marshal()
+		s.Require().NoError(err)
+		s.Require().LessOrEqual(
+			comettypes.ComputeProtoSizeForTxs(comettypes.ToTxs(append([][]byte{infoBz}, txs...))),
+			size,
+		)
+	})
+
+	s.Run("rejects a proposal without proposal info", func() {
+		s.setBlockParams(100, 1000000000000)
+
+		handler, _, _ := setUpHandler()
+		_, txs := prepare(handler)
+
+		resp, err := handler.ProcessProposalHandler()(s.ctx, &cometabci.RequestProcessProposal{Txs: txs, Height: 2})
+		s.Require().Error(err)
+		s.Require().Equal(cometabci.ResponseProcessProposal_REJECT, resp.Status)
+
+		resp, err = handler.ProcessProposalHandler()(s.ctx, &cometabci.RequestProcessProposal{Height: 2})
+		s.Require().Error(err)
+		s.Require().Equal(cometabci.ResponseProcessProposal_REJECT, resp.Status)
+	})
+
+	s.Run("rejects a proposal with mismatched proposal info", func() {
+		s.setBlockParams(100, 1000000000000)
+
+		handler, _, _ := setUpHandler()
+		info, txs := prepare(handler)
+
+		cases := map[string]func(info *proposalstypes.ProposalInfo){
+			"wrong number of txs in a lane": func(info *proposalstypes.ProposalInfo) {
+				info.TxsByLane = map[string]uint64{"a": 1, "default": 4}
+			},
+			"missing lane": func(info *proposalstypes.ProposalInfo) {
+				info.TxsByLane = map[string]uint64{"default": 3}
+			},
+			"unknown lane": func(info *proposalstypes.ProposalInfo) {
+				info.TxsByLane = map[string]uint64{"a": 2, "default": 3, "b": 1}
+			},
+			"wrong block size": func(info *proposalstypes.ProposalInfo) {
+				info.BlockSize++
+			},
+			"wrong gas limit": func(info *proposalstypes.ProposalInfo) {
+				info.GasLimit--
+			},
+		}
+
+		for name, malleate := range cases {
+			invalidInfo := info
+			invalidInfo.TxsByLane = map[string]uint64{"a": 2, "default": 3}
+			malleate(&invalidInfo)
+
+			resp, err := process(handler, invalidInfo, txs)
+			s.Require().Error(err, name)
+			s.Require().Equal(cometabci.ResponseProcessProposal_REJECT, resp.Status, name)
+		}
+	})
+}
+
+func (s *ProposalsTestSuite) TestFinalizeBlockHandler() {
+	// finalize mimics BaseApp.FinalizeBlock, which reports a decoding error for every
+	// transaction that fails to decode.
+	finalize := func(req *cometabci.RequestFinalizeBlock) (*cometabci.ResponseFinalizeBlock, error) {
+		resp := &cometabci.ResponseFinalizeBlock{}
+		for _, txBz := range req.Txs {
+			if _, err := s.encodingConfig.TxConfig.TxDecoder()(txBz); err != nil {
+				resp.TxResults = append(resp.TxResults, &cometabci.ExecTxResult{Code: 2, Log: err.Error()})
+				continue
+			}
+
+			resp.TxResults = append(resp.TxResults, &cometabci.ExecTxResult{})
+		}
+
+		return resp, nil
+	}
+
+	s.Run("reports the proposal info as a successful tx", func() {
+		s.setBlockParams(100, 1000000000000)
+
+		tx, err := testutils.CreateRandomTx(
+			s.encodingConfig.TxConfig,
+			s.accounts[0],
+			0,
+			1,
+			0,
+			10,
+			sdk.NewCoin(s.gasTokenDenom, math.NewInt(1000000)),
+		)
+		s.Require().NoError(err)
+
+		lane := s.setUpStandardLane(math.LegacyZeroDec(), map[sdk.Tx]bool{tx: true})
+		s.Require().NoError(lane.Insert(sdk.Context{}, tx))
+
+		handler := s.setUpProposalHandlers([]block.Lane{lane}, abci.WithProposalInfo())
+		resp, err := handler.PrepareProposalHandler()(s.ctx, &cometabci.RequestPrepareProposal{
+			Height:     2,
+			MaxTxBytes: s.ctx.ConsensusParams().Block.MaxBytes,
+		})
+		s.Require().NoError(err)
+		s.Require().Len(resp.Txs, 2)
+
+		finalizeResp, err := handler.FinalizeBlockHandler(finalize)(&cometabci.RequestFinalizeBlock{Txs: resp.Txs})
+		s.Require().NoError(err)
+		s.Require().Len(finalizeResp.TxResults, 2)
+		s.Require().True(finalizeResp.TxResults[0].IsOK())
+		s.Require().Equal(abci.ProposalInfoLog, finalizeResp.TxResults[0].Log)
+		s.Require().True(finalizeResp.TxResults[1].IsOK())
+	})
+
+	s.Run("does not replace results without proposal info", func() {
+		handler := s.setUpProposalHandlers([]block.Lane{s.setUpStandardLane(math.LegacyZeroDec(), nil)})
+
+		finalizeResp, err := handler.FinalizeBlockHandler(finalize)(&cometabci.RequestFinalizeBlock{Txs: [][]byte{{0x1}}})
+		s.Require().NoError(err)
+		s.Require().Len(finalizeResp.TxResults, 1)
+		s.Require().False(finalizeResp.TxResults[0].IsOK())
+	})
+
+	s.Run("does not replace the result of a valid tx in the first slot", func() {
+		tx, err := testutils.CreateRandomTx(
+			s.encodingConfig.TxConfig,
+			s.accounts[0],
+			0,
+			1,
+			0,
+			10,
+		)
+		s.Require().NoError(err)
+
+		handler := s.setUpProposalHandlers([]block.Lane{s.setUpStandardLane(math.LegacyZeroDec(), nil)}, abci.WithProposalInfo())
+
+		finalizeResp, err := handler.FinalizeBlockHandler(finalize)(&cometabci.RequestFinalizeBlock{Txs: s.getTxBytes(tx)})
+		s.Require().NoError(err)
+		s.Require().Len(finalizeResp.TxResults, 1)
+		s.Require().Empty(finalizeResp.TxResults[0].Log)
+	})
+}
+
+func (s *ProposalsTestSuite) TestProcessLanesInParallel() {
+	bidTx, bundle, err := testutils.CreateAuctionTx(
+		s.encodingConfig.TxConfig,
+		s.accounts[0],
+		sdk.NewCoin(s.gasTokenDenom, math.NewInt(1000000)),
+		0,
+		0,
+		s.accounts[0:2],
+		100,
+	)
+	s.Require().NoError(err)
+
+	freeTx, err := testutils.CreateFreeTx(
+		s.encodingConfig.TxConfig,
+		s.accounts[2],
+		0,
+		0,
+		"test",
+		sdk.NewCoin(s.gasTokenDenom, math.NewInt(1000000)),
+		sdk.NewCoin(s.gasTokenDenom, math.NewInt(1000000)),
+	)
+	s.Require().NoError(err)
+
+	defaultTx, err := testutils.CreateRandomTx(
+		s.encodingConfig.TxConfig,
+		s.accounts[3],
+		0,
+		1,
+		0,
+		1,
+		sdk.NewCoin(s.gasTokenDenom, math.NewInt(1000000)),
+	)
+	s.Require().NoError(err)
+
+	txs := []sdk.Tx{bidTx, bundle[0], bundle[1], freeTx, defaultTx}
+
+	// setUpLanes returns the mev, free and default lanes where all transactions are valid
+	// except for the given transaction (if any).
+	setUpLanes := func(invalidTx sdk.Tx) []block.Lane {
+		expectedExecution := make(map[sdk.Tx]bool)
+		for _, tx := range txs {
+			expectedExecution[tx] = tx != invalidTx
+		}
+
+		return []block.Lane{
+			s.setUpTOBLane(math.LegacyMustNewDecFromStr("0.25"), expectedExecution),
+			s.setUpFreeLane(math.LegacyMustNewDecFromStr("0.25"), expectedExecution),
+			s.setUpStandardLane(math.LegacyZeroDec(), expectedExecution),
+		}
+	}
+
+	process := func(lanes []block.Lane, txsByLane map[string]uint64) (proposals.Proposal, error) {
+		return abci.ProcessLanesInParallel(
+			s.ctx,
+			lanes,
+			s.encodingConfig.TxConfig.TxDecoder(),
+			proposals.NewProposalWithContext(s.ctx, log.NewNopLogger()),
+			proposalstypes.ProposalInfo{TxsByLane: txsByLane},
+			s.getTxBytes(txs...),
+		)
+	}
+
+	s.Run("can process a valid proposal", func() {
+		lanes := setUpLanes(nil)
+
+		expected, err := abci.ChainProcessLanes(lanes)(s.ctx, proposals.NewProposalWithContext(s.ctx, log.NewNopLogger()), txs)
+		s.Require().NoError(err)
+
+		proposal, err := process(lanes, map[string]uint64{"mev": 3, "free": 1, "default": 1})
+		s.Require().NoError(err)
+		s.Require().Equal(expected.Txs, proposal.Txs)
+		s.Require().Equal(expected.Info, proposal.Info)
+	})
+
+	s.Run("rejects a proposal with a transaction that fails verification", func() {
+		for _, tx := range txs {
+			_, err := process(setUpLanes(tx), map[string]uint64{"mev": 3, "free": 1, "default": 1})
+			s.Require().Error(err)
+		}
+	})
+
+	s.Run("rejects a proposal whose segments do not cover the proposal", func() {
+		_, err := process(setUpLanes(nil), map[string]uint64{"mev": 3, "free": 1})
+		s.Require().Error(err)
+
+		_, err = process(setUpLanes(nil), map[string]uint64{"mev": 3, "free": 1, "default": 2})
+		s.Require().Error(err)
+	})
+
+	s.Run("rejects a proposal with transactions attributed to the wrong lane", func() {
+		_, err := process(setUpLanes(nil), map[string]uint64{"mev": 3, "default": 2})
+		s.Require().Error(err)
+
+		_, err = process(setUpLanes(nil), map[string]uint64{"mev": 2, "free": 2, "default": 1})
+		s.Require().Error(err)
+
+		_, err = process(setUpLanes(nil), map[string]uint64{"mev": 3, "free": 2})
+		s.Require().Error(err)
+	})
+
+	s.Run("verifies lanes without stateless verification with ProcessLane", func() {
+		// The free lane uses a custom process lane handler.
+		setUpCustomLanes := func(invalidTx sdk.Tx) []block.Lane {
+			lanes := setUpLanes(invalidTx)
+
+			freeLane := lanes[1].(*base.BaseLane)
+			freeLane.WithOptions(base.WithProcessLaneHandler(base.NewDefaultProposalHandler(freeLane).ProcessLaneHandler()))
+
+			return lanes
+		}
+
+		proposal, err := process(setUpCustomLanes(nil), map[string]uint64{"mev": 3, "free": 1, "default": 1})
+		s.Require().NoError(err)
+		s.Require().Equal(map[string]uint64{"mev": 3, "free": 1, "default": 1}, proposal.Info.TxsByLane)
+
+		_, err = process(setUpCustomLanes(nil), map[string]uint64{"mev": 3, "free": 2})
+		s.Require().Error(err)
+
+		_, err = process(setUpCustomLanes(freeTx), map[string]uint64{"mev": 3, "free": 1, "default": 1})
+		s.Require().Error(err)
+	})
+
+	s.Run("verifies lanes that do not implement parallel verification with ProcessLane", func() {
+		// sequentialLane only exposes the methods of block.Lane, as custom lanes would.
+		type sequentialLane struct {
+			block.Lane
+		}
+
+		setUpSequentialLanes := func(invalidTx sdk.Tx) []block.Lane {
+			lanes := setUpLanes(invalidTx)
+			lanes[1] = sequentialLane{lanes[1]}
+
+			return lanes
+		}
+
+		_, ok := setUpSequentialLanes(nil)[1].(block.ParallelProcessLane)
+		s.Require().False(ok)
+
+		proposal, err := process(setUpSequentialLanes(nil), map[string]uint64{"mev": 3, "free": 1, "default": 1})
+		s.Require().NoError(err)
+		s.Require().Equal(map[string]uint64{"mev": 3, "free": 1, "default": 1}, proposal.Info.TxsByLane)
+
+		_, err = process(setUpSequentialLanes(freeTx), map[string]uint64{"mev": 3, "free": 1, "default": 1})
+		s.Require().Error(err)
+	})
+}
+
 func (s *ProposalsTestSuite) TestIterateMempoolAndProcessProposalParity() {
 	// Define a large enough block size and gas limit to ensure that the proposal is accepted
 	s.setBlockParams(1000000000000, 1000000000000)
@@ -1461,3 +1971,518 @@ func (s *ProposalsTestSuite) TestIterateMempoolAndProcessProposalParity() {
 	s.Require().NotNil(resp)
 	s.Require().NoError(err)
 }
+
+func (s *ProposalsTestSuite) TestShadowProcessProposal() {
+	// setUpHandlers returns a strict proposal handler and a shadow proposal handler for the
+	// given lanes. The logs of the shadow proposal handler are written to the returned buffer.
+	setUpHandlers := func(lanes []block.Lane, opts ...abci.ProposalHandlerOption) (*abci.ProposalHandler, *abci.ProposalHandler, *bytes.Buffer) {
+		mempool, err := block.NewLanedMempool(log.NewNopLogger(), lanes)
+		s.Require().NoError(err)
+
+		logs := new(bytes.Buffer)
+		shadowHandler := abci.New(
+			log.NewLogger(logs, log.OutputJSONOption()),
+			s.encodingConfig.TxConfig.TxDecoder(),
+			s.encodingConfig.TxConfig.TxEncoder(),
+			mempool,
+			true,
+			append(opts, abci.WithShadowProcessProposal())...,
+		)
+
+		return s.setUpProposalHandlers(lanes, opts...), shadowHandler, logs
+	}
+
+	// requireShadowRejection checks that the strict proposal handler rejects the proposal with
+	// the given invariant error while the shadow proposal handler accepts it and logs the error.
+	requireShadowRejection := func(
+		strictHandler, shadowHandler *abci.ProposalHandler,
+		logs *bytes.Buffer,
+		proposal [][]byte,
+		lane string,
+		txIndex int,
+		invariant proposals.Invariant,
+	) {
+		req := &cometabci.RequestProcessProposal{Txs: proposal, Height: 2}
+
+		resp, err := strictHandler.ProcessProposalHandler()(s.ctx, req)
+		s.Require().Error(err)
+		s.Require().Equal(cometabci.ResponseProcessProposal_REJECT, resp.Status)
+
+		invariantErr := proposals.GetInvariantError("", err)
+		s.Require().Equal(lane, invariantErr.Lane)
+		s.Require().Equal(txIndex, invariantErr.TxIndex)
+		s.Require().Equal(invariant, invariantErr.Invariant)
+
+		resp, err = shadowHandler.ProcessProposalHandler()(s.ctx, req)
+		s.Require().NoError(err)
+		s.Require().Equal(cometabci.ResponseProcessProposal_ACCEPT, resp.Status)
+
+		s.Require().Contains(logs.String(), "proposal would have been rejected")
+		s.Require().Contains(logs.String(), fmt.Sprintf(`"lane":"%s"`, lane))
+		s.Require().Contains(logs.String(), fmt.Sprintf(`"tx_index":%d`, txIndex))
+		s.Require().Contains(logs.String(), fmt.Sprintf(`"invariant":"%s"`, invariant))
+	}
+
+	// createOutOfOrderTxs creates two transactions from the same account that are included
+	// in the wrong order.
+	createOutOfOrderTxs := func() (sdk.Tx, sdk.Tx) {
+		tx1, err := testutils.CreateRandomTx(
+			s.encodingConfig.TxConfig,
+			s.accounts[2],
+			0,
+			1,
+			0,
+			1,
+			sdk.NewCoin(s.gasTokenDenom, math.NewInt(2000000)),
+		)
+		s.Require().NoError(err)
+
+		tx2, err := testutils.CreateRandomTx(
+			s.encodingConfig.TxConfig,
+			s.accounts[2],
+			1,
+			1,
+			0,
+			1,
+			sdk.NewCoin(s.gasTokenDenom, math.NewInt(2000000)),
+		)
+		s.Require().NoError(err)
+
+		return tx2, tx1
+	}
+
+	s.Run("accepts a valid proposal", func() {
+		tx, err := testutils.CreateRandomTx(
+			s.encodingConfig.TxConfig,
+			s.accounts[0],
+			0,
+			1,
+			0,
+			1,
+			sdk.NewCoin(s.gasTokenDenom, math.NewInt(2000000)),
+		)
+		s.Require().NoError(err)
+
+		mevLane := s.setUpTOBLane(math.LegacyMustNewDecFromStr("0.3"), map[sdk.Tx]bool{})
+		defaultLane := s.setUpStandardLane(math.LegacyMustNewDecFromStr("0.0"), map[sdk.Tx]bool{tx: true})
+
+		_, shadowHandler, logs := setUpHandlers([]block.Lane{mevLane, defaultLane})
+		resp, err := shadowHandler.ProcessProposalHandler()(s.ctx, &cometabci.RequestProcessProposal{Txs: s.createProposal(tx), Height: 2})
+		s.Require().NoError(err)
+		s.Require().Equal(cometabci.ResponseProcessProposal_ACCEPT, resp.Status)
+		s.Require().NotContains(logs.String(), "proposal would have been rejected")
+	})
+
+	s.Run("accepts a proposal with txs that cannot be decoded", func() {
+		defaultLane := s.setUpStandardLane(math.LegacyMustNewDecFromStr("0.0"), map[sdk.Tx]bool{})
+
+		strictHandler, shadowHandler, logs := setUpHandlers([]block.Lane{defaultLane})
+		requireShadowRejection(strictHandler, shadowHandler, logs, [][]byte{{0x01, 0x02, 0x03}}, "", 0, proposals.InvariantDecode)
+	})
+
+	s.Run("accepts a proposal with txs out of order", func() {
+		tx1, tx2 := createOutOfOrderTxs()
+
+		mevLane := s.setUpTOBLane(math.LegacyMustNewDecFromStr("0.3"), map[sdk.Tx]bool{})
+		defaultLane := s.setUpStandardLane(math.LegacyMustNewDecFromStr("0.0"), map[sdk.Tx]bool{tx1: true, tx2: true})
+
+		strictHandler, shadowHandler, logs := setUpHandlers([]block.Lane{mevLane, defaultLane})
+		requireShadowRejection(strictHandler, shadowHandler, logs, s.createProposal(tx1, tx2), "default", 1, proposals.InvariantOrdering)
+	})
+
+	s.Run("accepts a proposal where a tx of the second lane is invalid", func() {
+		bidTx, bundle, err := testutils.CreateAuctionTx(
+			s.encodingConfig.TxConfig,
+			s.accounts[0],
+			sdk.NewCoin(s.gasTokenDenom, math.NewInt(1000000)),
+			0,
+			1,
+			s.accounts[0:2],
+			10,
+		)
+		s.Require().NoError(err)
+
+		normalTx, err := testutils.CreateRandomTx(
+			s.encodingConfig.TxConfig,
+			s.accounts[1],
+			0,
+			1,
+			0,
+			1,
+			sdk.NewCoin(s.gasTokenDenom, math.NewInt(3000000)),
+		)
+		s.Require().NoError(err)
+
+		normalTx2, err := testutils.CreateRandomTx(
+			s.encodingConfig.TxConfig,
+			s.accounts[2],
+			0,
+			1,
+			0,
+			1,
+			sdk.NewCoin(s.gasTokenDenom, math.NewInt(3000000)),
+		)
+		s.Require().NoError(err)
+
+		defaultLane := s.setUpStandardLane(math.LegacyMustNewDecFromStr("0.5"), map[sdk.Tx]bool{
+			normalTx:  true,
+			normalTx2: false,
+		})
+		mevLane := s.setUpTOBLane(math.LegacyMustNewDecFromStr("0.5"), map[sdk.Tx]bool{
+			bidTx:     true,
+			bundle[0]: true,
+			bundle[1]: true,
+		})
+
+		strictHandler, shadowHandler, logs := setUpHandlers([]block.Lane{mevLane, defaultLane})
+		proposal := s.createProposal(bidTx, bundle[0], bundle[1], normalTx, normalTx2)
+		requireShadowRejection(strictHandler, shadowHandler, logs, proposal, "default", 4, proposals.InvariantVerifyTx)
+	})
+
+	s.Run("accepts a proposal with unverified txs", func() {
+		tx, err := testutils.CreateRandomTx(
+			s.encodingConfig.TxConfig,
+			s.accounts[0],
+			0,
+			1,
+			0,
+			1,
+			sdk.NewCoin(s.gasTokenDenom, math.NewInt(2000000)),
+		)
+		s.Require().NoError(err)
+
+		mevLane := s.setUpTOBLane(math.LegacyMustNewDecFromStr("0.3"), map[sdk.Tx]bool{})
+		freeLane := s.setUpFreeLane(math.LegacyMustNewDecFromStr("0.7"), map[sdk.Tx]bool{})
+
+		strictHandler, shadowHandler, logs := setUpHandlers([]block.Lane{mevLane, freeLane})
+		requireShadowRejection(strictHandler, shadowHandler, logs, s.createProposal(tx), "Terminator", 0, proposals.InvariantMatch)
+	})
+
+	s.Run("accepts a proposal when a lane panics", func() {
+		txbz, err := testutils.CreateRandomTxBz(
+			s.encodingConfig.TxConfig,
+			s.accounts[0],
+			0,
+			0,
+			0,
+			1,
+		)
+		s.Require().NoError(err)
+
+		mevLane := s.setUpTOBLane(math.LegacyMustNewDecFromStr("0.25"), map[sdk.Tx]bool{})
+		panicLane := s.setUpPanicLane("default", math.LegacyMustNewDecFromStr("0.0"))
+
+		_, shadowHandler, logs := setUpHandlers([]block.Lane{mevLane, panicLane})
+		resp, err := shadowHandler.ProcessProposalHandler()(s.ctx, &cometabci.RequestProcessProposal{Txs: [][]byte{txbz}, Height: 2})
+		s.Require().NoError(err)
+		s.Require().Equal(cometabci.ResponseProcessProposal_ACCEPT, resp.Status)
+		s.Require().Contains(logs.String(), "proposal would have been rejected")
+		s.Require().Contains(logs.String(), `"invariant":"unknown"`)
+	})
+
+	s.Run("accepts a proposal with txs out of order and proposal info", func() {
+		tx1, tx2 := createOutOfOrderTxs()
+
+		mevLane := s.setUpTOBLane(math.LegacyMustNewDecFromStr("0.3"), map[sdk.Tx]bool{})
+		defaultLane := s.setUpStandardLane(math.LegacyMustNewDecFromStr("0.0"), map[sdk.Tx]bool{tx1: true, tx2: true})
+
+		info := proposalstypes.ProposalInfo{TxsByLane: map[string]uint64{defaultLane.Name(): 2}}
+		infoBz, err := info.Marshal()
+		s.Require().NoError(err)
+
+		// The index of the transaction accounts for the proposal info in the first slot.
+		strictHandler, shadowHandler, logs := setUpHandlers([]block.Lane{mevLane, defaultLane}, abci.WithProposalInfo())
+		proposal := append([][]byte{infoBz}, s.createProposal(tx1, tx2)...)
+		requireShadowRejection(strictHandler, shadowHandler, logs, proposal, "default", 2, proposals.InvariantOrdering)
+	})
+
+	s.Run("accepts a proposal without proposal info", func() {
+		defaultLane := s.setUpStandardLane(math.LegacyMustNewDecFromStr("0.0"), map[sdk.Tx]bool{})
+
+		strictHandler, shadowHandler, logs := setUpHandlers([]block.Lane{defaultLane}, abci.WithProposalInfo())
+		requireShadowRejection(strictHandler, shadowHandler, logs, nil, "", -1, proposals.InvariantProposalInfo)
+	})
+
+	s.Run("shadow mode takes precedence over the default proposal handler", func() {
+		defaultLane := s.setUpStandardLane(math.LegacyMustNewDecFromStr("0.0"), map[sdk.Tx]bool{})
+
+		mempool, err := block.NewLanedMempool(log.NewNopLogger(), []block.Lane{defaultLane})
+		s.Require().NoError(err)
+
+		logs := new(bytes.Buffer)
+		handler := abci.NewDefaultProposalHandler(
+			log.NewLogger(logs, log.OutputJSONOption()),
+			s.encodingConfig.TxConfig.TxDecoder(),
+			s.encodingConfig.TxConfig.TxEncoder(),
+			mempool,
+			abci.WithShadowProcessProposal(),
+		)
+
+		resp, err := handler.ProcessProposalHandler()(s.ctx, &cometabci.RequestProcessProposal{Txs: [][]byte{{0x01}}, Height: 2})
+		s.Require().NoError(err)
+		s.Require().Equal(cometabci.ResponseProcessProposal_ACCEPT, resp.Status)
+		s.Require().Contains(logs.String(), `"invariant":"decode"`)
+	})
+}
+
+func (s *ProposalsTestSuite) TestMetrics() {
+	s.Run("reports lanes that are skipped when preparing a proposal", func() {
+		tx, err := testutils.CreateRandomTx(
+			s.encodingConfig.TxConfig,
+			s.accounts[0],
+			0,
+			0,
+			0,
+			1,
+			sdk.NewCoin(s.gasTokenDenom, math.NewInt(1000000)),
+		)
+		s.Require().NoError(err)
+
+		panicLane := s.setUpPanicLane("panik", math.LegacyMustNewDecFromStr("0.25"))
+		defaultLane := s.setUpStandardLane(math.LegacyMustNewDecFromStr("0.0"), map[sdk.Tx]bool{tx: true})
+		s.Require().NoError(defaultLane.Insert(sdk.Context{}, tx))
+
+		m := metricsmocks.NewMetrics(s.T())
+		m.On("SetLaneSize", "panik", 0).Once()
+		m.On("SetLaneSize", defaultLane.Name(), 1).Once()
+		m.On("AddLaneFailure", "panik").Once()
+		m.On("ObservePrepareProposalLatency", mock.Anything).Once()
+
+		handler := s.setUpProposalHandlers([]block.Lane{panicLane, defaultLane}, abci.WithMetrics(m))
+
+		maxTxBytes := s.ctx.ConsensusParams().Block.MaxBytes
+		resp, err := handler.PrepareProposalHandler()(s.ctx, &cometabci.RequestPrepareProposal{Height: 2, MaxTxBytes: maxTxBytes})
+		s.Require().NoError(err)
+		s.Require().Equal(s.getTxBytes(tx), resp.Txs)
+	})
+
+	s.Run("reports the latency of processing a proposal", func() {
+		defaultLane := s.setUpStandardLane(math.LegacyMustNewDecFromStr("0.0"), map[sdk.Tx]bool{})
+
+		m := metricsmocks.NewMetrics(s.T())
+		m.On("ObserveProcessProposalLatency", mock.Anything).Once()
+
+		handler := s.setUpProposalHandlers([]block.Lane{defaultLane}, abci.WithMetrics(m))
+		resp, err := handler.ProcessProposalHandler()(s.ctx, &cometabci.RequestProcessProposal{Height: 2})
+		s.Require().NoError(err)
+		s.Require().Equal(cometabci.ResponseProcessProposal_ACCEPT, resp.Status)
+	})
+
+	s.Run("reports proposals verified in shadow mode", func() {
+		defaultLane := s.setUpStandardLane(math.LegacyMustNewDecFromStr("0.0"), map[sdk.Tx]bool{})
+
+		m := metricsmocks.NewMetrics(s.T())
+		m.On("ObserveProcessProposalLatency", mock.Anything).Twice()
+		m.On("AddShadowProposalAccepted").Once()
+		m.On("AddShadowProposalRejected", "", string(proposals.InvariantDecode)).Once()
+
+		handler := s.setUpProposalHandlers(
+			[]block.Lane{defaultLane},
+			abci.WithShadowProcessProposal(),
+			abci.WithMetrics(m),
+		).ProcessProposalHandler()
+
+		resp, err := handler(s.ctx, &cometabci.RequestProcessProposal{Height: 2})
+		s.Require().NoError(err)
+		s.Require().Equal(cometabci.ResponseProcessProposal_ACCEPT, resp.Status)
+
+		resp, err = handler(s.ctx, &cometabci.RequestProcessProposal{Txs: [][]byte{{0x01}}, Height: 2})
+		s.Require().NoError(err)
+		s.Require().Equal(cometabci.ResponseProcessProposal_ACCEPT, resp.Status)
+	})
+}
+
+func (s *ProposalsTestSuite) TestTracing() {
+	tx1, err := testutils.CreateRandomTx(
+		s.encodingConfig.TxConfig,
+		s.accounts[0],
+		0,
+		1,
+		0,
+		1,
+		sdk.NewCoin(s.gasTokenDenom, math.NewInt(2)),
+	)
+	s.Require().NoError(err)
+
+	tx2, err := testutils.CreateRandomTx(
+		s.encodingConfig.TxConfig,
+		s.accounts[1],
+		0,
+		1,
+		0,
+		1,
+		sdk.NewCoin(s.gasTokenDenom, math.NewInt(1)),
+	)
+	s.Require().NoError(err)
+
+	recorder := tracetest.NewSpanRecorder()
+	tracer := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)).Tracer("test")
+
+	// Only the first lane is traced.
+	tracedLane := s.setUpCustomMatchHandlerLaneWithConfig(
+		base.LaneConfig{
+			Logger:          log.NewNopLogger(),
+			TxEncoder:       s.encodingConfig.TxConfig.TxEncoder(),
+			TxDecoder:       s.encodingConfig.TxConfig.TxDecoder(),
+			AnteHandler:     s.setUpAnteHandler(map[sdk.Tx]bool{tx1: true, tx2: false}),
+			MaxBlockSpace:   math.LegacyMustNewDecFromStr("0.5"),
+			SignerExtractor: signeradaptors.NewDefaultAdapter(),
+			Tracer:          tracer,
+		},
+		base.DefaultMatchHandler(),
+		"traced",
+	)
+	defaultLane := s.setUpStandardLane(math.LegacyZeroDec(), map[sdk.Tx]bool{})
+
+	s.Require().NoError(tracedLane.Insert(sdk.Context{}, tx1))
+	s.Require().NoError(tracedLane.Insert(sdk.Context{}, tx2))
+
+	tx2Info, err := tracedLane.(*base.BaseLane).GetTxInfo(s.ctx, tx2)
+	s.Require().NoError(err)
+
+	handler := s.setUpProposalHandlers([]block.Lane{tracedLane, defaultLane}, abci.WithTracer(tracer))
+
+	s.Run("traces the proposal and each traced lane when preparing", func() {
+		maxTxBytes := s.ctx.ConsensusParams().Block.MaxBytes
+		resp, err := handler.PrepareProposalHandler()(s.ctx, &cometabci.RequestPrepareProposal{Height: 2, MaxTxBytes: maxTxBytes})
+		s.Require().NoError(err)
+		s.Require().Equal(s.getTxBytes(tx1), resp.Txs)
+
+		spans := recorder.Ended()
+		s.Require().Len(spans, 2)
+
+		laneSpan, proposalSpan := spans[0], spans[1]
+		s.Require().Equal("PrepareProposal", proposalSpan.Name())
+		s.Require().Equal("PrepareLane", laneSpan.Name())
+		s.Require().Equal(proposalSpan.SpanContext().SpanID(), laneSpan.Parent().SpanID())
+		s.Require().Contains(laneSpan.Attributes(), attribute.String("lane", "traced"))
+
+		// The invalid transaction is reported with the reason it was rejected.
+		s.Require().Len(laneSpan.Events(), 1)
+		event := laneSpan.Events()[0]
+		s.Require().Equal(base.RejectedTxEvent, event.Name)
+		s.Require().Contains(event.Attributes, attribute.String("tx_hash", tx2Info.Hash))
+		s.Require().Contains(event.Attributes, attribute.Bool("removed", true))
+		s.Require().Contains(event.Attributes, attribute.String("reason", "failed to verify tx: tx failed"))
+	})
+
+	s.Run("traces the proposal and each traced lane when processing", func() {
+		numSpans := len(recorder.Ended())
+
+		resp, err := handler.ProcessProposalHandler()(s.ctx, &cometabci.RequestProcessProposal{Txs: s.getTxBytes(tx1), Height: 2})
+		s.Require().NoError(err)
+		s.Require().Equal(cometabci.ResponseProcessProposal_ACCEPT, resp.Status)
+
+		spans := recorder.Ended()[numSpans:]
+		s.Require().Len(spans, 2)
+
+		laneSpan, proposalSpan := spans[0], spans[1]
+		s.Require().Equal("ProcessProposal", proposalSpan.Name())
+		s.Require().Equal("ProcessLane", laneSpan.Name())
+		s.Require().Equal(proposalSpan.SpanContext().SpanID(), laneSpan.Parent().SpanID())
+		s.Require().Equal(codes.Unset, proposalSpan.Status().Code)
+	})
+
+	s.Run("records the error of a rejected proposal", func() {
+		numSpans := len(recorder.Ended())
+
+		resp, err := handler.ProcessProposalHandler()(s.ctx, &cometabci.RequestProcessProposal{Txs: s.getTxBytes(tx2), Height: 2})
+		s.Require().Error(err)
+		s.Require().Equal(cometabci.ResponseProcessProposal_REJECT, resp.Status)
+
+		spans := recorder.Ended()[numSpans:]
+		s.Require().Len(spans, 2)
+
+		laneSpan, proposalSpan := spans[0], spans[1]
+		s.Require().Equal(codes.Error, laneSpan.Status().Code)
+		s.Require().Equal(codes.Error, proposalSpan.Status().Code)
+	})
+}
+
+func (s *ProposalsTestSuite) TestPrepareProposalTxPanic() {
+	tx1, err := testutils.CreateRandomTx(
+		s.encodingConfig.TxConfig,
+		s.accounts[0],
+		0,
+		1,
+		0,
+		1,
+		sdk.NewCoin(s.gasTokenDenom, math.NewInt(2)),
+	)
+	s.Require().NoError(err)
+
+	tx2, err := testutils.CreateRandomTx(
+		s.encodingConfig.TxConfig,
+		s.accounts[1],
+		0,
+		1,
+		0,
+		1,
+		sdk.NewCoin(s.gasTokenDenom, math.NewInt(1)),
+	)
+	s.Require().NoError(err)
+
+	recorder := tracetest.NewSpanRecorder()
+	tracer := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)).Tracer("test")
+
+	// The ante handler panics for tx2.
+	anteHandler := s.setUpAnteHandler(map[sdk.Tx]bool{tx1: true})
+	m := metricsmocks.NewMetrics(s.T())
+	lane := s.setUpCustomMatchHandlerLaneWithConfig(
+		base.LaneConfig{
+			Logger:    log.NewNopLogger(),
+			TxEncoder: s.encodingConfig.TxConfig.TxEncoder(),
+			TxDecoder: s.encodingConfig.TxConfig.TxDecoder(),
+			AnteHandler: func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+				if tx == tx2 {
+					panic("poisonous tx")
+				}
+
+				return anteHandler(ctx, tx, simulate)
+			},
+			MaxBlockSpace:   math.LegacyOneDec(),
+			SignerExtractor: signeradaptors.NewDefaultAdapter(),
+			Metrics:         m,
+			Tracer:          tracer,
+		},
+		base.DefaultMatchHandler(),
+		"poisoned",
+	)
+
+	s.Require().NoError(lane.Insert(sdk.Context{}, tx1))
+	s.Require().NoError(lane.Insert(sdk.Context{}, tx2))
+
+	tx2Info, err := lane.(*base.BaseLane).GetTxInfo(s.ctx, tx2)
+	s.Require().NoError(err)
+
+	// Only the transaction that panicked is removed; the lane is not skipped.
+	m.On("AddTxPanic", "poisoned").Once()
+	m.On("AddTxsEvicted", "poisoned", 1).Once()
+	m.On("SetLaneSize", "poisoned", 1).Once()
+	m.On("ObservePrepareLaneLatency", "poisoned", mock.Anything).Once()
+	m.On("ObservePartialProposal", "poisoned", 1, mock.Anything, uint64(1)).Once()
+
+	handler := s.setUpProposalHandlers([]block.Lane{lane}, abci.WithTracer(tracer))
+
+	s.Run("removes only the tx that panicked without skipping the lane", func() {
+		maxTxBytes := s.ctx.ConsensusParams().Block.MaxBytes
+		resp, err := handler.PrepareProposalHandler()(s.ctx, &cometabci.RequestPrepareProposal{Height: 2, MaxTxBytes: maxTxBytes})
+		s.Require().NoError(err)
+		s.Require().Equal(s.getTxBytes(tx1), resp.Txs)
+		s.Require().True(lane.Contains(tx1))
+		s.Require().False(lane.Contains(tx2))
+
+		// The panic is recorded so that the poisonous transaction can be found.
+		spans := recorder.Ended()
+		s.Require().Len(spans, 2)
+
+		laneSpan := spans[0]
+		s.Require().Equal("PrepareLane", laneSpan.Name())
+		s.Require().NotEmpty(laneSpan.Events())
+
+		event := laneSpan.Events()[0]
+		s.Require().Equal(base.TxPanicEvent, event.Name)
+		s.Require().Contains(event.Attributes, attribute.String("tx_hash", tx2Info.Hash))
+		s.Require().Contains(event.Attributes, attribute.String("panic", "poisonous tx"))
+	})
+}
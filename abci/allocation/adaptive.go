@@ -0,0 +1,559 @@
+package allocation
+
+import (
+	"fmt"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	cometabci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/block-sdk/v2/block"
+	"github.com/skip-mev/block-sdk/v2/block/proposals"
+	proposalstypes "github.com/skip-mev/block-sdk/v2/block/proposals/types"
+	"github.com/skip-mev/block-sdk/v2/block/utils"
+	blocksdktypes "github.com/skip-mev/block-sdk/v2/x/blocksdk/types"
+)
+
+type (
+	// LaneStore defines the interface used to read and write the lane configurations
+	// stored on-chain. This is implemented by the x/blocksdk keeper.
+	LaneStore interface {
+		GetLanes(ctx sdk.Context) ([]blocksdktypes.Lane, error)
+		SetLane(ctx sdk.Context, lane blocksdktypes.Lane) error
+	}
+
+	// ProposalHandler defines the interface used to determine how proposals are built. This
+	// is implemented by the Block SDK's abci.ProposalHandler.
+	ProposalHandler interface {
+		// UsesProposalInfo returns true if the proposal info is included in the first slot of
+		// every proposal (see abci.WithProposalInfo).
+		UsesProposalInfo() bool
+	}
+
+	// LaneBounds defines the range within which the max block space of a lane can be
+	// adjusted.
+	LaneBounds struct {
+		// MinBlockSpace is the smallest max block space the lane can be adjusted to.
+		MinBlockSpace math.LegacyDec
+		// MaxBlockSpace is the largest max block space the lane can be adjusted to.
+		MaxBlockSpace math.LegacyDec
+	}
+
+	// Config defines the parameters of the adaptive allocation policy.
+	Config struct {
+		// TargetUtilization is the fill ratio of a lane at which its max block space is
+		// left unchanged. Lanes that are filled above the target grow and lanes that are
+		// filled below the target shrink.
+		TargetUtilization math.LegacyDec
+
+		// MaxChangeRate bounds the relative change of the max block space of a lane per
+		// block. For example, a rate of 0.125 means that the max block space of a lane can
+		// change by at most 12.5% between two consecutive blocks.
+		MaxChangeRate math.LegacyDec
+
+		// Lanes maps the name of each lane that is adjusted by the policy to the range its
+		// max block space must stay within. Lanes that are not listed are only adjusted if no
+		// lane has an unlimited max block space, in which case they absorb the adjustments of
+		// the listed lanes such that the max block space of the lanes still sums to 1 (see
+		// rebalance). Adjustments that would result in an invalid lane configuration are
+		// skipped.
+		Lanes map[string]LaneBounds
+
+		// MempoolDepth enables the depth of each lane's mempool as a demand signal. The
+		// depth is reported by the proposer in the proposal info, so the proposal handler
+		// must include it in proposals (see abci.WithProposalInfo). A lane that included
+		// n of the d transactions in its mempool has its utilization scaled by d / n (up to
+		// a fill ratio of 1), i.e. it is considered to need the block space it would take
+		// to include its whole mempool.
+		// NOTE: The depth cannot be verified by other validators. A proposer that misreports
+		// it can only move the max block space of a lane by MaxChangeRate, within its bounds.
+		MempoolDepth bool
+	}
+
+	// AdaptiveAllocation is an allocation policy that adjusts the max block space of each
+	// lane based on how much of its block space the lane used in the previous block. The
+	// adjustment works similarly to EIP-1559: the max block space of a lane is multiplied
+	// by 1 + MaxChangeRate * (utilization - target) / target and bounded by the lane's
+	// configured range.
+	//
+	// The max block space of each lane is kept on-chain (in x/blocksdk) and is updated in
+	// the PreBlocker of the block being finalized. The update only depends on the block's
+	// transactions (including the proposal info) and on committed state, so every validator
+	// arrives at the same lane configuration, which is then read by both PrepareProposal and
+	// ProcessProposal (see LanedMempool.GetProposalLanes) to compute identical LaneLimits.
+	// The lanes of the mempool are only read to attribute the block's transactions to lanes.
+	//
+	// The depth of each node's mempool is local to the node, so only the depth reported by
+	// the proposer in the proposal info is used (see Config.MempoolDepth).
+	AdaptiveAllocation struct {
+		logger          log.Logger
+		txDecoder       sdk.TxDecoder
+		mempool         block.Mempool
+		proposalHandler ProposalHandler
+		store           LaneStore
+		cfg             Config
+	}
+)
+
+// DefaultConfig returns a default configuration for the adaptive allocation policy. Lanes
+// that should be adjusted must be added to the Lanes field.
+func DefaultConfig() Config {
+	return Config{
+		TargetUtilization: math.LegacyMustNewDecFromStr("0.5"),
+		MaxChangeRate:     math.LegacyMustNewDecFromStr("0.125"),
+		Lanes:             make(map[string]LaneBounds),
+	}
+}
+
+// ValidateBasic validates the configuration of the adaptive allocation policy.
+func (c Config) ValidateBasic() error {
+	if c.TargetUtilization.IsNil() || !c.TargetUtilization.IsPositive() || c.TargetUtilization.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("target utilization must be set to a value greater than 0 and at most 1")
+	}
+
+	if c.MaxChangeRate.IsNil() || c.MaxChangeRate.IsNegative() || c.MaxChangeRate.GTE(math.LegacyOneDec()) {
+		return fmt.Errorf("max change rate must be set to a value between 0 and 1 (exclusive)")
+	}
+
+	for name, bounds := range c.Lanes {
+		if bounds.MinBlockSpace.IsNil() || bounds.MaxBlockSpace.IsNil() {
+			return fmt.Errorf("bounds of lane %s must be set", name)
+		}
+
+		if !bounds.MinBlockSpace.IsPositive() || bounds.MaxBlockSpace.GT(math.LegacyOneDec()) || bounds.MinBlockSpace.GT(bounds.MaxBlockSpace) {
+			return fmt.Errorf("bounds of lane %s must satisfy 0 < min <= max <= 1", name)
+		}
+	}
+
+	return nil
+}
+
+// NewAdaptiveAllocation returns a new adaptive allocation policy. The proposal handler must be
+// the one used to build and verify proposals, such that the policy knows whether proposals
+// include the proposal info.
+func NewAdaptiveAllocation(
+	logger log.Logger,
+	txDecoder sdk.TxDecoder,
+	mempool block.Mempool,
+	proposalHandler ProposalHandler,
+	store LaneStore,
+	cfg Config,
+) (*AdaptiveAllocation, error) {
+	if err := cfg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if cfg.MempoolDepth && !proposalHandler.UsesProposalInfo() {
+		return nil, fmt.Errorf("mempool depth requires the proposal info to be included in proposals")
+	}
+
+	return &AdaptiveAllocation{
+		logger:          logger,
+		txDecoder:       txDecoder,
+		mempool:         mempool,
+		proposalHandler: proposalHandler,
+		store:           store,
+		cfg:             cfg,
+	}, nil
+}
+
+// PreBlocker returns a PreBlocker that updates the lane allocations using the transactions
+// of the block being finalized before calling the given PreBlocker (if any).
+func (a *AdaptiveAllocation) PreBlocker(next sdk.PreBlocker) sdk.PreBlocker {
+	return func(ctx sdk.Context, req *cometabci.RequestFinalizeBlock) (*sdk.ResponsePreBlock, error) {
+		// Failing to update the allocations must never halt the chain, so errors (and panics)
+		// are only logged. All validators fail (or succeed) in the same way.
+		cacheCtx, write := ctx.CacheContext()
+		if err := a.updateAllocations(cacheCtx, req.Txs); err != nil {
+			a.logger.Error("failed to update lane allocations", "err", err)
+		} else {
+			write()
+		}
+
+		if next == nil {
+			return &sdk.ResponsePreBlock{}, nil
+		}
+
+		return next(ctx, req)
+	}
+}
+
+// updateAllocations calls UpdateAllocations, recovering from panics (e.g. in a lane's match
+// handler) such that they fail the update rather than the block.
+func (a *AdaptiveAllocation) updateAllocations(ctx sdk.Context, txs [][]byte) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic while updating lane allocations: %v", r)
+		}
+	}()
+
+	return a.UpdateAllocations(ctx, txs)
+}
+
+// UpdateAllocations updates the max block space of each lane managed by the policy based on
+// its demand in the block with the given transactions. The lane configurations are only
+// written if the resulting set of lanes is valid.
+func (a *AdaptiveAllocation) UpdateAllocations(ctx sdk.Context, txs [][]byte) error {
+	configs, err := a.store.GetLanes(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get lanes from state: %w", err)
+	}
+
+	// The policy requires the lane configurations to be stored on-chain.
+	if len(configs) == 0 {
+		return nil
+	}
+
+	lanes := a.mempool.GetProposalLanes(ctx)
+
+	var info *proposalstypes.ProposalInfo
+	if a.proposalHandler.UsesProposalInfo() {
+		proposalInfo, proposalTxs, err := proposals.GetProposalInfo(txs)
+		if err != nil {
+			return err
+		}
+
+		info, txs = &proposalInfo, proposalTxs
+	}
+
+	utilization, err := a.GetUtilization(ctx, lanes, info, txs)
+	if err != nil {
+		return err
+	}
+
+	if a.cfg.MempoolDepth {
+		utilization = applyMempoolDepth(utilization, *info)
+	}
+
+	var (
+		updated   = make(blocksdktypes.Lanes, len(configs))
+		adjusted  = make([]bool, len(configs))
+		unlimited bool
+	)
+	for i, config := range configs {
+		updated[i] = config
+		unlimited = unlimited || config.MaxBlockSpace.IsZero()
+
+		bounds, ok := a.cfg.Lanes[config.Id]
+		if !ok || config.MaxBlockSpace.IsZero() {
+			continue
+		}
+
+		lane, _, found := block.FindLane(lanes.Lanes, config.Id)
+		if !found {
+			return fmt.Errorf("lane %s in state not found in the mempool", config.Id)
+		}
+
+		updated[i].MaxBlockSpace = a.adjust(config.MaxBlockSpace, utilization[config.Id], bounds, lanes.GetBlockSpace(lane))
+		adjusted[i] = true
+	}
+
+	// Without an unlimited lane, the max block space of the lanes must sum to 1.
+	if !unlimited {
+		rebalance(configs, updated, adjusted, lanes)
+	}
+
+	if err := updated.ValidateBasic(); err != nil {
+		return fmt.Errorf("adjusted lane configuration is invalid: %w", err)
+	}
+
+	for i, lane := range updated {
+		if lane.MaxBlockSpace.Equal(configs[i].MaxBlockSpace) {
+			continue
+		}
+
+		a.logger.Info(
+			"adjusting lane allocation",
+			"lane", lane.Id,
+			"utilization", utilization[lane.Id],
+			"previous_max_block_space", configs[i].MaxBlockSpace,
+			"max_block_space", lane.MaxBlockSpace,
+		)
+
+		if err := a.store.SetLane(ctx, lane); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetUtilization returns the fill ratio of each lane in the block with the given transactions
+// (excluding the proposal info). Transactions are attributed to lanes the same way they are
+// verified in ProcessProposal: if the proposal info is given, by the number of transactions it
+// declares for each lane. Otherwise, each lane, in order, takes the leading transactions that
+// make up its partial proposal (see block.PartialProposalCounter). The limits of each lane
+// are computed exactly as they were when the block was built. The fill ratio of a lane is the
+// larger of its byte and gas utilization.
+func (a *AdaptiveAllocation) GetUtilization(
+	ctx sdk.Context,
+	lanes block.ProposalLanes,
+	info *proposalstypes.ProposalInfo,
+	txs [][]byte,
+) (map[string]math.LegacyDec, error) {
+	decodedTxs, err := utils.GetDecodedTxs(a.txDecoder, txs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode txs: %w", err)
+	}
+
+	maxBlockSize, maxGasLimit := proposals.GetBlockLimits(ctx)
+	proposal := lanes.NewProposal(a.logger, maxBlockSize, maxGasLimit)
+
+	registry := lanes.Lanes
+	utilization := make(map[string]math.LegacyDec, len(registry))
+
+	for i, lane := range registry {
+		remaining := make([]proposals.Lane, 0, len(registry)-i-1)
+		for _, next := range registry[i+1:] {
+			remaining = append(remaining, next)
+		}
+
+		proposal.AllocateLane(lane, remaining)
+		limits := proposal.Allocation.Limits
+
+		// Collect the transactions that belong to the lane.
+		numTxs, err := countLaneTxs(ctx, lane, info, decodedTxs)
+		if err != nil {
+			return nil, err
+		}
+
+		txsWithInfo := make([]utils.TxWithInfo, numTxs)
+		for j, tx := range decodedTxs[:numTxs] {
+			if txsWithInfo[j], err = lane.GetTxInfo(ctx, tx); err != nil {
+				return nil, fmt.Errorf("failed to get tx info: %w", err)
+			}
+		}
+		decodedTxs = decodedTxs[numTxs:]
+
+		var (
+			size     int64
+			gasLimit uint64
+		)
+		for _, txInfo := range txsWithInfo {
+			size += txInfo.Size
+			gasLimit += txInfo.GasLimit
+		}
+
+		utilization[lane.Name()] = math.LegacyMaxDec(
+			fillRatio(math.NewInt(size), math.NewInt(limits.MaxTxBytes)),
+			fillRatio(math.NewIntFromUint64(gasLimit), math.NewIntFromUint64(limits.MaxGasLimit)),
+		)
+
+		// Account for the block space used by the lane so that the limits of the next
+		// lanes are computed correctly.
+		proposal.Info.BlockSize += size
+		proposal.Info.GasLimit += gasLimit
+	}
+
+	if len(decodedTxs) > 0 {
+		return nil, fmt.Errorf("%d transactions do not belong to any lane", len(decodedTxs))
+	}
+
+	return utilization, nil
+}
+
+// countLaneTxs returns the number of leading transactions of txs that belong to the lane.
+func countLaneTxs(ctx sdk.Context, lane block.Lane, info *proposalstypes.ProposalInfo, txs []sdk.Tx) (int, error) {
+	if info != nil {
+		numTxs := info.TxsByLane[lane.Name()]
+		if numTxs > uint64(len(txs)) {
+			return 0, fmt.Errorf(
+				"proposal info declares %d transactions for lane %s but only %d remain",
+				numTxs,
+				lane.Name(),
+				len(txs),
+			)
+		}
+
+		return int(numTxs), nil
+	}
+
+	if counter, ok := lane.(block.PartialProposalCounter); ok {
+		return counter.CountPartialProposal(ctx, txs), nil
+	}
+
+	var numTxs int
+	for numTxs < len(txs) && lane.Match(ctx, txs[numTxs]) {
+		numTxs++
+	}
+
+	return numTxs, nil
+}
+
+// applyMempoolDepth scales the utilization of each lane by the ratio of the depth of its
+// mempool reported by the proposer to the number of transactions it included, capped at 1.
+// Lanes that did not include any transactions keep their utilization.
+func applyMempoolDepth(utilization map[string]math.LegacyDec, info proposalstypes.ProposalInfo) map[string]math.LegacyDec {
+	scaled := make(map[string]math.LegacyDec, len(utilization))
+	for lane, fill := range utilization {
+		scaled[lane] = fill
+
+		numTxs, depth := info.TxsByLane[lane], info.MempoolDepthByLane[lane]
+		if numTxs == 0 || depth <= numTxs {
+			continue
+		}
+
+		scaled[lane] = math.LegacyMinDec(
+			fill.MulInt(math.NewIntFromUint64(depth)).QuoInt(math.NewIntFromUint64(numTxs)),
+			math.LegacyOneDec(),
+		)
+	}
+
+	return scaled
+}
+
+// adjust returns the adjusted max block space of a lane given its utilization. The result is
+// bounded by the configured range of the lane as well as the block space the lane reserves.
+func (a *AdaptiveAllocation) adjust(
+	maxBlockSpace math.LegacyDec,
+	utilization math.LegacyDec,
+	bounds LaneBounds,
+	space proposals.BlockSpace,
+) math.LegacyDec {
+	if utilization.IsNil() {
+		utilization = math.LegacyZeroDec()
+	}
+
+	// change = MaxChangeRate * (utilization - target) / target, bounded to [-MaxChangeRate, MaxChangeRate].
+	change := a.cfg.MaxChangeRate.Mul(utilization.Sub(a.cfg.TargetUtilization)).Quo(a.cfg.TargetUtilization)
+	change = math.LegacyMinDec(math.LegacyMaxDec(change, a.cfg.MaxChangeRate.Neg()), a.cfg.MaxChangeRate)
+
+	adjusted := maxBlockSpace.Mul(math.LegacyOneDec().Add(change))
+
+	adjusted = math.LegacyMaxDec(adjusted, bounds.MinBlockSpace)
+	adjusted = math.LegacyMinDec(adjusted, bounds.MaxBlockSpace)
+
+	// The max block space can never be smaller than what the lane reserves for itself,
+	// otherwise the lane configuration would be rejected when proposals are built.
+	for _, reserved := range []math.LegacyDec{space.MinTxBytes, space.MinGasLimit} {
+		if !reserved.IsNil() {
+			adjusted = math.LegacyMaxDec(adjusted, reserved)
+		}
+	}
+
+	return adjusted
+}
+
+// rebalance keeps the max block space of the lanes summing to 1 once the lanes managed by the
+// policy are adjusted, given that it summed to 1 before. The lanes that are not adjusted absorb
+// the adjustments in proportion to their max block space, without shrinking below the block
+// space they reserve (or to zero, which would make them unlimited). Whatever they cannot absorb
+// is taken back from the adjustments, in proportion to the adjustment of each lane.
+func rebalance(configs, updated blocksdktypes.Lanes, adjusted []bool, lanes block.ProposalLanes) {
+	excess := math.LegacyZeroDec()
+	for _, lane := range updated {
+		excess = excess.Add(lane.MaxBlockSpace)
+	}
+	excess = excess.Sub(math.LegacyOneDec())
+
+	if excess.IsZero() {
+		return
+	}
+
+	// The lanes that are not adjusted shrink (down to their reserved block space) if the
+	// adjusted lanes grew and grow if the adjusted lanes shrank.
+	weights := make([]math.LegacyDec, len(updated))
+	for i, lane := range updated {
+		weights[i] = math.LegacyZeroDec()
+		if adjusted[i] {
+			continue
+		}
+
+		if excess.IsNegative() {
+			weights[i] = lane.MaxBlockSpace
+			continue
+		}
+
+		floor := math.LegacySmallestDec()
+		if l, _, found := block.FindLane(lanes.Lanes, lane.Id); found {
+			space := lanes.GetBlockSpace(l)
+			for _, reserved := range []math.LegacyDec{space.MinTxBytes, space.MinGasLimit} {
+				if !reserved.IsNil() {
+					floor = math.LegacyMaxDec(floor, reserved)
+				}
+			}
+		}
+
+		if slack := lane.MaxBlockSpace.Sub(floor); slack.IsPositive() {
+			weights[i] = slack
+		}
+	}
+
+	remaining := distribute(updated, weights, excess)
+	if remaining.IsZero() {
+		return
+	}
+
+	// Take back the rest from the adjustments that caused the excess.
+	for i, lane := range updated {
+		weights[i] = math.LegacyZeroDec()
+		if !adjusted[i] {
+			continue
+		}
+
+		if change := lane.MaxBlockSpace.Sub(configs[i].MaxBlockSpace); change.IsPositive() == remaining.IsPositive() {
+			weights[i] = change.Abs()
+		}
+	}
+
+	distribute(updated, weights, remaining)
+}
+
+// distribute subtracts the given amount from the max block space of the lanes in proportion to
+// their weights, without subtracting more than the weight of a lane (in absolute value). It
+// returns the part of the amount that could not be distributed.
+func distribute(lanes blocksdktypes.Lanes, weights []math.LegacyDec, amount math.LegacyDec) math.LegacyDec {
+	total := math.LegacyZeroDec()
+	last := -1
+	for i, weight := range weights {
+		if weight.IsPositive() {
+			total = total.Add(weight)
+			last = i
+		}
+	}
+
+	if last < 0 {
+		return amount
+	}
+
+	// Lanes can only shrink by their weight, while they can grow by any amount.
+	distributed := amount
+	if amount.IsPositive() {
+		distributed = math.LegacyMinDec(amount, total)
+	}
+
+	// The last lane takes the rounding error such that exactly the distributed amount is
+	// subtracted, unless every lane shrinks by its whole weight.
+	left := distributed
+	for i, weight := range weights {
+		if !weight.IsPositive() {
+			continue
+		}
+
+		var share math.LegacyDec
+		switch {
+		case distributed.Equal(total):
+			share = weight
+		case i == last:
+			share = left
+		default:
+			share = distributed.MulTruncate(weight).QuoTruncate(total)
+		}
+
+		lanes[i].MaxBlockSpace = lanes[i].MaxBlockSpace.Sub(share)
+		left = left.Sub(share)
+	}
+
+	return amount.Sub(distributed)
+}
+
+// fillRatio returns used / limit capped at 1. A lane without any capacity is considered to
+// be empty.
+func fillRatio(used, limit math.Int) math.LegacyDec {
+	if !limit.IsPositive() {
+		return math.LegacyZeroDec()
+	}
+
+	return math.LegacyMinDec(math.LegacyNewDecFromInt(used).QuoInt(limit), math.LegacyOneDec())
+}
@@ -0,0 +1,467 @@
+package allocation_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	cometabci "github.com/cometbft/cometbft/abci/types"
+	tmprototypes "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/skip-mev/block-sdk/v2/abci"
+	"github.com/skip-mev/block-sdk/v2/abci/allocation"
+	signeradaptors "github.com/skip-mev/block-sdk/v2/adapters/signer_extraction_adapter"
+	"github.com/skip-mev/block-sdk/v2/block"
+	"github.com/skip-mev/block-sdk/v2/block/base"
+	"github.com/skip-mev/block-sdk/v2/block/proposals"
+	proposalstypes "github.com/skip-mev/block-sdk/v2/block/proposals/types"
+	"github.com/skip-mev/block-sdk/v2/block/utils"
+	defaultlane "github.com/skip-mev/block-sdk/v2/lanes/base"
+	testutils "github.com/skip-mev/block-sdk/v2/testutils"
+	"github.com/skip-mev/block-sdk/v2/x/blocksdk/keeper"
+	blocksdktypes "github.com/skip-mev/block-sdk/v2/x/blocksdk/types"
+)
+
+type AdaptiveAllocationTestSuite struct {
+	suite.Suite
+
+	ctx            sdk.Context
+	encodingConfig testutils.EncodingConfig
+	accounts       []testutils.Account
+	gasTokenDenom  string
+
+	keeper     keeper.Keeper
+	lane       block.Lane
+	mempool    *block.LanedMempool
+	allocation *allocation.AdaptiveAllocation
+}
+
+func TestAdaptiveAllocationTestSuite(t *testing.T) {
+	suite.Run(t, new(AdaptiveAllocationTestSuite))
+}
+
+func (s *AdaptiveAllocationTestSuite) SetupTest() {
+	s.encodingConfig = testutils.CreateTestEncodingConfig()
+	s.accounts = testutils.RandomAccounts(rand.New(rand.NewSource(1)), 2)
+	s.gasTokenDenom = "stake"
+
+	key := storetypes.NewKVStoreKey(blocksdktypes.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(s.T(), key, storetypes.NewTransientStoreKey("transient_test"))
+	s.ctx = testCtx.Ctx.WithConsensusParams(
+		tmprototypes.ConsensusParams{
+			Block: &tmprototypes.BlockParams{
+				MaxBytes: 1000000000,
+				MaxGas:   100,
+			},
+		},
+	)
+
+	s.keeper = keeper.NewKeeper(s.encodingConfig.Codec, key, sdk.AccAddress([]byte("authority")).String())
+	s.keeper.InitGenesis(s.ctx, *blocksdktypes.NewGenesisState([]blocksdktypes.Lane{
+		blocksdktypes.NewLane("adaptive", math.LegacyMustNewDecFromStr("0.2"), 0),
+		blocksdktypes.NewLane(defaultlane.LaneName, math.LegacyZeroDec(), 1),
+	}))
+
+	// The adaptive lane only matches transactions signed by the first account.
+	signerExtractor := signeradaptors.NewDefaultAdapter()
+	s.setUpMempool(func(_ sdk.Context, tx sdk.Tx) bool {
+		signers, err := signerExtractor.GetSigners(tx)
+		return err == nil && len(signers) > 0 && signers[0].Signer.Equals(s.accounts[0].Address)
+	})
+
+	s.allocation = s.newAdaptiveAllocation(s.defaultConfig())
+}
+
+// setUpMempool sets up a mempool with the adaptive lane, which uses the given match handler,
+// followed by the default lane.
+func (s *AdaptiveAllocationTestSuite) setUpMempool(mh base.MatchHandler) {
+	cfg := base.LaneConfig{
+		Logger:          log.NewNopLogger(),
+		TxEncoder:       s.encodingConfig.TxConfig.TxEncoder(),
+		TxDecoder:       s.encodingConfig.TxConfig.TxDecoder(),
+		MaxBlockSpace:   math.LegacyMustNewDecFromStr("0.2"),
+		SignerExtractor: signeradaptors.NewDefaultAdapter(),
+	}
+
+	var err error
+	s.lane, err = base.NewBaseLane(
+		cfg,
+		"adaptive",
+		base.WithMatchHandler(mh),
+		base.WithMempoolConfigs(cfg, base.DefaultTxPriority()),
+	)
+	s.Require().NoError(err)
+
+	defaultCfg := cfg
+	defaultCfg.MaxBlockSpace = math.LegacyZeroDec()
+	defaultLane := defaultlane.NewDefaultLane(defaultCfg, base.DefaultMatchHandler())
+
+	s.mempool, err = block.NewLanedMempoolWithLaneFetcher(log.NewNopLogger(), []block.Lane{s.lane, defaultLane}, s.keeper)
+	s.Require().NoError(err)
+}
+
+// newAdaptiveAllocation returns an adaptive allocation policy with the given config for
+// proposals built by a proposal handler with the given options.
+func (s *AdaptiveAllocationTestSuite) newAdaptiveAllocation(
+	config allocation.Config,
+	opts ...abci.ProposalHandlerOption,
+) *allocation.AdaptiveAllocation {
+	adaptiveAllocation, err := allocation.NewAdaptiveAllocation(
+		log.NewNopLogger(),
+		s.encodingConfig.TxConfig.TxDecoder(),
+		s.mempool,
+		s.newProposalHandler(opts...),
+		s.keeper,
+		config,
+	)
+	s.Require().NoError(err)
+
+	return adaptiveAllocation
+}
+
+// newProposalHandler returns a proposal handler for the mempool with the given options.
+func (s *AdaptiveAllocationTestSuite) newProposalHandler(opts ...abci.ProposalHandlerOption) *abci.ProposalHandler {
+	return abci.New(
+		log.NewNopLogger(),
+		s.encodingConfig.TxConfig.TxDecoder(),
+		s.encodingConfig.TxConfig.TxEncoder(),
+		s.mempool,
+		true,
+		opts...,
+	)
+}
+
+// defaultConfig returns the config of the adaptive allocation policy used by the tests.
+func (s *AdaptiveAllocationTestSuite) defaultConfig() allocation.Config {
+	config := allocation.DefaultConfig()
+	config.Lanes["adaptive"] = allocation.LaneBounds{
+		MinBlockSpace: math.LegacyMustNewDecFromStr("0.1"),
+		MaxBlockSpace: math.LegacyMustNewDecFromStr("0.25"),
+	}
+
+	return config
+}
+
+// getProposalWithInfo returns the given transactions preceded by the proposal info, which
+// declares the number of transactions included by each lane and the mempool depth of each lane.
+func (s *AdaptiveAllocationTestSuite) getProposalWithInfo(txs [][]byte, txsByLane, depthByLane map[string]uint64) [][]byte {
+	proposal := proposals.NewProposalWithContext(s.ctx, log.NewNopLogger())
+	proposal.Txs = txs
+	proposal.Info.TxsByLane = txsByLane
+	proposal.Info.MempoolDepthByLane = depthByLane
+
+	proposalTxs, err := proposal.GetProposalWithInfo()
+	s.Require().NoError(err)
+
+	return proposalTxs
+}
+
+// createTxs creates n transactions from the given account that each consume 10 gas.
+func (s *AdaptiveAllocationTestSuite) createTxs(account testutils.Account, n int) [][]byte {
+	txs := make([]sdk.Tx, n)
+	for i := range txs {
+		tx, err := testutils.CreateRandomTx(
+			s.encodingConfig.TxConfig,
+			account,
+			uint64(i),
+			1,
+			0,
+			10,
+			sdk.NewCoin(s.gasTokenDenom, math.NewInt(1000000)),
+		)
+		s.Require().NoError(err)
+
+		txs[i] = tx
+	}
+
+	txBzs, err := utils.GetEncodedTxs(s.encodingConfig.TxConfig.TxEncoder(), txs)
+	s.Require().NoError(err)
+
+	return txBzs
+}
+
+func (s *AdaptiveAllocationTestSuite) getMaxBlockSpace() math.LegacyDec {
+	lane, err := s.keeper.GetLane(s.ctx, "adaptive")
+	s.Require().NoError(err)
+
+	return lane.MaxBlockSpace
+}
+
+func (s *AdaptiveAllocationTestSuite) getDefaultMaxBlockSpace() math.LegacyDec {
+	lane, err := s.keeper.GetLane(s.ctx, defaultlane.LaneName)
+	s.Require().NoError(err)
+
+	return lane.MaxBlockSpace
+}
+
+func (s *AdaptiveAllocationTestSuite) TestGetUtilization() {
+	lanes := s.mempool.GetProposalLanes(s.ctx)
+
+	// The adaptive lane can use 20 gas and uses 10, the default lane uses 30 of the
+	// remaining 90 gas.
+	txs := append(s.createTxs(s.accounts[0], 1), s.createTxs(s.accounts[1], 3)...)
+
+	utilization, err := s.allocation.GetUtilization(s.ctx, lanes, nil, txs)
+	s.Require().NoError(err)
+	s.Require().Equal(math.LegacyMustNewDecFromStr("0.5"), utilization["adaptive"])
+	s.Require().Equal(math.LegacyNewDec(30).QuoInt64(90), utilization[defaultlane.LaneName])
+
+	// Transactions the adaptive lane does not match on their own (e.g. the bundled transactions
+	// of an auction bid) are attributed to it if the proposal info says so.
+	info := &proposalstypes.ProposalInfo{TxsByLane: map[string]uint64{"adaptive": 2, defaultlane.LaneName: 2}}
+	utilization, err = s.allocation.GetUtilization(s.ctx, lanes, info, txs)
+	s.Require().NoError(err)
+	s.Require().Equal(math.LegacyOneDec(), utilization["adaptive"])
+	s.Require().Equal(math.LegacyNewDec(20).QuoInt64(80), utilization[defaultlane.LaneName])
+
+	// The proposal info must account for every transaction.
+	info = &proposalstypes.ProposalInfo{TxsByLane: map[string]uint64{"adaptive": 1, defaultlane.LaneName: 2}}
+	_, err = s.allocation.GetUtilization(s.ctx, lanes, info, txs)
+	s.Require().Error(err)
+}
+
+func (s *AdaptiveAllocationTestSuite) TestUpdateAllocations() {
+	s.Run("a full lane grows", func() {
+		s.SetupTest()
+
+		s.Require().NoError(s.allocation.UpdateAllocations(s.ctx, s.createTxs(s.accounts[0], 2)))
+		s.Require().Equal(math.LegacyMustNewDecFromStr("0.225"), s.getMaxBlockSpace())
+
+		// The updated allocation is used when the next proposal is built, without
+		// modifying the lane itself.
+		lanes := s.mempool.GetProposalLanes(s.ctx)
+		s.Require().Equal(math.LegacyMustNewDecFromStr("0.225"), lanes.GetBlockSpace(s.lane).MaxTxBytes)
+		s.Require().Equal(math.LegacyMustNewDecFromStr("0.2"), s.lane.GetMaxBlockSpace())
+	})
+
+	s.Run("a lane at the target utilization does not change", func() {
+		s.SetupTest()
+
+		s.Require().NoError(s.allocation.UpdateAllocations(s.ctx, s.createTxs(s.accounts[0], 1)))
+		s.Require().Equal(math.LegacyMustNewDecFromStr("0.2"), s.getMaxBlockSpace())
+	})
+
+	s.Run("an empty lane shrinks", func() {
+		s.SetupTest()
+
+		s.Require().NoError(s.allocation.UpdateAllocations(s.ctx, nil))
+		s.Require().Equal(math.LegacyMustNewDecFromStr("0.175"), s.getMaxBlockSpace())
+	})
+
+	s.Run("adjustments are bounded", func() {
+		s.SetupTest()
+
+		for i := 0; i < 10; i++ {
+			s.Require().NoError(s.allocation.UpdateAllocations(s.ctx, nil))
+		}
+		s.Require().Equal(math.LegacyMustNewDecFromStr("0.1"), s.getMaxBlockSpace())
+
+		for i := 0; i < 10; i++ {
+			s.Require().NoError(s.allocation.UpdateAllocations(s.ctx, s.createTxs(s.accounts[0], 3)))
+		}
+		s.Require().Equal(math.LegacyMustNewDecFromStr("0.25"), s.getMaxBlockSpace())
+	})
+
+	s.Run("lanes that are not managed by the policy are not adjusted", func() {
+		s.SetupTest()
+
+		s.Require().NoError(s.allocation.UpdateAllocations(s.ctx, nil))
+
+		lane, err := s.keeper.GetLane(s.ctx, defaultlane.LaneName)
+		s.Require().NoError(err)
+		s.Require().True(lane.MaxBlockSpace.IsZero())
+	})
+
+	s.Run("lanes that are not managed by the policy absorb the adjustments without an unlimited lane", func() {
+		s.SetupTest()
+		s.Require().NoError(s.keeper.SetLane(s.ctx, blocksdktypes.NewLane(defaultlane.LaneName, math.LegacyMustNewDecFromStr("0.8"), 1)))
+
+		s.Require().NoError(s.allocation.UpdateAllocations(s.ctx, s.createTxs(s.accounts[0], 2)))
+		s.Require().Equal(math.LegacyMustNewDecFromStr("0.225"), s.getMaxBlockSpace())
+		s.Require().Equal(math.LegacyMustNewDecFromStr("0.775"), s.getDefaultMaxBlockSpace())
+
+		s.Require().NoError(s.allocation.UpdateAllocations(s.ctx, nil))
+		s.Require().Equal(math.LegacyMustNewDecFromStr("0.196875"), s.getMaxBlockSpace())
+		s.Require().Equal(math.LegacyMustNewDecFromStr("0.803125"), s.getDefaultMaxBlockSpace())
+	})
+
+	s.Run("adjustments offset each other if every lane is managed by the policy", func() {
+		s.SetupTest()
+		s.Require().NoError(s.keeper.SetLane(s.ctx, blocksdktypes.NewLane(defaultlane.LaneName, math.LegacyMustNewDecFromStr("0.8"), 1)))
+
+		config := s.defaultConfig()
+		config.Lanes[defaultlane.LaneName] = allocation.LaneBounds{
+			MinBlockSpace: math.LegacyMustNewDecFromStr("0.7"),
+			MaxBlockSpace: math.LegacyMustNewDecFromStr("0.8"),
+		}
+		s.allocation = s.newAdaptiveAllocation(config)
+
+		// The adaptive lane grows by 0.025 while the empty default lane would shrink by 0.1,
+		// so the default lane only gives up the space the adaptive lane takes.
+		s.Require().NoError(s.allocation.UpdateAllocations(s.ctx, s.createTxs(s.accounts[0], 2)))
+		s.Require().Equal(math.LegacyMustNewDecFromStr("0.225"), s.getMaxBlockSpace())
+		s.Require().Equal(math.LegacyMustNewDecFromStr("0.775"), s.getDefaultMaxBlockSpace())
+	})
+}
+
+func (s *AdaptiveAllocationTestSuite) TestUpdateAllocationsWithProposalInfo() {
+	s.Run("skips the proposal info", func() {
+		s.SetupTest()
+
+		adaptiveAllocation := s.newAdaptiveAllocation(s.defaultConfig(), abci.WithProposalInfo())
+
+		txs := s.getProposalWithInfo(s.createTxs(s.accounts[0], 2), map[string]uint64{"adaptive": 2}, nil)
+		s.Require().NoError(adaptiveAllocation.UpdateAllocations(s.ctx, txs))
+		s.Require().Equal(math.LegacyMustNewDecFromStr("0.225"), s.getMaxBlockSpace())
+	})
+
+	s.Run("a lane at the target utilization grows with a deep mempool", func() {
+		s.SetupTest()
+
+		config := s.defaultConfig()
+		config.MempoolDepth = true
+		adaptiveAllocation := s.newAdaptiveAllocation(config, abci.WithProposalInfo())
+
+		txs := s.getProposalWithInfo(
+			s.createTxs(s.accounts[0], 1),
+			map[string]uint64{"adaptive": 1},
+			map[string]uint64{"adaptive": 4},
+		)
+		s.Require().NoError(adaptiveAllocation.UpdateAllocations(s.ctx, txs))
+		s.Require().Equal(math.LegacyMustNewDecFromStr("0.225"), s.getMaxBlockSpace())
+	})
+
+	s.Run("the mempool depth is ignored unless enabled", func() {
+		s.SetupTest()
+
+		adaptiveAllocation := s.newAdaptiveAllocation(s.defaultConfig(), abci.WithProposalInfo())
+
+		txs := s.getProposalWithInfo(
+			s.createTxs(s.accounts[0], 1),
+			map[string]uint64{"adaptive": 1},
+			map[string]uint64{"adaptive": 4},
+		)
+		s.Require().NoError(adaptiveAllocation.UpdateAllocations(s.ctx, txs))
+		s.Require().Equal(math.LegacyMustNewDecFromStr("0.2"), s.getMaxBlockSpace())
+	})
+
+	s.Run("the mempool depth requires the proposal info", func() {
+		s.SetupTest()
+
+		config := s.defaultConfig()
+		config.MempoolDepth = true
+
+		_, err := allocation.NewAdaptiveAllocation(
+			log.NewNopLogger(),
+			s.encodingConfig.TxConfig.TxDecoder(),
+			s.mempool,
+			s.newProposalHandler(),
+			s.keeper,
+			config,
+		)
+		s.Require().Error(err)
+	})
+}
+
+func (s *AdaptiveAllocationTestSuite) TestPreBlocker() {
+	s.Run("updates the allocations and calls the next pre-blocker", func() {
+		s.SetupTest()
+
+		called := false
+		next := func(_ sdk.Context, _ *cometabci.RequestFinalizeBlock) (*sdk.ResponsePreBlock, error) {
+			called = true
+			return &sdk.ResponsePreBlock{}, nil
+		}
+
+		_, err := s.allocation.PreBlocker(next)(s.ctx, &cometabci.RequestFinalizeBlock{Txs: s.createTxs(s.accounts[0], 2)})
+		s.Require().NoError(err)
+		s.Require().True(called)
+		s.Require().Equal(math.LegacyMustNewDecFromStr("0.225"), s.getMaxBlockSpace())
+	})
+
+	s.Run("does not fail the block if a lane panics", func() {
+		s.SetupTest()
+		s.setUpMempool(func(sdk.Context, sdk.Tx) bool { panic("match") })
+		adaptiveAllocation := s.newAdaptiveAllocation(s.defaultConfig())
+
+		_, err := adaptiveAllocation.PreBlocker(nil)(s.ctx, &cometabci.RequestFinalizeBlock{Txs: s.createTxs(s.accounts[0], 2)})
+		s.Require().NoError(err)
+		s.Require().Equal(math.LegacyMustNewDecFromStr("0.2"), s.getMaxBlockSpace())
+	})
+
+	s.Run("does not fail the block if the allocations cannot be updated", func() {
+		s.SetupTest()
+
+		_, err := s.allocation.PreBlocker(nil)(s.ctx, &cometabci.RequestFinalizeBlock{Txs: [][]byte{[]byte("invalid")}})
+		s.Require().NoError(err)
+		s.Require().Equal(math.LegacyMustNewDecFromStr("0.2"), s.getMaxBlockSpace())
+	})
+}
+
+func TestConfigValidateBasic(t *testing.T) {
+	cases := []struct {
+		description string
+		malleate    func(cfg *allocation.Config)
+		expectPass  bool
+	}{
+		{
+			description: "default config is valid",
+			malleate:    func(_ *allocation.Config) {},
+			expectPass:  true,
+		},
+		{
+			description: "zero target utilization",
+			malleate: func(cfg *allocation.Config) {
+				cfg.TargetUtilization = math.LegacyZeroDec()
+			},
+			expectPass: false,
+		},
+		{
+			description: "max change rate of 1",
+			malleate: func(cfg *allocation.Config) {
+				cfg.MaxChangeRate = math.LegacyOneDec()
+			},
+			expectPass: false,
+		},
+		{
+			description: "lane with min bound greater than max bound",
+			malleate: func(cfg *allocation.Config) {
+				cfg.Lanes["lane"] = allocation.LaneBounds{
+					MinBlockSpace: math.LegacyMustNewDecFromStr("0.5"),
+					MaxBlockSpace: math.LegacyMustNewDecFromStr("0.2"),
+				}
+			},
+			expectPass: false,
+		},
+		{
+			description: "lane with zero min bound",
+			malleate: func(cfg *allocation.Config) {
+				cfg.Lanes["lane"] = allocation.LaneBounds{
+					MinBlockSpace: math.LegacyZeroDec(),
+					MaxBlockSpace: math.LegacyMustNewDecFromStr("0.2"),
+				}
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.description, func(t *testing.T) {
+			cfg := allocation.DefaultConfig()
+			tc.malleate(&cfg)
+
+			err := cfg.ValidateBasic()
+			if tc.expectPass && err != nil {
+				t.Errorf("expected config to be valid, got %s", err)
+			}
+			if !tc.expectPass && err == nil {
+				t.Errorf("expected config to be invalid")
+			}
+		})
+	}
+}
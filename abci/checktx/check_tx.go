@@ -1,11 +1,111 @@
 package checktx
 
 import (
+	"bytes"
+	"context"
+
 	cometabci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/block-sdk/v2/block"
+	"github.com/skip-mev/block-sdk/v2/block/metrics"
 )
 
 type (
 	// CheckTx is baseapp's CheckTx method that checks the validity of a
 	// transaction.
 	CheckTx func(req *cometabci.RequestCheckTx) (*cometabci.ResponseCheckTx, error)
+
+	// CheckTxOption defines a function that can be used to configure the check tx
+	// handlers.
+	CheckTxOption func(*checkTxConfig)
+
+	// AccountKeeper defines the interface used to rewind the sequence number of the signer
+	// of a replacement transaction in the check state (see WithAccountKeeper). This is
+	// implemented by the x/auth keeper.
+	AccountKeeper interface {
+		GetAccount(ctx context.Context, addr sdk.AccAddress) sdk.AccountI
+		SetAccount(ctx context.Context, acc sdk.AccountI)
+	}
+
+	// CheckStateApp defines the interface used to retrieve the check state, on which
+	// replacement transactions are checked. This is implemented by baseapp.
+	CheckStateApp interface {
+		GetContextForCheckTx(txBytes []byte) sdk.Context
+	}
+
+	// checkTxConfig defines the optional configuration of the check tx handlers.
+	checkTxConfig struct {
+		// metrics is used to report the transactions that are rejected by, inserted
+		// into and evicted from the mempool by the check tx handlers.
+		metrics metrics.Metrics
+
+		// accountKeeper is used to rewind the sequence number of the signer of a
+		// replacement transaction in the check state.
+		accountKeeper AccountKeeper
+	}
 )
+
+// WithMetrics sets the metrics the check tx handler reports to. By default, the
+// check tx handlers report to the Cosmos SDK telemetry.
+func WithMetrics(m metrics.Metrics) CheckTxOption {
+	return func(cfg *checkTxConfig) {
+		if m == nil {
+			panic("metrics cannot be nil")
+		}
+
+		cfg.metrics = m
+	}
+}
+
+// WithAccountKeeper enables the MempoolParityCheckTx handler to replace transactions (see
+// base.LaneConfig.TxReplacement). A replacement is checked by the wrapped CheckTx handler on
+// the check state, whose sequence number of the signer is rewound with the account keeper for
+// the replacement to be accepted. The base app given to the handler must implement
+// CheckStateApp. Without an account keeper, replacements are rejected.
+func WithAccountKeeper(ak AccountKeeper) CheckTxOption {
+	return func(cfg *checkTxConfig) {
+		if ak == nil {
+			panic("account keeper cannot be nil")
+		}
+
+		cfg.accountKeeper = ak
+	}
+}
+
+// newCheckTxConfig returns the configuration of a check tx handler with the given
+// options applied.
+func newCheckTxConfig(opts ...CheckTxOption) checkTxConfig {
+	cfg := checkTxConfig{
+		metrics: metrics.NewTelemetryMetrics(),
+	}
+
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	return cfg
+}
+
+// isReplacedTx returns true if the lane holds a different transaction with the same signer
+// and sequence number as the given transaction, i.e. the given transaction was replaced
+// (e.g. by a transaction paying a higher fee). Lanes that do not implement block.TxReplacer
+// never report replaced transactions.
+func isReplacedTx(ctx sdk.Context, lane block.Lane, tx sdk.Tx, txBytes []byte) bool {
+	replacer, ok := lane.(block.TxReplacer)
+	if !ok {
+		return false
+	}
+
+	current, found := replacer.Lookup(tx)
+	if !found {
+		return false
+	}
+
+	txInfo, err := lane.GetTxInfo(ctx, current)
+	if err != nil {
+		return false
+	}
+
+	return !bytes.Equal(txInfo.TxBytes, txBytes)
+}
@@ -1,6 +1,7 @@
 package checktx_test
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -13,11 +14,15 @@ import (
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	db "github.com/cosmos/cosmos-db"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/skip-mev/block-sdk/v2/abci/checktx"
+	signer_extraction "github.com/skip-mev/block-sdk/v2/adapters/signer_extraction_adapter"
 	"github.com/skip-mev/block-sdk/v2/block"
+	"github.com/skip-mev/block-sdk/v2/block/base"
 	"github.com/skip-mev/block-sdk/v2/block/utils"
+	defaultlane "github.com/skip-mev/block-sdk/v2/lanes/base"
 	mevlanetestutils "github.com/skip-mev/block-sdk/v2/lanes/mev/testutils"
 	"github.com/skip-mev/block-sdk/v2/testutils"
 	auctiontypes "github.com/skip-mev/block-sdk/v2/x/auction/types"
@@ -125,8 +130,16 @@ func (s *CheckTxTestSuite) TestCheckTxMempoolParity() {
 
 		s.Require().Equal(uint32(1), res.Code)
 
-		// check that the mev-lane does not contain the bid
-		s.Require().False(mevLane.Contains(bidTx))
+		// check that the bid with the same signer and sequence number was not replaced
+		s.Require().True(mevLane.Contains(bidTx))
+		s.Require().False(mevLane.Contains(hugeBidTx))
+		current, found := mevLane.Lookup(hugeBidTx)
+		s.Require().True(found)
+		currentBz, err := s.EncCfg.TxConfig.TxEncoder()(current)
+		s.Require().NoError(err)
+		bidTxBz, err := s.EncCfg.TxConfig.TxEncoder()(bidTx)
+		s.Require().NoError(err)
+		s.Require().Equal(bidTxBz, currentBz)
 	})
 
 	// test that a bid can be successfully inserted to mev-lane on CheckTx
@@ -290,6 +303,260 @@ func (s *CheckTxTestSuite) TestMEVCheckTxHandler() {
 	})
 }
 
+func (s *CheckTxTestSuite) TestMEVCheckTxHandlerReplacedBid() {
+	bidTx, _, err := testutils.CreateAuctionTx(
+		s.EncCfg.TxConfig,
+		s.Accounts[0],
+		sdk.NewCoin(s.GasTokenDenom, math.NewInt(100)),
+		0,
+		0,
+		nil,
+		100,
+	)
+	s.Require().NoError(err)
+
+	// a bid with the same signer and sequence number that replaces the bid above
+	higherBidTx, _, err := testutils.CreateAuctionTx(
+		s.EncCfg.TxConfig,
+		s.Accounts[0],
+		sdk.NewCoin(s.GasTokenDenom, math.NewInt(200)),
+		0,
+		0,
+		nil,
+		100,
+	)
+	s.Require().NoError(err)
+
+	txs := map[sdk.Tx]bool{
+		bidTx:       true,
+		higherBidTx: true,
+	}
+
+	mevLane := s.InitLane(math.LegacyOneDec(), txs, true)
+	ba := &baseApp{
+		s.Ctx,
+	}
+
+	handler := checktx.NewMEVCheckTxHandler(
+		ba,
+		s.EncCfg.TxConfig.TxDecoder(),
+		mevLane,
+		s.SetUpAnteHandler(txs),
+		ba.CheckTx,
+	).CheckTx()
+
+	bidTxBz, err := s.EncCfg.TxConfig.TxEncoder()(bidTx)
+	s.Require().NoError(err)
+	higherBidTxBz, err := s.EncCfg.TxConfig.TxEncoder()(higherBidTx)
+	s.Require().NoError(err)
+
+	for _, txBz := range [][]byte{bidTxBz, higherBidTxBz} {
+		res, err := handler(&cometabci.RequestCheckTx{Tx: txBz, Type: cometabci.CheckTxType_New})
+		s.Require().NoError(err)
+		s.Require().Equal(uint32(0), res.Code)
+	}
+
+	s.Run("replaced bid fails on ReCheck", func() {
+		res, err := handler(&cometabci.RequestCheckTx{Tx: bidTxBz, Type: cometabci.CheckTxType_Recheck})
+		s.Require().NoError(err)
+		s.Require().Equal(uint32(1), res.Code)
+
+		// the bid that replaced it is kept
+		current, found := mevLane.Lookup(bidTx)
+		s.Require().True(found)
+		currentBz, err := s.EncCfg.TxConfig.TxEncoder()(current)
+		s.Require().NoError(err)
+		s.Require().Equal(higherBidTxBz, currentBz)
+	})
+
+	s.Run("current bid passes ReCheck", func() {
+		res, err := handler(&cometabci.RequestCheckTx{Tx: higherBidTxBz, Type: cometabci.CheckTxType_Recheck})
+		s.Require().NoError(err)
+		s.Require().Equal(uint32(0), res.Code)
+		s.Require().Equal(1, mevLane.CountTx())
+	})
+}
+
+func (s *CheckTxTestSuite) TestTxReplacement() {
+	createTx := func(acc testutils.Account, nonce uint64, fee int64) (sdk.Tx, []byte) {
+		tx, err := testutils.CreateRandomTx(
+			s.EncCfg.TxConfig,
+			acc,
+			nonce,
+			1,
+			0,
+			1,
+			sdk.NewCoin(s.GasTokenDenom, math.NewInt(fee)),
+		)
+		s.Require().NoError(err)
+
+		txBz, err := s.EncCfg.TxConfig.TxEncoder()(tx)
+		s.Require().NoError(err)
+
+		return tx, txBz
+	}
+
+	tx1, tx1Bz := createTx(s.Accounts[0], 0, 100)
+	tx2, tx2Bz := createTx(s.Accounts[0], 0, 105)
+	tx3, tx3Bz := createTx(s.Accounts[0], 0, 110)
+	txs := map[sdk.Tx]bool{
+		tx1: true,
+		tx2: true,
+		tx3: true,
+	}
+
+	lane := defaultlane.NewDefaultLane(
+		base.LaneConfig{
+			Logger:          s.Ctx.Logger(),
+			TxEncoder:       s.EncCfg.TxConfig.TxEncoder(),
+			TxDecoder:       s.EncCfg.TxConfig.TxDecoder(),
+			AnteHandler:     s.SetUpAnteHandler(txs),
+			SignerExtractor: signer_extraction.NewDefaultAdapter(),
+			MaxBlockSpace:   math.LegacyOneDec(),
+			TxReplacement:   base.NewFeeBumpReplacementPolicy(math.LegacyMustNewDecFromStr("0.1")),
+		},
+		base.DefaultMatchHandler(),
+	)
+	mempool, err := block.NewLanedMempool(s.Ctx.Logger(), []block.Lane{lane})
+	s.Require().NoError(err)
+
+	// The account keeper holds the sequence numbers of the check state.
+	keeper := accountKeeper{}
+	for _, acc := range s.Accounts[:2] {
+		keeper.SetAccount(s.Ctx, authtypes.NewBaseAccountWithAddress(acc.Address))
+	}
+
+	sequence := func(acc testutils.Account) uint64 {
+		return keeper.GetAccount(s.Ctx, acc.Address).GetSequence()
+	}
+
+	// The wrapped handler mimics baseapp's CheckTx: invalid txs and txs whose sequence number
+	// does not match the check state are rejected, and valid txs consume their sequence number
+	// and are inserted into the mempool.
+	var calls int
+	invalid := make(map[string]bool)
+	checkTx := func(req *cometabci.RequestCheckTx) (*cometabci.ResponseCheckTx, error) {
+		calls++
+
+		tx, err := s.EncCfg.TxConfig.TxDecoder()(req.Tx)
+		s.Require().NoError(err)
+
+		if req.Type == cometabci.CheckTxType_Recheck {
+			return &cometabci.ResponseCheckTx{Code: 0}, nil
+		}
+
+		signers, err := signer_extraction.NewDefaultAdapter().GetSigners(tx)
+		s.Require().NoError(err)
+
+		account := keeper.GetAccount(s.Ctx, signers[0].Signer)
+		if invalid[string(req.Tx)] || signers[0].Sequence != account.GetSequence() {
+			return &cometabci.ResponseCheckTx{Code: 1}, nil
+		}
+
+		s.Require().NoError(account.SetSequence(account.GetSequence() + 1))
+		keeper.SetAccount(s.Ctx, account)
+
+		s.Require().NoError(mempool.Insert(s.Ctx, tx))
+		return &cometabci.ResponseCheckTx{Code: 0}, nil
+	}
+
+	handler := checktx.NewMempoolParityCheckTx(
+		s.Ctx.Logger(),
+		mempool,
+		s.EncCfg.TxConfig.TxDecoder(),
+		checkTx,
+		&baseApp{s.Ctx},
+		checktx.WithAccountKeeper(keeper),
+	).CheckTx()
+
+	requireCurrentTx := func(tx sdk.Tx, expected []byte) {
+		current, found := lane.Lookup(tx)
+		s.Require().True(found)
+
+		currentBz, err := s.EncCfg.TxConfig.TxEncoder()(current)
+		s.Require().NoError(err)
+		s.Require().Equal(expected, currentBz)
+	}
+
+	s.Run("inserts the original tx", func() {
+		res, err := handler(&cometabci.RequestCheckTx{Tx: tx1Bz, Type: cometabci.CheckTxType_New})
+		s.Require().NoError(err)
+		s.Require().Equal(uint32(0), res.Code)
+		s.Require().Equal(1, calls)
+		s.Require().Equal(uint64(1), sequence(s.Accounts[0]))
+	})
+
+	s.Run("rejects a replacement that does not satisfy the policy", func() {
+		res, err := handler(&cometabci.RequestCheckTx{Tx: tx2Bz, Type: cometabci.CheckTxType_New})
+		s.Require().NoError(err)
+		s.Require().Equal(uint32(1), res.Code)
+
+		requireCurrentTx(tx2, tx1Bz)
+	})
+
+	s.Run("replaces the tx through CheckTx if the fee is bumped", func() {
+		calls = 0
+
+		res, err := handler(&cometabci.RequestCheckTx{Tx: tx3Bz, Type: cometabci.CheckTxType_New})
+		s.Require().NoError(err)
+		s.Require().Equal(uint32(0), res.Code)
+		s.Require().Equal(1, calls)
+
+		requireCurrentTx(tx1, tx3Bz)
+		s.Require().Equal(1, mempool.CountTx())
+		s.Require().Equal(uint64(1), sequence(s.Accounts[0]))
+	})
+
+	s.Run("purges the replaced tx from the comet mempool on ReCheck", func() {
+		res, err := handler(&cometabci.RequestCheckTx{Tx: tx1Bz, Type: cometabci.CheckTxType_Recheck})
+		s.Require().NoError(err)
+		s.Require().Equal(uint32(1), res.Code)
+		s.Require().True(mempool.Contains(tx3))
+
+		res, err = handler(&cometabci.RequestCheckTx{Tx: tx3Bz, Type: cometabci.CheckTxType_Recheck})
+		s.Require().NoError(err)
+		s.Require().Equal(uint32(0), res.Code)
+	})
+
+	s.Run("restores the replaced tx if the replacement fails CheckTx", func() {
+		_, precedingTxBz := createTx(s.Accounts[1], 0, 100)
+		_, txBz := createTx(s.Accounts[1], 1, 100)
+		replacementTx, replacementTxBz := createTx(s.Accounts[1], 1, 200)
+		invalid[string(replacementTxBz)] = true
+
+		for _, bz := range [][]byte{precedingTxBz, txBz} {
+			res, err := handler(&cometabci.RequestCheckTx{Tx: bz, Type: cometabci.CheckTxType_New})
+			s.Require().NoError(err)
+			s.Require().Equal(uint32(0), res.Code)
+		}
+
+		res, err := handler(&cometabci.RequestCheckTx{Tx: replacementTxBz, Type: cometabci.CheckTxType_New})
+		s.Require().NoError(err)
+		s.Require().Equal(uint32(1), res.Code)
+
+		requireCurrentTx(replacementTx, txBz)
+		s.Require().Equal(uint64(2), sequence(s.Accounts[1]))
+	})
+
+	s.Run("rejects replacements without an account keeper", func() {
+		handler := checktx.NewMempoolParityCheckTx(
+			s.Ctx.Logger(),
+			mempool,
+			s.EncCfg.TxConfig.TxDecoder(),
+			checkTx,
+			&baseApp{s.Ctx},
+		).CheckTx()
+
+		tx4, tx4Bz := createTx(s.Accounts[0], 0, 150)
+
+		res, err := handler(&cometabci.RequestCheckTx{Tx: tx4Bz, Type: cometabci.CheckTxType_New})
+		s.Require().NoError(err)
+		s.Require().Equal(uint32(1), res.Code)
+
+		requireCurrentTx(tx4, tx3Bz)
+	})
+}
+
 func (s *CheckTxTestSuite) TestValidateBidTx() {
 	validBidTx, bundled, err := testutils.CreateAuctionTx(
 		s.EncCfg.TxConfig,
@@ -435,3 +702,19 @@ func (ba *baseApp) GetConsensusParams(_ sdk.Context) cmtproto.ConsensusParams {
 func (ba *baseApp) ChainID() string {
 	return ba.ctx.ChainID()
 }
+
+// GetContextForCheckTx is utilized to retrieve the check state.
+func (ba *baseApp) GetContextForCheckTx(txBytes []byte) sdk.Context {
+	return ba.ctx.WithTxBytes(txBytes)
+}
+
+// accountKeeper is an in-memory checktx.AccountKeeper.
+type accountKeeper map[string]sdk.AccountI
+
+func (k accountKeeper) GetAccount(_ context.Context, addr sdk.AccAddress) sdk.AccountI {
+	return k[addr.String()]
+}
+
+func (k accountKeeper) SetAccount(_ context.Context, acc sdk.AccountI) {
+	k[acc.GetAddress().String()] = acc
+}
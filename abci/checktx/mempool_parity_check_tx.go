@@ -12,10 +12,16 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/skip-mev/block-sdk/v2/block"
+	"github.com/skip-mev/block-sdk/v2/block/metrics"
+	"github.com/skip-mev/block-sdk/v2/block/utils"
+	mevlane "github.com/skip-mev/block-sdk/v2/lanes/mev"
 )
 
 // MempoolParityCheckTx is a CheckTx function that evicts txs that are not in the app-side mempool
-// on ReCheckTx. This handler is used to enforce parity in the app-side / comet mempools.
+// on ReCheckTx. This handler is used to enforce parity in the app-side / comet mempools. It also
+// handles txs that replace a tx in the app-side mempool with the same signer and sequence number
+// according to the lane's replacement policy (see base.LaneConfig.TxReplacement and
+// WithAccountKeeper); the replaced tx is purged from the comet mempool on ReCheckTx.
 type MempoolParityCheckTx struct {
 	// logger
 	logger log.Logger
@@ -32,6 +38,14 @@ type MempoolParityCheckTx struct {
 	// baseApp is utilized to retrieve the latest committed state and to call
 	// baseapp's CheckTx method.
 	baseApp BaseApp
+
+	// metrics is utilized to report the transactions that are rejected by and
+	// evicted from the mempool.
+	metrics metrics.Metrics
+
+	// accountKeeper is utilized to rewind the sequence number of the signer of a
+	// replacement tx in the check state. If nil, replacements are rejected.
+	accountKeeper AccountKeeper
 }
 
 // NewMempoolParityCheckTx returns a new MempoolParityCheckTx handler.
@@ -41,13 +55,18 @@ func NewMempoolParityCheckTx(
 	txDecoder sdk.TxDecoder,
 	checkTxHandler CheckTx,
 	baseApp BaseApp,
+	opts ...CheckTxOption,
 ) MempoolParityCheckTx {
+	cfg := newCheckTxConfig(opts...)
+
 	return MempoolParityCheckTx{
 		logger:         logger,
 		mempl:          mempl,
 		txDecoder:      txDecoder,
 		checkTxHandler: checkTxHandler,
 		baseApp:        baseApp,
+		metrics:        cfg.metrics,
+		accountKeeper:  cfg.accountKeeper,
 	}
 }
 
@@ -68,7 +87,40 @@ func (m MempoolParityCheckTx) CheckTx() CheckTx {
 		}
 
 		isReCheck := req.Type == cmtabci.CheckTxType_Recheck
-		txInMempool := m.mempl.Contains(tx)
+		txHash := utils.TxHash(req.Tx)
+
+		// look up the exact same tx, i.e. a tx with the same hash, in the hash index of the
+		// app's mempool. This is the common case on ReCheck and does not re-encode the tx.
+		txIndexed := m.mempl.ContainsHash(txHash)
+		txInMempool := txIndexed
+
+		// if the app's mempool holds a different tx with the same signer and sequence number,
+		// the tx was either replaced (on ReCheck), in which case it is purged from the comet
+		// mempool, or it is replacing the tx in the app's mempool. The same tx is also found
+		// this way if its lane does not index txs by hash.
+		if !txIndexed {
+			sdkCtx := m.GetContextForTx(req)
+			lane, found, replaced := m.replacedIn(sdkCtx, tx, req.Tx)
+			txInMempool = found && !replaced
+
+			switch {
+			case replaced && isReCheck:
+				m.logger.Debug(
+					"tx from comet mempool was replaced in app-side mempool",
+					"tx", tx,
+				)
+
+				return sdkerrors.ResponseCheckTxWithEvents(
+					fmt.Errorf("tx from comet mempool was replaced in app-side mempool"),
+					0,
+					0,
+					nil,
+					false,
+				), nil
+			case replaced && m.canReplace(lane, tx):
+				return m.replaceTx(sdkCtx, req, lane, tx), nil
+			}
+		}
 
 		// if the mode is ReCheck and the app's mempool does not contain the given tx, we fail
 		// immediately, to purge the tx from the comet mempool.
@@ -88,16 +140,30 @@ func (m MempoolParityCheckTx) CheckTx() CheckTx {
 		}
 
 		// prepare cleanup closure to remove tx if marked
-		removeTx := false
+		var (
+			removeTx bool
+			laneName string
+		)
 		defer func() {
 			if removeTx {
-				// remove the tx
-				if err := m.mempl.Remove(tx); err != nil {
+				// remove the tx, by hash if it is indexed
+				var err error
+				if txIndexed {
+					err = m.mempl.RemoveByHash(txHash)
+				} else {
+					err = m.mempl.Remove(tx)
+				}
+
+				if err != nil {
 					m.logger.Debug(
 						"failed to remove tx from app-side mempool when purging for re-check failure",
 						"removal-err", err,
 					)
+
+					return
 				}
+
+				m.metrics.AddTxsEvicted(laneName, 1)
 			}
 		}()
 
@@ -117,6 +183,8 @@ func (m MempoolParityCheckTx) CheckTx() CheckTx {
 			}
 
 			m.logger.Debug("failed to match lane", "lane", lane, "err", err)
+			m.metrics.AddTxsRejected("", 1)
+
 			return sdkerrors.ResponseCheckTxWithEvents(
 				err,
 				0,
@@ -126,9 +194,9 @@ func (m MempoolParityCheckTx) CheckTx() CheckTx {
 			), nil
 		}
 
-		consensusParams := sdkCtx.ConsensusParams()
-		laneSize := lane.GetMaxBlockSpace().MulInt64(consensusParams.GetBlock().GetMaxBytes()).TruncateInt64()
+		laneName = lane.Name()
 
+		laneSize := maxLaneBytes(sdkCtx, lane)
 		txSize := int64(len(req.Tx))
 		if txSize > laneSize {
 			if isReCheck && txInMempool {
@@ -141,6 +209,7 @@ func (m MempoolParityCheckTx) CheckTx() CheckTx {
 				"tx size", txSize,
 				"max bytes", laneSize,
 			)
+			m.metrics.AddTxsRejected(lane.Name(), 1)
 
 			return sdkerrors.ResponseCheckTxWithEvents(
 				fmt.Errorf("tx size exceeds max bytes for lane %s", lane.Name()),
@@ -155,6 +224,177 @@ func (m MempoolParityCheckTx) CheckTx() CheckTx {
 	}
 }
 
+// replacedIn returns the lane that contains a transaction with the same signer and sequence
+// number as the given transaction and whether that transaction differs from the given one.
+// Lanes that cannot look up transactions by signer and sequence number (see
+// block.TxReplacer) only find the same transaction.
+func (m MempoolParityCheckTx) replacedIn(ctx sdk.Context, tx sdk.Tx, txBytes []byte) (block.Lane, bool, bool) {
+	for _, lane := range m.mempl.Registry() {
+		if _, ok := lane.(block.TxReplacer); ok {
+			if replaced := isReplacedTx(ctx, lane, tx, txBytes); replaced || lane.Contains(tx) {
+				return lane, true, replaced
+			}
+
+			continue
+		}
+
+		if lane.Contains(tx) {
+			return lane, true, false
+		}
+	}
+
+	return nil, false, false
+}
+
+// canReplace returns true if the transaction can replace the transaction in the lane with the
+// same signer and sequence number according to the lane's replacement policy. Bid transactions
+// are excluded since they are verified against the latest committed state (and replaced) by the
+// MEVCheckTxHandler. Replacements require the check state to be rewound (see WithAccountKeeper).
+func (m MempoolParityCheckTx) canReplace(lane block.Lane, tx sdk.Tx) bool {
+	if factory, ok := lane.(mevlane.Factory); ok {
+		if bidInfo, err := factory.GetAuctionBidInfo(tx); err != nil || bidInfo != nil {
+			return false
+		}
+	}
+
+	if _, ok := m.baseApp.(CheckStateApp); !ok || m.accountKeeper == nil {
+		return false
+	}
+
+	replacer, ok := lane.(block.TxReplacer)
+	if !ok {
+		return false
+	}
+
+	current, found := replacer.Lookup(tx)
+	return found && replacer.AllowsReplacement(current, tx)
+}
+
+// replaceTx replaces the transaction in the given lane with the same signer and sequence number
+// by the given transaction. The replaced transaction is removed from the app-side mempool and
+// the replacement is checked by the wrapped CheckTx handler, i.e. baseapp's CheckTx, on the check
+// state, which inserts it into the app-side mempool. Since the check state has already consumed
+// the sequence number of the replaced transaction, the sequence number of the signer is rewound
+// while the replacement is checked. If the replacement fails, the replaced transaction is
+// restored. The fees paid by the replaced transaction are not refunded in the check state,
+// which is reset once the next block is committed.
+func (m MempoolParityCheckTx) replaceTx(
+	ctx sdk.Context,
+	req *cmtabci.RequestCheckTx,
+	lane block.Lane,
+	tx sdk.Tx,
+) *cmtabci.ResponseCheckTx {
+	reject := func(err error) *cmtabci.ResponseCheckTx {
+		m.logger.Debug(
+			"failed to replace tx in app-side mempool",
+			"lane", lane.Name(),
+			"err", err,
+		)
+		m.metrics.AddTxsRejected(lane.Name(), 1)
+
+		return sdkerrors.ResponseCheckTxWithEvents(err, 0, 0, nil, false)
+	}
+
+	if !lane.Match(ctx, tx) {
+		return reject(fmt.Errorf("replacement tx does not belong to lane %s", lane.Name()))
+	}
+
+	if int64(len(req.Tx)) > maxLaneBytes(ctx, lane) {
+		return reject(fmt.Errorf("tx size exceeds max bytes for lane %s", lane.Name()))
+	}
+
+	replaced, found := lane.(block.TxReplacer).Lookup(tx)
+	if !found {
+		return reject(fmt.Errorf("replaced tx not found in lane %s", lane.Name()))
+	}
+
+	txInfo, err := lane.GetTxInfo(ctx, tx)
+	if err != nil {
+		return reject(fmt.Errorf("failed to get tx info: %w", err))
+	}
+
+	if len(txInfo.Signers) == 0 || txInfo.Signers[0].Unordered {
+		return reject(fmt.Errorf("replacement tx must have an ordered signer"))
+	}
+	signer := txInfo.Signers[0]
+
+	checkCtx := m.baseApp.(CheckStateApp).GetContextForCheckTx(req.Tx)
+	account := m.accountKeeper.GetAccount(checkCtx, signer.Signer)
+	if account == nil {
+		return reject(fmt.Errorf("account %s not found", signer.Signer))
+	}
+	sequence := account.GetSequence()
+
+	if err := m.mempl.Remove(replaced); err != nil {
+		return reject(fmt.Errorf("failed to remove replaced tx: %w", err))
+	}
+
+	if err := m.setSequence(checkCtx, signer.Signer, signer.Sequence); err != nil {
+		m.restoreTx(ctx, lane, replaced)
+		return reject(err)
+	}
+
+	res, checkTxErr := m.checkTxHandler(req)
+
+	// The signer's transactions with a higher sequence number are still in the check state.
+	if err := m.setSequence(checkCtx, signer.Signer, sequence); err != nil {
+		m.logger.Error(
+			"failed to restore sequence of signer in check state",
+			"signer", signer.Signer,
+			"err", err,
+		)
+	}
+
+	if isInvalidCheckTxExecution(res, checkTxErr) {
+		m.restoreTx(ctx, lane, replaced)
+		m.metrics.AddTxsRejected(lane.Name(), 1)
+
+		if checkTxErr != nil {
+			return sdkerrors.ResponseCheckTxWithEvents(checkTxErr, 0, 0, nil, false)
+		}
+
+		if res == nil {
+			return sdkerrors.ResponseCheckTxWithEvents(fmt.Errorf("failed to check replacement tx"), 0, 0, nil, false)
+		}
+	}
+
+	return res
+}
+
+// setSequence sets the sequence number of the account in the check state.
+func (m MempoolParityCheckTx) setSequence(ctx sdk.Context, addr sdk.AccAddress, sequence uint64) error {
+	account := m.accountKeeper.GetAccount(ctx, addr)
+	if account == nil {
+		return fmt.Errorf("account %s not found", addr)
+	}
+
+	if err := account.SetSequence(sequence); err != nil {
+		return fmt.Errorf("failed to set sequence of account %s: %w", addr, err)
+	}
+
+	m.accountKeeper.SetAccount(ctx, account)
+
+	return nil
+}
+
+// restoreTx re-inserts a replaced transaction into the app-side mempool after its replacement
+// failed.
+func (m MempoolParityCheckTx) restoreTx(ctx sdk.Context, lane block.Lane, tx sdk.Tx) {
+	if err := m.mempl.Insert(ctx, tx); err != nil {
+		m.logger.Error(
+			"failed to restore replaced tx in app-side mempool",
+			"lane", lane.Name(),
+			"err", err,
+		)
+	}
+}
+
+// maxLaneBytes returns the maximum number of bytes the lane can include in a block.
+func maxLaneBytes(ctx sdk.Context, lane block.Lane) int64 {
+	consensusParams := ctx.ConsensusParams()
+	return lane.GetBlockSpace().MaxTxBytes.MulInt64(consensusParams.GetBlock().GetMaxBytes()).TruncateInt64()
+}
+
 // matchLane returns a Lane if the given tx matches the Lane.
 func (m MempoolParityCheckTx) matchLane(ctx sdk.Context, tx sdk.Tx) (block.Lane, error) {
 	var lane block.Lane
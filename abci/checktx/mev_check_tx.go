@@ -13,6 +13,7 @@ import (
 	storetypes "cosmossdk.io/store/types"
 
 	"github.com/skip-mev/block-sdk/v2/block"
+	"github.com/skip-mev/block-sdk/v2/block/metrics"
 	mevlane "github.com/skip-mev/block-sdk/v2/lanes/mev"
 	"github.com/skip-mev/block-sdk/v2/x/auction/types"
 )
@@ -40,6 +41,10 @@ type MEVCheckTxHandler struct {
 
 	// checkTxHandler is the wrapped CheckTx handler that is used to execute all non-bid txs
 	checkTxHandler CheckTx
+
+	// metrics is utilized to report the bids that are submitted to and the bid
+	// transactions that are rejected by, inserted into and evicted from the MEV lane.
+	metrics metrics.Metrics
 }
 
 // MEVLaneI defines the interface for the mev auction lane. This interface
@@ -77,13 +82,17 @@ func NewMEVCheckTxHandler(
 	mevLane MEVLaneI,
 	anteHandler sdk.AnteHandler,
 	checkTxHandler CheckTx,
+	opts ...CheckTxOption,
 ) *MEVCheckTxHandler {
+	cfg := newCheckTxConfig(opts...)
+
 	return &MEVCheckTxHandler{
 		baseApp:        baseApp,
 		txDecoder:      txDecoder,
 		mevLane:        mevLane,
 		anteHandler:    anteHandler,
 		checkTxHandler: checkTxHandler,
+		metrics:        cfg.metrics,
 	}
 }
 
@@ -164,6 +173,26 @@ func (handler *MEVCheckTxHandler) CheckTx() CheckTx {
 		// context will be discarded and will not apply any state changes.
 		ctx := handler.GetContextForBidTx(req)
 
+		// If the MEV lane holds a different bid with the same signer and sequence number, the
+		// bid was replaced (on ReCheck) and is purged from the comet mempool, or the bid is
+		// replacing it (on Check).
+		replacing := isReplacedTx(ctx, handler.mevLane, tx, req.Tx)
+		if replacing && ctx.IsReCheckTx() {
+			handler.baseApp.Logger().Info(
+				"bid tx was replaced in mev-lane",
+				"bidder", bidInfo.Bidder,
+				"bid", bidInfo.Bid,
+			)
+
+			return sdkerrors.ResponseCheckTxWithEvents(
+				fmt.Errorf("bid tx was replaced in mev-lane"),
+				0,
+				0,
+				nil,
+				false,
+			), nil
+		}
+
 		// Verify the bid transaction.
 		gasInfo, err := handler.ValidateBidTx(ctx, tx, bidInfo)
 		if err != nil {
@@ -176,14 +205,19 @@ func (handler *MEVCheckTxHandler) CheckTx() CheckTx {
 				"bid", bidInfo.Bid,
 				"is_recheck_tx", ctx.IsReCheckTx(),
 			)
+			handler.metrics.AddTxsRejected(handler.mevLane.Name(), 1)
 
-			// attempt to remove the bid from the MEVLane (if it exists)
-			if handler.mevLane.Contains(tx) {
+			// attempt to remove the bid from the MEVLane (if it exists). A bid that fails to replace
+			// another bid must not remove the bid it attempted to replace.
+			if handler.mevLane.Contains(tx) && !replacing {
 				if err := handler.mevLane.Remove(tx); err != nil {
 					handler.baseApp.Logger().Error(
 						"failed to remove bid transaction from mev-lane",
 						"err", err,
 					)
+				} else {
+					handler.metrics.AddTxsEvicted(handler.mevLane.Name(), 1)
+					handler.metrics.SetLaneSize(handler.mevLane.Name(), handler.mevLane.CountTx())
 				}
 			}
 
@@ -204,13 +238,26 @@ func (handler *MEVCheckTxHandler) CheckTx() CheckTx {
 			"bid", bidInfo.Bid,
 			"inserting tx into mempool", true,
 		)
+		handler.metrics.ObserveBid(handler.mevLane.Name(), bidInfo.Bid)
+
+		// A valid bid that is already in the MEV lane is kept as is on ReCheck. Re-inserting it
+		// would be treated as a replacement of itself.
+		if ctx.IsReCheckTx() && handler.mevLane.Contains(tx) {
+			return &cometabci.ResponseCheckTx{
+				Code:      cometabci.CodeTypeOK,
+				GasWanted: int64(gasInfo.GasWanted),
+				GasUsed:   int64(gasInfo.GasUsed),
+			}, nil
+		}
 
 		// If the bid transaction is valid, we know we can insert it into the mempool for consideration in the next block.
+		// Bids that replace a bid with the same signer and sequence number must satisfy the lane's replacement policy.
 		if err := handler.mevLane.Insert(ctx, tx); err != nil {
 			handler.baseApp.Logger().Info(
 				"invalid bid tx; failed to insert bid transaction into mempool",
 				"err", err,
 			)
+			handler.metrics.AddTxsRejected(handler.mevLane.Name(), 1)
 
 			return sdkerrors.ResponseCheckTxWithEvents(
 				fmt.Errorf("invalid bid tx; failed to insert bid transaction into mempool: %w", err),
@@ -221,6 +268,9 @@ func (handler *MEVCheckTxHandler) CheckTx() CheckTx {
 			), nil
 		}
 
+		handler.metrics.AddTxsInserted(handler.mevLane.Name(), 1)
+		handler.metrics.SetLaneSize(handler.mevLane.Name(), handler.mevLane.CountTx())
+
 		return &cometabci.ResponseCheckTx{
 			Code:      cometabci.CodeTypeOK,
 			GasWanted: int64(gasInfo.GasWanted),
@@ -0,0 +1,51 @@
+package abci
+
+import (
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"github.com/skip-mev/block-sdk/v2/block/proposals"
+)
+
+// ProposalInfoLog is the log of the execution result reported for the proposal info (see
+// FinalizeBlockHandler).
+const ProposalInfoLog = "proposal info"
+
+// FinalizeBlock defines the signature of the ABCI FinalizeBlock method.
+type FinalizeBlock func(req *abci.RequestFinalizeBlock) (*abci.ResponseFinalizeBlock, error)
+
+// FinalizeBlockHandler wraps the application's FinalizeBlock method (e.g. BaseApp.FinalizeBlock).
+// If the proposal handler includes the proposal info in the first slot of every proposal (see
+// WithProposalInfo), the proposal info fails to decode when the block is executed and would
+// otherwise be reported as a failed transaction. The returned handler replaces its execution
+// result with a successful, empty result. Decoding the proposal info never touches state, so
+// only the result reported to CometBFT changes. The result is replaced after the block is
+// executed, such that it is the same whether or not the block was executed optimistically.
+//
+// NOTE: All validators on the network must use the same setting, since the execution results
+// are part of consensus (LastResultsHash).
+func (h *ProposalHandler) FinalizeBlockHandler(finalize FinalizeBlock) FinalizeBlock {
+	return func(req *abci.RequestFinalizeBlock) (*abci.ResponseFinalizeBlock, error) {
+		resp, err := finalize(req)
+		if err != nil || !h.useProposalInfo || resp == nil {
+			return resp, err
+		}
+
+		if len(req.Txs) == 0 || len(resp.TxResults) != len(req.Txs) {
+			return resp, nil
+		}
+
+		// Only replace the result if the first slot holds the proposal info and not a valid
+		// transaction, e.g. if the proposer did not include the proposal info.
+		if _, err := h.txDecoder(req.Txs[0]); err == nil {
+			return resp, nil
+		}
+
+		if _, _, err := proposals.GetProposalInfo(req.Txs); err != nil {
+			return resp, nil
+		}
+
+		resp.TxResults[0] = &abci.ExecTxResult{Log: ProposalInfoLog}
+
+		return resp, nil
+	}
+}
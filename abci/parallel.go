@@ -0,0 +1,284 @@
+package abci
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/block-sdk/v2/block"
+	"github.com/skip-mev/block-sdk/v2/block/proposals"
+	proposalstypes "github.com/skip-mev/block-sdk/v2/block/proposals/types"
+	"github.com/skip-mev/block-sdk/v2/block/utils"
+)
+
+type (
+	// laneSegment contains the transactions of a proposal that belong to a single lane, as
+	// declared by the proposal info.
+	laneSegment struct {
+		// txs are the raw transactions of the segment.
+		txs [][]byte
+		// decodedTxs are the decoded transactions of the segment.
+		decodedTxs []sdk.Tx
+		// txsWithInfo are the transaction infos of the decoded transactions.
+		txsWithInfo []utils.TxWithInfo
+		// basic is true if the stateless invariants of the segment were verified by the lane.
+		// Otherwise, the segment must be verified with the lane's ProcessLane.
+		basic bool
+	}
+)
+
+// ProcessLanesInParallel verifies a proposal using the proposal info to split the transactions
+// of the proposal into contiguous segments, one for each lane (in order). Since the segment of
+// every lane is known up front, the stateless verification of each segment (decoding, matching,
+// ordering and size/gas accounting) is done concurrently for all lanes, each on its own branch of
+// the state. The stateful verification (VerifyTx) and the update of the proposal are then done
+// sequentially, lane by lane, exactly as in ChainProcessLanes. Lanes that do not implement
+// block.ParallelProcessLane or do not support stateless verification on its own are verified
+// sequentially with ProcessLane.
+//
+// The proposal is rejected if the segments declared by the proposal info do not cover the
+// proposal or if any segment fails verification. The resulting proposal must still be compared
+// against the proposal info by the caller.
+func ProcessLanesInParallel(
+	ctx sdk.Context,
+	lanes []block.Lane,
+	txDecoder sdk.TxDecoder,
+	proposal proposals.Proposal,
+	info proposalstypes.ProposalInfo,
+	txs [][]byte,
+) (proposals.Proposal, error) {
+	segments, err := splitProposal(lanes, info, txs)
+	if err != nil {
+		return proposal, err
+	}
+
+	// offsets[i] is the index of the first transaction of the i-th segment in the proposal.
+	offsets := make([]int, len(lanes)+1)
+	for i, segment := range segments {
+		offsets[i+1] = offsets[i] + len(segment.txs)
+	}
+
+	// Decode the transactions of each segment concurrently.
+	if err := forEachLane(lanes, func(i int, lane block.Lane) error {
+		decodedTxs, err := decodeTxs(txDecoder, lane.Name(), segments[i].txs)
+		if err != nil {
+			return proposals.OffsetInvariantError(lane.Name(), offsets[i], err)
+		}
+
+		segments[i].decodedTxs = decodedTxs
+		return nil
+	}); err != nil {
+		return proposal, err
+	}
+
+	decodedTxs := make([]sdk.Tx, 0, len(txs))
+	for _, segment := range segments {
+		decodedTxs = append(decodedTxs, segment.decodedTxs...)
+	}
+
+	// Verify the stateless invariants of each segment concurrently. Each lane is given its own
+	// branch of the state and its own gas meter since neither cache stores nor gas meters are
+	// safe for concurrent use. The branches are created up front and are never written back.
+	laneCtxs := make([]sdk.Context, len(lanes))
+	for i := range lanes {
+		laneCtx, _ := ctx.CacheContext()
+		laneCtxs[i] = laneCtx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	}
+
+	if err := forEachLane(lanes, func(i int, lane block.Lane) error {
+		parallelLane, ok := lane.(block.ParallelProcessLane)
+		if !ok {
+			return nil
+		}
+
+		laneCtx := laneCtxs[i]
+		err := parallelLane.ProcessLaneBasic(laneCtx, segments[i].decodedTxs, decodedTxs[offsets[i+1]:])
+		switch {
+		case errors.Is(err, block.ErrProcessLaneBasicNotSupported):
+			return nil
+		case err != nil:
+			return proposals.OffsetInvariantError(
+				lane.Name(),
+				offsets[i],
+				fmt.Errorf("failed to verify lane %s: %w", lane.Name(), err),
+			)
+		}
+
+		txsWithInfo := make([]utils.TxWithInfo, len(segments[i].decodedTxs))
+		for j, tx := range segments[i].decodedTxs {
+			if txsWithInfo[j], err = lane.GetTxInfo(laneCtx, tx); err != nil {
+				return proposals.NewInvariantError(
+					lane.Name(),
+					offsets[i]+j,
+					proposals.InvariantUnknown,
+					fmt.Errorf("failed to get tx info: %w", err),
+				)
+			}
+		}
+
+		segments[i].txsWithInfo = txsWithInfo
+		segments[i].basic = true
+		return nil
+	}); err != nil {
+		return proposal, err
+	}
+
+	// Verify each segment against state and update the proposal in order.
+	for i, lane := range lanes {
+		proposal.AllocateLane(lane, remainingLanes(lanes[i:]))
+
+		// Trace the verification of the lane's segment in a child span of the proposal.
+		laneCtx, span := startLaneSpan(ctx, lane, "ProcessLane")
+		proposal, err = verifySegment(laneCtx, lane, proposal, segments[i], decodedTxs[offsets[i]:], offsets[i])
+		endSpan(span, err)
+
+		if err != nil {
+			return proposal, err
+		}
+	}
+
+	return proposal, nil
+}
+
+// verifySegment verifies the segment of the given lane against state and updates the proposal.
+// txs are the transactions of the proposal starting at the segment, whose index in the proposal
+// is offset.
+func verifySegment(
+	ctx sdk.Context,
+	lane block.Lane,
+	proposal proposals.Proposal,
+	segment laneSegment,
+	txs []sdk.Tx,
+	offset int,
+) (proposals.Proposal, error) {
+	parallelLane, ok := lane.(block.ParallelProcessLane)
+	if !segment.basic || !ok {
+		return lane.ProcessLane(
+			ctx,
+			proposal,
+			txs,
+			verifyRemainingTxs(len(txs)-len(segment.decodedTxs)),
+		)
+	}
+
+	for j, tx := range segment.decodedTxs {
+		if err := parallelLane.VerifyTx(ctx, tx, false); err != nil {
+			return proposal, proposals.NewInvariantError(
+				lane.Name(),
+				offset+j,
+				proposals.InvariantVerifyTx,
+				fmt.Errorf("failed to verify tx in lane %s: %w", lane.Name(), err),
+			)
+		}
+	}
+
+	if err := proposal.UpdateProposal(lane, segment.txsWithInfo); err != nil {
+		return proposal, proposals.OffsetInvariantError(
+			lane.Name(),
+			offset,
+			fmt.Errorf("failed to update proposal with lane %s: %w", lane.Name(), err),
+		)
+	}
+
+	return proposal, nil
+}
+
+// splitProposal splits the transactions of the proposal into the segments declared by the
+// proposal info.
+func splitProposal(lanes []block.Lane, info proposalstypes.ProposalInfo, txs [][]byte) ([]laneSegment, error) {
+	var (
+		segments = make([]laneSegment, len(lanes))
+		offset   int
+	)
+
+	for i, lane := range lanes {
+		numTxs := info.TxsByLane[lane.Name()]
+		if numTxs > uint64(len(txs)) {
+			return nil, proposals.NewInvariantError(
+				lane.Name(),
+				-1,
+				proposals.InvariantProposalInfo,
+				fmt.Errorf(
+					"proposal info declares %d transactions for lane %s but only %d remain",
+					numTxs,
+					lane.Name(),
+					len(txs),
+				),
+			)
+		}
+
+		segments[i].txs, txs = txs[:numTxs], txs[numTxs:]
+		offset += int(numTxs)
+	}
+
+	if len(txs) > 0 {
+		return nil, proposals.NewInvariantError(
+			"",
+			offset,
+			proposals.InvariantProposalInfo,
+			fmt.Errorf("proposal contains %d transactions that do not belong to any lane", len(txs)),
+		)
+	}
+
+	return segments, nil
+}
+
+// verifyRemainingTxs returns a ProcessLanesHandler that ensures that a lane verified with
+// ProcessLane left exactly the transactions of the lanes after it.
+func verifyRemainingTxs(numRemainingTxs int) block.ProcessLanesHandler {
+	return func(_ sdk.Context, proposal proposals.Proposal, txs []sdk.Tx) (proposals.Proposal, error) {
+		if len(txs) != numRemainingTxs {
+			return proposal, proposals.NewInvariantError(
+				proposal.Allocation.Lane,
+				-1,
+				proposals.InvariantProposalInfo,
+				fmt.Errorf(
+					"lane %s did not verify the transactions declared by the proposal info",
+					proposal.Allocation.Lane,
+				),
+			)
+		}
+
+		return proposal, nil
+	}
+}
+
+// forEachLane calls fn concurrently for each lane and returns the first error (in lane
+// order) if any. Panics in fn are recovered and returned as errors.
+func forEachLane(lanes []block.Lane, fn func(i int, lane block.Lane) error) error {
+	errs := make([]error, len(lanes))
+
+	var wg sync.WaitGroup
+	for i, lane := range lanes {
+		wg.Add(1)
+
+		go func(i int, lane block.Lane) {
+			defer wg.Done()
+			defer func() {
+				if rec := recover(); rec != nil {
+					errs[i] = proposals.NewInvariantError(
+						lane.Name(),
+						-1,
+						proposals.InvariantUnknown,
+						fmt.Errorf("lane %s panicked: %v", lane.Name(), rec),
+					)
+				}
+			}()
+
+			errs[i] = fn(i, lane)
+		}(i, lane)
+	}
+
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,86 @@
+package abci
+
+import (
+	"fmt"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/skip-mev/block-sdk/v2/block/proposals"
+)
+
+// shadowProcessProposalHandler returns a process proposal handler that verifies every proposal
+// exactly as the custom process proposal handler does but always accepts it. Proposals that
+// would have been rejected are reported with reportShadowRejection.
+func (h *ProposalHandler) shadowProcessProposalHandler() sdk.ProcessProposalHandler {
+	return func(ctx sdk.Context, req *abci.RequestProcessProposal) (resp *abci.ResponseProcessProposal, err error) {
+		if req.Height <= 1 {
+			return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_ACCEPT}, nil
+		}
+
+		ctx, span := h.startSpan(ctx, "ProcessProposal", req.Height, len(req.Txs))
+		span.SetAttributes(attribute.Bool("shadow", true))
+		defer span.End()
+
+		// In the case where any of the lanes panic, we recover here, report the proposal and
+		// still accept it.
+		defer func() {
+			if rec := recover(); rec != nil {
+				h.reportShadowRejection(ctx, req.Height, fmt.Errorf("failed to process proposal: %v", rec))
+
+				resp = &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_ACCEPT}
+				err = nil
+			}
+		}()
+
+		finalProposal, err := h.verifyProposal(ctx, req)
+		if err != nil {
+			h.reportShadowRejection(ctx, req.Height, err)
+			return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_ACCEPT}, nil
+		}
+
+		h.logger.Info(
+			"processed proposal in shadow mode",
+			"num_txs", len(finalProposal.Txs),
+			"total_tx_bytes", finalProposal.Info.BlockSize,
+			"max_tx_bytes", finalProposal.Info.MaxBlockSize,
+			"total_gas_limit", finalProposal.Info.GasLimit,
+			"max_gas_limit", finalProposal.Info.MaxGasLimit,
+			"height", req.Height,
+		)
+
+		h.metrics.AddShadowProposalAccepted()
+
+		return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_ACCEPT}, nil
+	}
+}
+
+// reportShadowRejection logs and records a proposal that would have been rejected, identifying
+// the lane, the index of the offending transaction (-1 if the invariant is not specific to a
+// transaction) and the invariant that was violated. The rejection is also recorded as an error
+// of the proposal's span (if any), without marking the span as failed.
+func (h *ProposalHandler) reportShadowRejection(ctx sdk.Context, height int64, err error) {
+	invariantErr := proposals.GetInvariantError("", err)
+
+	h.logger.Error(
+		"proposal would have been rejected",
+		"height", height,
+		"lane", invariantErr.Lane,
+		"tx_index", invariantErr.TxIndex,
+		"invariant", string(invariantErr.Invariant),
+		"err", err,
+	)
+
+	h.metrics.AddShadowProposalRejected(invariantErr.Lane, string(invariantErr.Invariant))
+
+	trace.SpanFromContext(ctx.Context()).RecordError(
+		err,
+		trace.WithAttributes(
+			attribute.String("lane", invariantErr.Lane),
+			attribute.Int("tx_index", invariantErr.TxIndex),
+			attribute.String("invariant", string(invariantErr.Invariant)),
+		),
+	)
+}
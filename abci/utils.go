@@ -1,9 +1,16 @@
 package abci
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
 
 	"github.com/skip-mev/block-sdk/v2/block"
+	"github.com/skip-mev/block-sdk/v2/block/metrics"
 	"github.com/skip-mev/block-sdk/v2/block/proposals"
 	"github.com/skip-mev/block-sdk/v2/lanes/terminator"
 )
@@ -14,6 +21,12 @@ import (
 // fail to prepare the partial proposal, the lane that failed will be skipped and the next
 // lane in the chain will be called to prepare the proposal.
 func ChainPrepareLanes(chain []block.Lane) block.PrepareLanesHandler {
+	return chainPrepareLanes(chain, metrics.NewTelemetryMetrics())
+}
+
+// chainPrepareLanes implements ChainPrepareLanes, reporting the lanes that are skipped to
+// the given metrics.
+func chainPrepareLanes(chain []block.Lane, m metrics.Metrics) block.PrepareLanesHandler {
 	if len(chain) == 0 {
 		return nil
 	}
@@ -26,8 +39,16 @@ func ChainPrepareLanes(chain []block.Lane) block.PrepareLanesHandler {
 	return func(ctx sdk.Context, partialProposal proposals.Proposal) (finalProposal proposals.Proposal, err error) {
 		lane := chain[0]
 
+		// Determine the limits of the lane, redistributing any block space left unused by the
+		// previous lanes. If the lane fails to prepare its partial proposal, all of its block
+		// space is left unused for the next lanes.
+		partialProposal.AllocateLane(lane, remainingLanes(chain))
+
+		// Trace the preparation of the lane's partial proposal in a child span of the proposal.
+		laneCtx, span := startLaneSpan(ctx, lane, "PrepareLane")
+
 		// Cache the context in the case where any of the lanes fail to prepare the proposal.
-		cacheCtx, write := ctx.CacheContext()
+		cacheCtx, write := laneCtx.CacheContext()
 
 		// We utilize a recover to handle any panics or errors that occur during the preparation
 		// of a lane's transactions. This defer will first check if there was a panic or error
@@ -35,6 +56,14 @@ func ChainPrepareLanes(chain []block.Lane) block.PrepareLanesHandler {
 		// and call the next lane in the chain to the prepare the proposal.
 		defer func() {
 			if rec := recover(); rec != nil || err != nil {
+				m.AddLaneFailure(lane.Name())
+
+				laneErr := err
+				if rec != nil {
+					laneErr = fmt.Errorf("lane %s panicked: %v", lane.Name(), rec)
+				}
+				endSpan(span, laneErr)
+
 				if len(chain) <= 2 {
 					// If there are only two lanes remaining, then the first lane in the chain
 					// is the lane that failed to prepare the partial proposal and the second lane in the
@@ -45,7 +74,7 @@ func ChainPrepareLanes(chain []block.Lane) block.PrepareLanesHandler {
 					// is the lane that failed to prepare the proposal but the second lane in the
 					// chain is not the terminator lane so there could potentially be more transactions
 					// added to the proposal
-					finalProposal, err = ChainPrepareLanes(chain[1:])(ctx, partialProposal)
+					finalProposal, err = chainPrepareLanes(chain[1:], m)(ctx, partialProposal)
 				}
 			} else {
 				// Write the cache to the context since we know that the lane successfully prepared
@@ -53,13 +82,14 @@ func ChainPrepareLanes(chain []block.Lane) block.PrepareLanesHandler {
 				// that the final context will only be updated after all other lanes have successfully
 				// prepared the partial proposal.
 				write()
+				span.End()
 			}
 		}()
 
 		return lane.PrepareLane(
 			cacheCtx,
 			partialProposal,
-			ChainPrepareLanes(chain[1:]),
+			prepareNextLanes(ctx, span, chainPrepareLanes(chain[1:], m)),
 		)
 	}
 }
@@ -83,6 +113,104 @@ func ChainProcessLanes(chain []block.Lane) block.ProcessLanesHandler {
 
 	return func(ctx sdk.Context, proposal proposals.Proposal, txs []sdk.Tx) (proposals.Proposal, error) {
 		lane := chain[0]
-		return lane.ProcessLane(ctx, proposal, txs, ChainProcessLanes(chain[1:]))
+
+		// Determine the limits of the lane exactly as they were determined when the proposal
+		// was prepared.
+		proposal.AllocateLane(lane, remainingLanes(chain))
+
+		// Trace the verification of the lane's partial proposal in a child span of the proposal.
+		laneCtx, span := startLaneSpan(ctx, lane, "ProcessLane")
+		defer span.End()
+
+		finalProposal, err := lane.ProcessLane(
+			laneCtx,
+			proposal,
+			txs,
+			processNextLanes(ctx, span, ChainProcessLanes(chain[1:])),
+		)
+		if err != nil {
+			// This is a no-op if the error was returned by one of the next lanes, since the
+			// span of this lane has already ended.
+			endSpan(span, err)
+		}
+
+		return finalProposal, err
+	}
+}
+
+// startLaneSpan starts a span for the given lane as a child of the span in the context (if
+// any) and returns the context with the span. Lanes are traced with their own tracer (see
+// base.LaneConfig). Lanes that do not expose a tracer are not traced.
+func startLaneSpan(ctx sdk.Context, lane block.Lane, name string) (sdk.Context, trace.Span) {
+	var tracer trace.Tracer = noop.NewTracerProvider().Tracer("")
+	if tracedLane, ok := lane.(interface{ Tracer() trace.Tracer }); ok && tracedLane.Tracer() != nil {
+		tracer = tracedLane.Tracer()
+	}
+
+	spanCtx, span := tracer.Start(
+		ctx.Context(),
+		name,
+		trace.WithAttributes(attribute.String("lane", lane.Name())),
+	)
+
+	return ctx.WithContext(spanCtx), span
+}
+
+// prepareNextLanes returns a PrepareLanesHandler that ends the span of the current lane before
+// calling the next lanes in the chain, such that the span of every lane is a child of the span
+// of the proposal (i.e. in the given parent context) rather than of the previous lane.
+func prepareNextLanes(parent sdk.Context, span trace.Span, next block.PrepareLanesHandler) block.PrepareLanesHandler {
+	return func(ctx sdk.Context, proposal proposals.Proposal) (proposals.Proposal, error) {
+		span.End()
+		return next(ctx.WithContext(parent.Context()), proposal)
+	}
+}
+
+// processNextLanes is the equivalent of prepareNextLanes for the verification of proposals.
+func processNextLanes(parent sdk.Context, span trace.Span, next block.ProcessLanesHandler) block.ProcessLanesHandler {
+	return func(ctx sdk.Context, proposal proposals.Proposal, txs []sdk.Tx) (proposals.Proposal, error) {
+		span.End()
+		return next(ctx.WithContext(parent.Context()), proposal, txs)
 	}
 }
+
+// endSpan records the error (if any) on the span and ends it.
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	span.End()
+}
+
+// remainingLanes returns the lanes that come after the first lane in the chain.
+func remainingLanes(chain []block.Lane) []proposals.Lane {
+	remaining := make([]proposals.Lane, len(chain)-1)
+	for i, lane := range chain[1:] {
+		remaining[i] = lane
+	}
+
+	return remaining
+}
+
+// decodeTxs decodes the transactions of a proposal. Transactions that cannot be decoded are
+// reported with their index and the given lane (if known).
+func decodeTxs(txDecoder sdk.TxDecoder, lane string, txs [][]byte) ([]sdk.Tx, error) {
+	decodedTxs := make([]sdk.Tx, len(txs))
+	for i, txBz := range txs {
+		tx, err := txDecoder(txBz)
+		if err != nil {
+			return nil, proposals.NewInvariantError(
+				lane,
+				i,
+				proposals.InvariantDecode,
+				fmt.Errorf("failed to decode transaction: %w", err),
+			)
+		}
+
+		decodedTxs[i] = tx
+	}
+
+	return decodedTxs, nil
+}
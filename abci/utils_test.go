@@ -18,6 +18,7 @@ import (
 	defaultlane "github.com/skip-mev/block-sdk/v2/lanes/base"
 	"github.com/skip-mev/block-sdk/v2/lanes/free"
 	"github.com/skip-mev/block-sdk/v2/lanes/mev"
+	testutils "github.com/skip-mev/block-sdk/v2/testutils"
 )
 
 func (s *ProposalsTestSuite) setUpAnteHandler(expectedExecution map[sdk.Tx]bool) sdk.AnteHandler {
@@ -63,6 +64,10 @@ func (s *ProposalsTestSuite) setUpCustomMatchHandlerLane(maxBlockSpace math.Lega
 		SignerExtractor: signeradaptors.NewDefaultAdapter(),
 	}
 
+	return s.setUpCustomMatchHandlerLaneWithConfig(cfg, mh, name)
+}
+
+func (s *ProposalsTestSuite) setUpCustomMatchHandlerLaneWithConfig(cfg base.LaneConfig, mh base.MatchHandler, name string) block.Lane {
 	options := []base.LaneOption{
 		base.WithMatchHandler(mh),
 		base.WithMempoolConfigs(cfg, base.DefaultTxPriority()),
@@ -78,6 +83,42 @@ func (s *ProposalsTestSuite) setUpCustomMatchHandlerLane(maxBlockSpace math.Lega
 	return lane
 }
 
+// setUpAllocatedLane sets up a lane with a reserved (min) block space and a redistribution
+// weight. If a signer is provided, the lane only matches transactions signed by the signer.
+func (s *ProposalsTestSuite) setUpAllocatedLane(
+	name string,
+	signer *testutils.Account,
+	maxBlockSpace, minBlockSpace math.LegacyDec,
+	weight uint64,
+	expectedExecution map[sdk.Tx]bool,
+) block.Lane {
+	cfg := base.LaneConfig{
+		Logger:               log.NewNopLogger(),
+		TxEncoder:            s.encodingConfig.TxConfig.TxEncoder(),
+		TxDecoder:            s.encodingConfig.TxConfig.TxDecoder(),
+		AnteHandler:          s.setUpAnteHandler(expectedExecution),
+		MaxBlockSpace:        maxBlockSpace,
+		MinBlockSpace:        minBlockSpace,
+		RedistributionWeight: weight,
+		SignerExtractor:      signeradaptors.NewDefaultAdapter(),
+	}
+
+	mh := func(_ sdk.Context, tx sdk.Tx) bool {
+		if signer == nil {
+			return true
+		}
+
+		signers, err := cfg.SignerExtractor.GetSigners(tx)
+		if err != nil || len(signers) == 0 {
+			return false
+		}
+
+		return signers[0].Signer.Equals(signer.Address)
+	}
+
+	return s.setUpCustomMatchHandlerLaneWithConfig(cfg, mh, name)
+}
+
 func (s *ProposalsTestSuite) setUpStandardLane(maxBlockSpace math.LegacyDec, expectedExecution map[sdk.Tx]bool) *base.BaseLane {
 	cfg := base.LaneConfig{
 		Logger:          log.NewNopLogger(),
@@ -144,7 +185,7 @@ func (s *ProposalsTestSuite) setUpPanicLane(name string, maxBlockSpace math.Lega
 	return lane
 }
 
-func (s *ProposalsTestSuite) setUpProposalHandlers(lanes []block.Lane) *abci.ProposalHandler {
+func (s *ProposalsTestSuite) setUpProposalHandlers(lanes []block.Lane, opts ...abci.ProposalHandlerOption) *abci.ProposalHandler {
 	mempool, err := block.NewLanedMempool(
 		log.NewNopLogger(),
 		lanes,
@@ -157,6 +198,7 @@ func (s *ProposalsTestSuite) setUpProposalHandlers(lanes []block.Lane) *abci.Pro
 		s.encodingConfig.TxConfig.TxEncoder(),
 		mempool,
 		true,
+		opts...,
 	)
 }
 
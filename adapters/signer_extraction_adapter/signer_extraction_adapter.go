@@ -2,6 +2,7 @@ package signerextraction
 
 import (
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/signing"
@@ -10,6 +11,28 @@ import (
 type SignerData struct {
 	Signer   sdk.AccAddress
 	Sequence uint64
+
+	// Unordered is true if the transaction is unordered, i.e. it is not sequenced by the
+	// sequence number of the signer, which is ignored, but expires at a timeout timestamp
+	// instead (see TxWithUnordered).
+	Unordered bool
+}
+
+// TxWithUnordered defines a transaction that can be unordered, as introduced by the Cosmos
+// SDK's unordered transactions. An unordered transaction does not use (or increment) the
+// sequence numbers of its signers. Instead, it is valid until its timeout timestamp and
+// replay protection is based on its hash.
+type TxWithUnordered interface {
+	sdk.Tx
+
+	GetUnordered() bool
+	GetTimeoutTimeStamp() time.Time
+}
+
+// IsUnordered returns true if the transaction is an unordered transaction.
+func IsUnordered(tx sdk.Tx) bool {
+	unorderedTx, ok := tx.(TxWithUnordered)
+	return ok && unorderedTx.GetUnordered()
 }
 
 // NewSignerData returns a new SignerData instance.
@@ -22,6 +45,10 @@ func NewSignerData(signer sdk.AccAddress, sequence uint64) SignerData {
 
 // String implements the fmt.Stringer interface.
 func (s SignerData) String() string {
+	if s.Unordered {
+		return fmt.Sprintf("SignerData{Signer: %s, Unordered: true}", s.Signer)
+	}
+
 	return fmt.Sprintf("SignerData{Signer: %s, Sequence: %d}", s.Signer, s.Sequence)
 }
 
@@ -52,11 +79,14 @@ func (DefaultAdapter) GetSigners(tx sdk.Tx) ([]SignerData, error) {
 		return nil, err
 	}
 
+	unordered := IsUnordered(tx)
+
 	signers := make([]SignerData, len(sigs))
 	for i, sig := range sigs {
 		signers[i] = SignerData{
-			Signer:   sig.PubKey.Address().Bytes(),
-			Sequence: sig.Sequence,
+			Signer:    sig.PubKey.Address().Bytes(),
+			Sequence:  sig.Sequence,
+			Unordered: unordered,
 		}
 	}
 
@@ -3,6 +3,7 @@ package signerextraction_test
 import (
 	"math/rand"
 	"testing"
+	"time"
 
 	"cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/client"
@@ -52,3 +53,23 @@ func (s *SignerExtractionAdapterTestSuite) TestGetSigners() {
 	s.Require().Equal(acct.Address.String(), signers[0].Signer.String())
 	s.Require().Equal(uint64(1), signers[0].Sequence)
 }
+
+func (s *SignerExtractionAdapterTestSuite) TestGetSignersUnordered() {
+	acct := s.accts[0]
+	tx, err := testutils.CreateUnorderedTx(s.txConfig, acct, time.Unix(100, 0), 1, sdk.NewCoin("test", math.NewInt(1)))
+	s.Require().NoError(err)
+	s.Require().True(signer_extraction.IsUnordered(tx))
+
+	signers, err := s.adapter.GetSigners(tx)
+	s.Require().NoError(err)
+
+	s.Require().Len(signers, 1)
+	s.Require().Equal(acct.Address.String(), signers[0].Signer.String())
+	s.Require().True(signers[0].Unordered)
+
+	// Ordered transactions are not marked as unordered.
+	signers, err = s.adapter.GetSigners(tx.Tx)
+	s.Require().NoError(err)
+	s.Require().False(signers[0].Unordered)
+	s.Require().False(signer_extraction.IsUnordered(tx.Tx))
+}
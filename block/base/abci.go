@@ -1,6 +1,10 @@
 package base
 
 import (
+	"errors"
+	"fmt"
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/skip-mev/block-sdk/v2/block"
@@ -19,9 +23,14 @@ func (l *BaseLane) PrepareLane(
 ) (proposals.Proposal, error) {
 	l.Logger().Info("preparing lane", "lane", l.Name())
 
+	start := time.Now()
+
+	// Promote the queued transactions whose sequence gap was closed by the previous blocks.
+	l.PromoteQueuedTxs(ctx)
+
 	// Select transactions from the lane respecting the selection logic of the lane and the
 	// max block space for the lane.
-	limit := proposal.GetLaneLimits(l.cfg.MaxBlockSpace)
+	limit := proposal.GetLaneLimitsForLane(l)
 	txsToInclude, txsToRemove, err := l.prepareLaneHandler(ctx, proposal, limit)
 	if err != nil {
 		l.Logger().Error(
@@ -42,8 +51,17 @@ func (l *BaseLane) PrepareLane(
 		)
 	}
 
+	l.Metrics().AddTxsEvicted(l.Name(), len(txsToRemove))
+	l.Metrics().SetLaneSize(l.Name(), l.CountTx())
+	l.pruneReverts()
+
 	// Get the transaction info for each transaction that was selected.
-	txsWithInfo := make([]utils.TxWithInfo, len(txsToInclude))
+	var (
+		txsWithInfo = make([]utils.TxWithInfo, len(txsToInclude))
+		size        int64
+		gasLimit    uint64
+	)
+
 	for i, tx := range txsToInclude {
 		txInfo, err := l.GetTxInfo(ctx, tx)
 		if err != nil {
@@ -57,6 +75,8 @@ func (l *BaseLane) PrepareLane(
 		}
 
 		txsWithInfo[i] = txInfo
+		size += txInfo.Size
+		gasLimit += txInfo.GasLimit
 	}
 
 	// Update the proposal with the selected transactions. This fails if the lane attempted to add
@@ -70,6 +90,7 @@ func (l *BaseLane) PrepareLane(
 			"num_txs_to_remove", len(txsToRemove),
 			"lane_max_block_size", limit.MaxTxBytes,
 			"lane_max_gas_limit", limit.MaxGasLimit,
+			"lane_max_txs", limit.MaxTxs,
 		)
 
 		return proposal, err
@@ -82,8 +103,12 @@ func (l *BaseLane) PrepareLane(
 		"num_txs_removed", len(txsToRemove),
 		"lane_max_block_size", limit.MaxTxBytes,
 		"lane_max_gas_limit", limit.MaxGasLimit,
+		"lane_max_txs", limit.MaxTxs,
 	)
 
+	l.Metrics().ObservePrepareLaneLatency(l.Name(), time.Since(start))
+	l.Metrics().ObservePartialProposal(l.Name(), len(txsWithInfo), size, gasLimit)
+
 	return next(ctx, proposal)
 }
 
@@ -106,6 +131,8 @@ func (l *BaseLane) ProcessLane(
 		return next(ctx, proposal, txs)
 	}
 
+	start := time.Now()
+
 	// Verify the transactions that belong to the lane and return any transactions that must be
 	// validated by the next lane in the chain.
 	txsFromLane, remainingTxs, err := l.processLaneHandler(ctx, txs)
@@ -116,7 +143,7 @@ func (l *BaseLane) ProcessLane(
 			"err", err,
 		)
 
-		return proposal, err
+		return proposal, proposals.OffsetInvariantError(l.Name(), len(proposal.Txs), err)
 	}
 
 	// Retrieve the transaction info for each transaction that belongs to the lane.
@@ -130,7 +157,7 @@ func (l *BaseLane) ProcessLane(
 				"err", err,
 			)
 
-			return proposal, err
+			return proposal, proposals.OffsetInvariantError(l.Name(), len(proposal.Txs), err)
 		}
 
 		txsWithInfo[i] = txInfo
@@ -145,7 +172,7 @@ func (l *BaseLane) ProcessLane(
 			"err", err,
 		)
 
-		return proposal, err
+		return proposal, proposals.OffsetInvariantError(l.Name(), len(proposal.Txs), err)
 	}
 
 	l.Logger().Info(
@@ -155,23 +182,146 @@ func (l *BaseLane) ProcessLane(
 		"num_txs_remaining", len(remainingTxs),
 	)
 
+	l.Metrics().ObserveProcessLaneLatency(l.Name(), time.Since(start))
+
 	// Validate the remaining transactions with the next lane in the chain.
 	return next(ctx, proposal, remainingTxs)
 }
 
+// ProcessLaneBasic verifies the stateless invariants of the transactions included in the block
+// proposal that belong to the lane respecting the verification logic of the lane
+// (processLaneBasicHandler). Stateful verification is done separately with VerifyTx.
+func (l *BaseLane) ProcessLaneBasic(
+	ctx sdk.Context,
+	partialProposal []sdk.Tx,
+	remainingTxs []sdk.Tx,
+) error {
+	if l.processLaneBasicHandler == nil {
+		return block.ErrProcessLaneBasicNotSupported
+	}
+
+	return l.processLaneBasicHandler(ctx, partialProposal, remainingTxs)
+}
+
+// ErrTxReverted is returned by VerifyAndExecuteTx if the messages of the transaction fail to
+// execute, i.e. the transaction would revert if it were included in the block.
+var ErrTxReverted = errors.New("tx reverted")
+
+// DefaultMaxTxReverts is the number of proposals a transaction that reverts is excluded from
+// before it is removed from the lane's mempool, unless the lane's config sets MaxTxReverts.
+const DefaultMaxTxReverts = 3
+
 // VerifyTx verifies that the transaction is valid respecting the ante verification logic of
-// of the antehandler chain.
+// of the antehandler chain. The messages of the transaction are never executed, such that
+// VerifyTx can be used in CheckTx and to verify proposals (see VerifyAndExecuteTx). The state
+// changes of the transaction are only written to the context if the transaction does not
+// fail, so transactions must be verified in the order in which they are included in the
+// proposal.
 func (l *BaseLane) VerifyTx(ctx sdk.Context, tx sdk.Tx, simulate bool) error {
+	return l.verifyTx(ctx, tx, simulate, false)
+}
+
+// VerifyAndExecuteTx verifies the transaction like VerifyTx and, if the lane has a MsgRouter,
+// also executes its messages, such that transactions that would revert are not included in
+// the proposal. It must only be used while the lane prepares a proposal: a transaction that
+// reverts is still valid in a block, so proposals are verified with VerifyTx.
+func (l *BaseLane) VerifyAndExecuteTx(ctx sdk.Context, tx sdk.Tx) error {
+	return l.verifyTx(ctx, tx, false, l.cfg.MsgRouter != nil)
+}
+
+// verifyTx runs the ante handler on the transaction and, if execute is set, executes its
+// messages with the lane's MsgRouter.
+func (l *BaseLane) verifyTx(ctx sdk.Context, tx sdk.Tx, simulate, execute bool) error {
+	if l.cfg.AnteHandler == nil && !execute {
+		return nil
+	}
+
+	// Only write to the context if the tx does not fail.
+	catchCtx, write := ctx.CacheContext()
 	if l.cfg.AnteHandler != nil {
-		// Only write to the context if the tx does not fail.
-		catchCtx, write := ctx.CacheContext()
-		if _, err := l.cfg.AnteHandler(catchCtx, tx, simulate); err != nil {
+		newCtx, err := l.cfg.AnteHandler(catchCtx, tx, simulate)
+		if err != nil {
 			return err
 		}
 
-		write()
+		catchCtx = newCtx
+	}
 
-		return nil
+	if execute {
+		if err := l.executeMsgs(catchCtx, tx); err != nil {
+			return err
+		}
+	}
+
+	write()
+
+	return nil
+}
+
+// removeOnVerifyError returns true if the transaction with the given hash, which failed
+// VerifyAndExecuteTx with the given error while a proposal is built, must be removed from the
+// lane's mempool. Transactions that revert are only removed if the lane's config says so (see
+// RemoveRevertingTxs) or once they reverted MaxTxReverts times.
+func (l *BaseLane) removeOnVerifyError(txHash string, err error) bool {
+	if !errors.Is(err, ErrTxReverted) || l.cfg.RemoveRevertingTxs {
+		return true
+	}
+
+	l.revertsMtx.Lock()
+	defer l.revertsMtx.Unlock()
+
+	maxReverts := l.cfg.MaxTxReverts
+	if maxReverts <= 0 {
+		maxReverts = DefaultMaxTxReverts
+	}
+
+	if l.reverts == nil {
+		l.reverts = make(map[string]int)
+	}
+
+	l.reverts[txHash]++
+	if l.reverts[txHash] < maxReverts {
+		return false
+	}
+
+	delete(l.reverts, txHash)
+
+	return true
+}
+
+// pruneReverts forgets the reverts of the transactions that are no longer in the lane's
+// mempool, e.g. because they were included in a block.
+func (l *BaseLane) pruneReverts() {
+	l.revertsMtx.Lock()
+	defer l.revertsMtx.Unlock()
+
+	for txHash := range l.reverts {
+		if _, found := l.LookupHash(txHash); !found {
+			delete(l.reverts, txHash)
+		}
+	}
+}
+
+// executeMsgs executes the messages of the transaction with the lane's MsgRouter. The
+// returned error wraps ErrTxReverted if any of the messages fails (or panics, e.g. when
+// running out of gas).
+func (l *BaseLane) executeMsgs(ctx sdk.Context, tx sdk.Tx) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%w: panic executing msgs: %v", ErrTxReverted, r)
+		}
+	}()
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	for i, msg := range tx.GetMsgs() {
+		handler := l.cfg.MsgRouter.Handler(msg)
+		if handler == nil {
+			return fmt.Errorf("%w: no message handler found for %s", ErrTxReverted, sdk.MsgTypeURL(msg))
+		}
+
+		if _, err := handler(ctx, msg); err != nil {
+			return fmt.Errorf("%w: failed to execute message %d: %s", ErrTxReverted, i, err)
+		}
 	}
 
 	return nil
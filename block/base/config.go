@@ -5,11 +5,20 @@ import (
 
 	"cosmossdk.io/log"
 	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"go.opentelemetry.io/otel/trace"
 
 	signer_extraction "github.com/skip-mev/block-sdk/v2/adapters/signer_extraction_adapter"
+	"github.com/skip-mev/block-sdk/v2/block/metrics"
 )
 
+// MsgRouter defines the interface of the router used to execute the messages of
+// transactions (e.g. baseapp.MsgServiceRouter).
+type MsgRouter interface {
+	Handler(msg sdk.Msg) baseapp.MsgServiceHandler
+}
+
 // LaneConfig defines the basic configurations needed for a lane.
 type LaneConfig struct {
 	Logger      log.Logger
@@ -27,6 +36,30 @@ type LaneConfig struct {
 	// lane (up to maxTxBytes as provided by the request). This is useful for the default lane.
 	MaxBlockSpace math.LegacyDec
 
+	// MaxBlockSpaceBytes optionally defines the relative percentage of the block's max bytes
+	// that can be used by this lane. If unset, MaxBlockSpace is used. NOTE: If this is set
+	// to zero, the lane can use whatever bytes remain in the block.
+	MaxBlockSpaceBytes math.LegacyDec
+
+	// MaxBlockSpaceGas optionally defines the relative percentage of the block's max gas
+	// limit that can be used by this lane. If unset, MaxBlockSpace is used. NOTE: If this is
+	// set to zero, the lane can use whatever gas remains in the block.
+	MaxBlockSpaceGas math.LegacyDec
+
+	// MaxTxsPerBlock defines the maximum number of transactions this lane can include in
+	// a single block. If set to zero, there is no limit on the number of transactions.
+	MaxTxsPerBlock uint64
+
+	// MinBlockSpace optionally defines the relative percentage of block space (both bytes
+	// and gas) that is reserved for this lane. Lanes that come before this lane cannot
+	// consume the reserved space. If unset, nothing is reserved.
+	MinBlockSpace math.LegacyDec
+
+	// RedistributionWeight defines the relative weight this lane has when block space left
+	// unused by the lanes before it is redistributed. If set to zero, the lane does not
+	// receive any of the unused block space.
+	RedistributionWeight uint64
+
 	// MaxTxs sets the maximum number of transactions allowed in the mempool with
 	// the semantics:
 	// - if MaxTx == 0, there is no cap on the number of transactions in the mempool
@@ -35,6 +68,63 @@ type LaneConfig struct {
 	//   (sequence number) when evicting transactions.
 	// - if MaxTx < 0, `Insert` is a no-op.
 	MaxTxs int
+
+	// EvictLowerPriority defines the behavior of the lane's mempool once it holds MaxTxs
+	// transactions. If false, new transactions are rejected. If true, a new transaction with a
+	// higher priority than the lowest priority transaction in the mempool evicts it, along with
+	// the later transactions of the same signer. The evicted transactions are reported to the
+	// LanedMempool (see block.TxEvictor), which removes them from its indexes and passes them
+	// to its eviction handler (see block.WithEvictionHandler).
+	EvictLowerPriority bool
+
+	// TxReplacement optionally defines the policy a transaction must satisfy to replace the
+	// transaction in the lane's mempool with the same signer and sequence number (e.g. see
+	// NewFeeBumpReplacementPolicy). This allows users to speed up transactions that are stuck
+	// in the mempool. If unset, transactions cannot be replaced through CheckTx.
+	TxReplacement TxReplacementPolicy
+
+	// AccountKeeper optionally defines the keeper used to retrieve the sequence numbers of
+	// accounts. If set, transactions whose sequence number is above the next sequence number
+	// of their signer are held in a queued pool, and are not selected for proposals until the
+	// gap closes. If unset, all transactions are pending. NOTE: Transactions only reach the
+	// mempool if they pass CheckTx, so the ante handler must accept sequence numbers above the
+	// signer's account sequence in CheckTx (the default SDK ante handler rejects them).
+	AccountKeeper AccountKeeper
+
+	// MaxQueuedTxs sets the maximum number of transactions in the queued pool (see
+	// AccountKeeper), with the same semantics as MaxTxs, which only bounds the pending pool.
+	// If zero, the queued pool holds at most a quarter of MaxTxs (and at least one
+	// transaction), or any number of transactions if MaxTxs is zero.
+	MaxQueuedTxs int
+
+	// MsgRouter optionally defines the router used to execute the messages of the lane's
+	// transactions, e.g. the app's baseapp.MsgServiceRouter. If set, transactions are fully
+	// executed when the lane prepares a proposal (see VerifyAndExecuteTx), and transactions
+	// that would revert are not included in the proposal. Proposals are verified with the
+	// ante handler only, since a transaction that reverts is still valid in a block. If
+	// unset, only the ante handler is run.
+	MsgRouter MsgRouter
+
+	// RemoveRevertingTxs defines whether transactions that revert when they are executed
+	// while a proposal is built are also removed from the lane's mempool. If false, they
+	// are only excluded from the proposal until they reverted MaxTxReverts times. It is
+	// only used if MsgRouter is set.
+	RemoveRevertingTxs bool
+
+	// MaxTxReverts sets the number of proposals a transaction that reverts is excluded from
+	// before it is removed from the lane's mempool, if RemoveRevertingTxs is false. If zero,
+	// DefaultMaxTxReverts is used.
+	MaxTxReverts int
+
+	// Metrics optionally defines where the lane reports its metrics (e.g. prepare/process
+	// latency and the size of its partial proposals). If unset, the lane reports to the
+	// Cosmos SDK telemetry.
+	Metrics metrics.Metrics
+
+	// Tracer optionally defines the OpenTelemetry tracer used to trace the preparation and
+	// verification of the lane's partial proposals (e.g. the transactions that were rejected
+	// and why). If unset, the lane is not traced.
+	Tracer trace.Tracer
 }
 
 // NewLaneConfig returns a new LaneConfig. This will be embedded in a lane.
@@ -78,5 +168,17 @@ func (c *LaneConfig) ValidateBasic() error {
 		return fmt.Errorf("max block space must be set to a value between 0 and 1")
 	}
 
+	if !c.MaxBlockSpaceBytes.IsNil() && (c.MaxBlockSpaceBytes.IsNegative() || c.MaxBlockSpaceBytes.GT(math.LegacyOneDec())) {
+		return fmt.Errorf("max block space bytes must be set to a value between 0 and 1")
+	}
+
+	if !c.MaxBlockSpaceGas.IsNil() && (c.MaxBlockSpaceGas.IsNegative() || c.MaxBlockSpaceGas.GT(math.LegacyOneDec())) {
+		return fmt.Errorf("max block space gas must be set to a value between 0 and 1")
+	}
+
+	if !c.MinBlockSpace.IsNil() && (c.MinBlockSpace.IsNegative() || c.MinBlockSpace.GT(math.LegacyOneDec())) {
+		return fmt.Errorf("min block space must be set to a value between 0 and 1")
+	}
+
 	return nil
 }
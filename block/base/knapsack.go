@@ -0,0 +1,691 @@
+package base
+
+import (
+	"container/heap"
+	"context"
+	"fmt"
+	"sort"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/block-sdk/v2/block/proposals"
+	"github.com/skip-mev/block-sdk/v2/block/utils"
+)
+
+// DefaultKnapsackSearchLimit is the default maximum number of partial selections the
+// KnapsackProposalHandler explores when searching for the optimal selection of a lane.
+const DefaultKnapsackSearchLimit = 100_000
+
+type (
+	// TxValue returns the value of including the transaction in a proposal, e.g. its
+	// fee. The KnapsackProposalHandler selects the transactions with the highest total
+	// value. Values must not be negative.
+	TxValue func(ctx context.Context, tx sdk.Tx) math.LegacyDec
+
+	// KnapsackOption defines a function that can be used to set options on a
+	// KnapsackProposalHandler.
+	KnapsackOption func(*KnapsackProposalHandler)
+
+	// KnapsackProposalHandler is an alternative to the DefaultProposalHandler that, instead
+	// of filling the lane greedily in priority order, selects the set of transactions with
+	// the highest total value (see TxValue) that fits the lane's byte, gas and transaction
+	// limits. A sender's transactions are selected in nonce order, i.e. a transaction is only
+	// selected if the sender's previous transactions in the lane are selected as well.
+	//
+	// The selected transactions are ordered by priority, where each transaction must have a
+	// priority at least as high as the next transaction of every other sender, since a
+	// sender's transactions must stay in nonce order. The ProcessLaneHandler and
+	// ProcessLaneBasicHandler of the KnapsackProposalHandler verify this ordering. It is
+	// stricter than the ordering verified by the DefaultProposalHandler, which only compares
+	// adjacent transactions.
+	KnapsackProposalHandler struct {
+		lane  *BaseLane
+		value TxValue
+
+		// searchLimit is the maximum number of partial selections that are explored.
+		searchLimit int
+
+		// tracer records the transactions that are not selected (see traceRejectedTx).
+		tracer *DefaultProposalHandler
+	}
+
+	// knapsackItem is a transaction that can be selected by the KnapsackProposalHandler.
+	knapsackItem struct {
+		tx    sdk.Tx
+		info  utils.TxWithInfo
+		value float64
+	}
+
+	// knapsackGroup are the transactions of a sender, in nonce order, along with the
+	// cumulative size, gas limit and value of each prefix of the transactions.
+	knapsackGroup struct {
+		items []knapsackItem
+		size  []int64
+		gas   []uint64
+		value []float64
+	}
+
+	// knapsackSearch is the state of the search for the optimal selection.
+	knapsackSearch struct {
+		groups []*knapsackGroup
+		limit  proposals.LaneLimits
+
+		// laneOrder are the groups of the transactions in the lane's order.
+		laneOrder []int
+
+		// bound is the total value of the groups from each index onwards.
+		bound []float64
+
+		// current and best are the number of transactions selected from each group in the
+		// current and best selections.
+		current   []int
+		best      []int
+		bestValue float64
+
+		nodes       int
+		searchLimit int
+	}
+)
+
+// WithKnapsackSearchLimit sets the maximum number of partial selections the handler explores
+// when searching for the optimal selection. The best selection found within the limit is
+// used, which is at least as valuable as the selection made by filling the lane greedily in
+// priority order.
+func WithKnapsackSearchLimit(limit int) KnapsackOption {
+	return func(h *KnapsackProposalHandler) {
+		if limit <= 0 {
+			panic("knapsack search limit must be positive")
+		}
+
+		h.searchLimit = limit
+	}
+}
+
+// NewKnapsackProposalHandler returns a new knapsack proposal handler that maximizes the
+// given value of the transactions selected for the lane.
+func NewKnapsackProposalHandler(lane *BaseLane, value TxValue, options ...KnapsackOption) *KnapsackProposalHandler {
+	h := &KnapsackProposalHandler{
+		lane:        lane,
+		value:       value,
+		searchLimit: DefaultKnapsackSearchLimit,
+		tracer:      NewDefaultProposalHandler(lane),
+	}
+
+	for _, option := range options {
+		option(h)
+	}
+
+	return h
+}
+
+// FeeTxValue returns a TxValue of the fee of the transactions, converted to the reference
+// denom (see DenomConverter).
+func FeeTxValue(converter DenomConverter) TxValue {
+	return func(ctx context.Context, tx sdk.Tx) math.LegacyDec {
+		feeTx, ok := tx.(sdk.FeeTx)
+		if !ok {
+			return math.LegacyZeroDec()
+		}
+
+		return feeValue(ctx, feeTx.GetFee(), converter)
+	}
+}
+
+// PriorityTxValue returns a TxValue of the priority of the transactions, given a function
+// that converts priorities into values. Priorities that cannot be converted have no value.
+func PriorityTxValue[C comparable](txPriority TxPriority[C], toValue func(C) (math.LegacyDec, bool)) TxValue {
+	return func(ctx context.Context, tx sdk.Tx) math.LegacyDec {
+		value, ok := toValue(txPriority.GetTxPriority(ctx, tx))
+		if !ok {
+			return math.LegacyZeroDec()
+		}
+
+		return value
+	}
+}
+
+// PrepareLaneHandler returns a PrepareLaneHandler that selects the valid transactions of
+// the lane with the highest total value that fit the lane's limits. Transactions are first
+// verified in the lane's order, and the invalid ones are removed from the lane. The selected
+// transactions are then verified again in the order in which they are included, since
+// excluding transactions may change the outcome of the verification of the others. A
+// transaction that panics is removed without affecting the others (see RecoverTxPanic).
+//
+// NOTE: Finding the optimal selection is NP-hard. The search is exact as long as it explores
+// at most the handler's search limit of partial selections (see WithKnapsackSearchLimit).
+// Values are compared as float64 during the search.
+func (h *KnapsackProposalHandler) PrepareLaneHandler() PrepareLaneHandler {
+	return func(ctx sdk.Context, proposal proposals.Proposal, limit proposals.LaneLimits) ([]sdk.Tx, []sdk.Tx, error) {
+		groups, laneOrder, txsToRemove := h.candidates(ctx, proposal, limit)
+
+		search := newKnapsackSearch(groups, laneOrder, limit, h.searchLimit)
+		selected := search.solve()
+
+		// Order the selected transactions and verify them in that order.
+		senders := make([]*senderQueue, 0, len(groups))
+		for g, group := range groups {
+			if selected[g] == 0 {
+				continue
+			}
+
+			txs := make([]sdk.Tx, selected[g])
+			for i := range txs {
+				txs[i] = group.items[i].tx
+			}
+
+			senders = append(senders, &senderQueue{txs: txs})
+		}
+
+		var (
+			txsToInclude = make([]sdk.Tx, 0, search.count(selected))
+			failed       = make(map[*senderQueue]bool)
+		)
+
+		heads := newSenderHeads(ctx, h.lane, senders)
+		for heads.Len() > 0 {
+			sender := heads.queues[0]
+			tx := sender.head()
+			heads.advance(sender)
+
+			// A sender's transactions after a transaction that failed are not included
+			// since their sequence no longer matches.
+			if failed[sender] {
+				continue
+			}
+
+			err := h.lane.RecoverTxPanic(ctx, tx, func() error {
+				return h.lane.VerifyAndExecuteTx(ctx, tx)
+			})
+			if err != nil {
+				txInfo, _ := h.lane.GetTxInfo(ctx, tx)
+				remove := h.lane.removeOnVerifyError(txInfo.Hash, err)
+				h.lane.Logger().Info(
+					"failed to verify tx",
+					"tx_hash", txInfo.Hash,
+					"err", err,
+				)
+				h.tracer.traceRejectedTx(ctx, txInfo.Hash, fmt.Sprintf("failed to verify tx: %s", err), remove)
+
+				failed[sender] = true
+				if remove {
+					txsToRemove = append(txsToRemove, tx)
+				}
+				continue
+			}
+
+			txsToInclude = append(txsToInclude, tx)
+		}
+
+		return txsToInclude, txsToRemove, nil
+	}
+}
+
+// candidates returns the transactions of the lane that can be selected, grouped by sender,
+// the groups of the transactions in the lane's order and the transactions that must be
+// removed from the lane. The transactions are verified in the lane's order on a copy of
+// the state, which is discarded.
+func (h *KnapsackProposalHandler) candidates(
+	ctx sdk.Context,
+	proposal proposals.Proposal,
+	limit proposals.LaneLimits,
+) ([]*knapsackGroup, []int, []sdk.Tx) {
+	var (
+		groups      []*knapsackGroup
+		laneOrder   []int
+		groupIndex  = make(map[string]int)
+		skipped     = make(map[string]bool)
+		txsToRemove []sdk.Tx
+	)
+
+	verifyCtx, _ := ctx.CacheContext()
+	for iterator := h.lane.Select(ctx, nil); iterator != nil; iterator = iterator.Next() {
+		tx := iterator.Tx()
+
+		var txInfo utils.TxWithInfo
+		err := h.lane.RecoverTxPanic(ctx, tx, func() (err error) {
+			txInfo, err = h.lane.GetTxInfo(ctx, tx)
+			return err
+		})
+		if err != nil {
+			h.lane.Logger().Info("failed to get hash of tx", "err", err)
+			h.tracer.traceRejectedTx(ctx, "", fmt.Sprintf("failed to get tx info: %s", err), true)
+
+			txsToRemove = append(txsToRemove, tx)
+			continue
+		}
+
+		sender := txSender(txInfo)
+		if skipped[sender] {
+			h.tracer.traceRejectedTx(ctx, txInfo.Hash, PreviousTxNotIncludedReason, false)
+			continue
+		}
+
+		var matches bool
+		matchErr := h.lane.RecoverTxPanic(ctx, tx, func() error {
+			matches = h.lane.Match(ctx, tx)
+			return nil
+		})
+
+		var (
+			reason string
+			remove bool
+		)
+		switch {
+		case txInfo.GasLimit > limit.MaxGasLimit:
+			reason, remove = "gas limit above the maximum allowed", true
+		case txInfo.Size > limit.MaxTxBytes:
+			reason, remove = "tx bytes above the maximum allowed", true
+		case matchErr != nil:
+			reason, remove = fmt.Sprintf("failed to match tx: %s", matchErr), true
+		case !matches:
+			reason, remove = "tx does not belong to lane", true
+		case proposal.Contains(txInfo.Hash):
+			// The sender's next transactions can still be selected since this one is
+			// already included.
+			h.tracer.traceRejectedTx(ctx, txInfo.Hash, "tx is already in proposal", false)
+			continue
+		default:
+			err := h.lane.RecoverTxPanic(ctx, tx, func() error {
+				return h.lane.VerifyAndExecuteTx(verifyCtx, tx)
+			})
+			if err != nil {
+				reason, remove = fmt.Sprintf("failed to verify tx: %s", err), h.lane.removeOnVerifyError(txInfo.Hash, err)
+			}
+		}
+
+		if reason != "" {
+			h.lane.Logger().Info(
+				"failed to select tx for lane",
+				"lane", h.lane.Name(),
+				"tx_hash", txInfo.Hash,
+				"reason", reason,
+			)
+			h.tracer.traceRejectedTx(ctx, txInfo.Hash, reason, remove)
+
+			if remove {
+				txsToRemove = append(txsToRemove, tx)
+			}
+
+			// The sender's next transactions cannot be selected without this one.
+			skipped[sender] = true
+			continue
+		}
+
+		value, err := h.value(ctx, tx).Float64()
+		if err != nil || value < 0 {
+			value = 0
+		}
+
+		g, ok := groupIndex[sender]
+		if !ok {
+			g = len(groups)
+			groupIndex[sender] = g
+			groups = append(groups, &knapsackGroup{size: []int64{0}, gas: []uint64{0}, value: []float64{0}})
+		}
+
+		group := groups[g]
+		laneOrder = append(laneOrder, g)
+
+		n := len(group.items)
+		group.items = append(group.items, knapsackItem{tx: tx, info: txInfo, value: value})
+		group.size = append(group.size, group.size[n]+txInfo.Size)
+		group.gas = append(group.gas, group.gas[n]+txInfo.GasLimit)
+		group.value = append(group.value, group.value[n]+value)
+	}
+
+	return groups, laneOrder, txsToRemove
+}
+
+// txSender returns the key of the sender of the transaction, whose transactions must be
+// included in nonce order. Unordered transactions do not depend on other transactions, so
+// each is its own sender.
+func txSender(txInfo utils.TxWithInfo) string {
+	if len(txInfo.Signers) == 0 || txInfo.Signers[0].Unordered {
+		return utils.UnorderedSenderPrefix + txInfo.Hash
+	}
+
+	return txInfo.Signers[0].Signer.String()
+}
+
+// newKnapsackSearch returns a new search for the optimal selection of the given groups.
+func newKnapsackSearch(
+	groups []*knapsackGroup,
+	laneOrder []int,
+	limit proposals.LaneLimits,
+	searchLimit int,
+) *knapsackSearch {
+	s := &knapsackSearch{
+		groups:      groups,
+		laneOrder:   laneOrder,
+		limit:       limit,
+		bound:       make([]float64, len(groups)+1),
+		current:     make([]int, len(groups)),
+		best:        make([]int, len(groups)),
+		searchLimit: searchLimit,
+	}
+
+	return s
+}
+
+// solve returns the number of transactions to select from each group. The search starts
+// from the greedy selection in the lane's order and explores the groups in order of their
+// total value, such that valuable selections are found (and others pruned) early.
+func (s *knapsackSearch) solve() []int {
+	s.bestValue = s.greedy()
+
+	order := make([]int, len(s.groups))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		a, b := s.groups[order[i]], s.groups[order[j]]
+		return a.value[len(a.items)] > b.value[len(b.items)]
+	})
+
+	for i := len(order) - 1; i >= 0; i-- {
+		group := s.groups[order[i]]
+		s.bound[i] = s.bound[i+1] + group.value[len(group.items)]
+	}
+
+	s.search(order, 0, 0, 0, 0, 0)
+
+	return s.best
+}
+
+// greedy selects the transactions that fit in the lane's order, like the DefaultProposalHandler,
+// and returns the value of the selection. A sender's transactions after one that does not fit
+// are not selected.
+func (s *knapsackSearch) greedy() float64 {
+	var (
+		size    int64
+		gas     uint64
+		count   int
+		value   float64
+		next    = make([]int, len(s.groups))
+		blocked = make([]bool, len(s.groups))
+	)
+
+	for _, g := range s.laneOrder {
+		item := s.groups[g].items[next[g]]
+		next[g]++
+
+		if blocked[g] || !s.fits(size+item.info.Size, gas+item.info.GasLimit, count+1) {
+			blocked[g] = true
+			continue
+		}
+
+		size += item.info.Size
+		gas += item.info.GasLimit
+		count++
+		value += item.value
+		s.best[g]++
+	}
+
+	return value
+}
+
+// search explores the selections of the groups from the given index onwards, given the
+// size, gas limit, number and value of the transactions selected from the previous groups.
+func (s *knapsackSearch) search(order []int, index int, size int64, gas uint64, count int, value float64) {
+	s.nodes++
+
+	if value > s.bestValue {
+		s.bestValue = value
+		copy(s.best, s.current)
+	}
+
+	if index == len(order) || s.nodes >= s.searchLimit || value+s.bound[index] <= s.bestValue {
+		return
+	}
+
+	g := order[index]
+	group := s.groups[g]
+	for k := len(group.items); k >= 0; k-- {
+		if !s.fits(size+group.size[k], gas+group.gas[k], count+k) {
+			continue
+		}
+
+		s.current[g] = k
+		s.search(order, index+1, size+group.size[k], gas+group.gas[k], count+k, value+group.value[k])
+		s.current[g] = 0
+
+		if s.nodes >= s.searchLimit {
+			return
+		}
+	}
+}
+
+// fits returns true if a selection with the given size, gas limit and number of transactions
+// respects the lane's limits.
+func (s *knapsackSearch) fits(size int64, gas uint64, count int) bool {
+	return size <= s.limit.MaxTxBytes &&
+		gas <= s.limit.MaxGasLimit &&
+		(s.limit.MaxTxs == 0 || uint64(count) <= s.limit.MaxTxs)
+}
+
+// count returns the number of transactions in the selection.
+func (s *knapsackSearch) count(selection []int) int {
+	var count int
+	for _, k := range selection {
+		count += k
+	}
+
+	return count
+}
+
+// ProcessLaneHandler returns a ProcessLaneHandler that verifies the following invariants:
+//  1. Transactions belonging to the lane must be contiguous from the beginning of the partial proposal.
+//  2. Transactions that do not belong to the lane must be contiguous from the end of the partial proposal.
+//  3. Each transaction must have a priority at least as high as the next transaction of every other sender.
+//  4. Transactions must be valid according to the verification logic of the lane.
+func (h *KnapsackProposalHandler) ProcessLaneHandler() ProcessLaneHandler {
+	return func(ctx sdk.Context, partialProposal []sdk.Tx) ([]sdk.Tx, []sdk.Tx, error) {
+		if len(partialProposal) == 0 {
+			return nil, nil, nil
+		}
+
+		end := len(partialProposal)
+		for index, tx := range partialProposal {
+			if h.lane.Match(ctx, tx) {
+				continue
+			}
+
+			// If the transaction does not belong to this lane, we return the remaining transactions
+			// iff there are no matches in the remaining transactions after this index.
+			if index+1 < len(partialProposal) {
+				if err := h.lane.VerifyNoMatches(ctx, partialProposal[index+1:]); err != nil {
+					return nil, nil, proposals.OffsetInvariantError(
+						h.lane.Name(),
+						index+1,
+						fmt.Errorf("failed to verify no matches: %w", err),
+					)
+				}
+			}
+
+			end = index
+			break
+		}
+
+		if err := h.verifyOrdering(ctx, partialProposal[:end]); err != nil {
+			return nil, nil, err
+		}
+
+		for index, tx := range partialProposal[:end] {
+			if err := h.lane.VerifyTx(ctx, tx, false); err != nil {
+				return nil, nil, proposals.NewInvariantError(
+					h.lane.Name(),
+					index,
+					proposals.InvariantVerifyTx,
+					fmt.Errorf("failed to verify tx: %w", err),
+				)
+			}
+		}
+
+		return partialProposal[:end], partialProposal[end:], nil
+	}
+}
+
+// ProcessLaneBasicHandler returns a ProcessLaneBasicHandler that verifies the same invariants
+// as the ProcessLaneHandler except for the verification logic of the lane (VerifyTx):
+//  1. All transactions in the partial proposal must belong to the lane.
+//  2. None of the remaining transactions may belong to the lane.
+//  3. Each transaction must have a priority at least as high as the next transaction of every other sender.
+func (h *KnapsackProposalHandler) ProcessLaneBasicHandler() ProcessLaneBasicHandler {
+	return func(ctx sdk.Context, partialProposal []sdk.Tx, remainingTxs []sdk.Tx) error {
+		for index, tx := range partialProposal {
+			if !h.lane.Match(ctx, tx) {
+				return proposals.NewInvariantError(
+					h.lane.Name(),
+					index,
+					proposals.InvariantMatch,
+					fmt.Errorf("transaction at index %d does not belong to lane %s", index, h.lane.Name()),
+				)
+			}
+		}
+
+		if err := h.verifyOrdering(ctx, partialProposal); err != nil {
+			return err
+		}
+
+		if err := h.lane.VerifyNoMatches(ctx, remainingTxs); err != nil {
+			return proposals.OffsetInvariantError(
+				h.lane.Name(),
+				len(partialProposal),
+				fmt.Errorf("failed to verify no matches: %w", err),
+			)
+		}
+
+		return nil
+	}
+}
+
+// verifyOrdering verifies that each transaction has a priority at least as high as the next
+// transaction of every other sender, i.e. that the transactions are in the order in which the
+// PrepareLaneHandler includes them.
+func (h *KnapsackProposalHandler) verifyOrdering(ctx sdk.Context, txs []sdk.Tx) error {
+	var (
+		senders  []*senderQueue
+		bySender = make(map[string]*senderQueue)
+		queueOf  = make([]*senderQueue, len(txs))
+	)
+
+	for index, tx := range txs {
+		signers, err := h.lane.cfg.SignerExtractor.GetSigners(tx)
+		if err != nil || len(signers) == 0 {
+			return proposals.NewInvariantError(
+				h.lane.Name(),
+				index,
+				proposals.InvariantOrdering,
+				fmt.Errorf("failed to get signers of transaction at index %d: %w", index, err),
+			)
+		}
+
+		sender := signers[0].Signer.String()
+		if signers[0].Unordered {
+			sender = fmt.Sprintf("%s%d", utils.UnorderedSenderPrefix, index)
+		}
+
+		queue, ok := bySender[sender]
+		if !ok {
+			queue = &senderQueue{}
+			bySender[sender] = queue
+			senders = append(senders, queue)
+		}
+
+		queue.txs = append(queue.txs, tx)
+		queueOf[index] = queue
+	}
+
+	heads := newSenderHeads(ctx, h.lane, senders)
+	for index, tx := range txs {
+		queue := queueOf[index]
+		if top := heads.queues[0]; top != queue {
+			if v, err := h.lane.Compare(ctx, tx, top.head()); v == -1 || err != nil {
+				return proposals.NewInvariantError(
+					h.lane.Name(),
+					index,
+					proposals.InvariantOrdering,
+					fmt.Errorf("transaction at index %d has a lower priority than the next transaction of another sender", index),
+				)
+			}
+		}
+
+		heads.advance(queue)
+	}
+
+	return nil
+}
+
+type (
+	// senderQueue are the transactions of a sender in nonce order, of which the ones from
+	// next onwards are yet to be ordered.
+	senderQueue struct {
+		txs   []sdk.Tx
+		next  int
+		index int
+	}
+
+	// senderHeads is a heap of the next transactions of each sender, ordered by priority.
+	senderHeads struct {
+		ctx    sdk.Context
+		lane   *BaseLane
+		queues []*senderQueue
+	}
+)
+
+var _ heap.Interface = (*senderHeads)(nil)
+
+func (q *senderQueue) head() sdk.Tx {
+	return q.txs[q.next]
+}
+
+// newSenderHeads returns a heap of the next transactions of the given senders.
+func newSenderHeads(ctx sdk.Context, lane *BaseLane, queues []*senderQueue) *senderHeads {
+	h := &senderHeads{ctx: ctx, lane: lane, queues: queues}
+	for i, queue := range queues {
+		queue.index = i
+	}
+
+	heap.Init(h)
+
+	return h
+}
+
+// advance moves the given sender on to its next transaction.
+func (h *senderHeads) advance(queue *senderQueue) {
+	queue.next++
+	if queue.next < len(queue.txs) {
+		heap.Fix(h, queue.index)
+		return
+	}
+
+	heap.Remove(h, queue.index)
+}
+
+func (h *senderHeads) Len() int { return len(h.queues) }
+
+func (h *senderHeads) Less(i, j int) bool {
+	v, err := h.lane.Compare(h.ctx, h.queues[i].head(), h.queues[j].head())
+	return err == nil && v == 1
+}
+
+func (h *senderHeads) Swap(i, j int) {
+	h.queues[i], h.queues[j] = h.queues[j], h.queues[i]
+	h.queues[i].index = i
+	h.queues[j].index = j
+}
+
+func (h *senderHeads) Push(x any) {
+	queue := x.(*senderQueue)
+	queue.index = len(h.queues)
+	h.queues = append(h.queues, queue)
+}
+
+func (h *senderHeads) Pop() any {
+	n := len(h.queues)
+	queue := h.queues[n-1]
+	h.queues = h.queues[:n-1]
+
+	return queue
+}
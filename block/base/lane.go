@@ -1,16 +1,36 @@
 package base
 
 import (
+	"context"
 	"fmt"
+	"sort"
+	"sync"
 
 	"cosmossdk.io/log"
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
 
 	"github.com/skip-mev/block-sdk/v2/block"
+	"github.com/skip-mev/block-sdk/v2/block/metrics"
+	"github.com/skip-mev/block-sdk/v2/block/proposals"
+	"github.com/skip-mev/block-sdk/v2/block/utils"
 )
 
-var _ block.Lane = (*BaseLane)(nil)
+var (
+	_ block.Lane                = (*BaseLane)(nil)
+	_ block.TxReplacer          = (*BaseLane)(nil)
+	_ block.QueuedPool          = (*BaseLane)(nil)
+	_ block.TxHashIndex         = (*BaseLane)(nil)
+	_ block.TxSenderIndex       = (*BaseLane)(nil)
+	_ block.TxPriorityIndex     = (*BaseLane)(nil)
+	_ block.TxEvictor           = (*BaseLane)(nil)
+	_ block.TxTracker           = (*BaseLane)(nil)
+	_ block.BlockSpaceResolver  = (*BaseLane)(nil)
+	_ block.ParallelProcessLane = (*BaseLane)(nil)
+)
 
 // BaseLane is a generic implementation of a lane. It is meant to be used
 // as a base for other lanes to be built on top of. It provides a default
@@ -42,6 +62,20 @@ type BaseLane struct { //nolint
 	// verified and the lane needs to verify that the transactions included in the proposal
 	// are valid respecting the verification logic of the lane.
 	processLaneHandler ProcessLaneHandler
+
+	// processLaneBasicHandler is the function that is called to verify the stateless invariants
+	// of the transactions included in a proposal that belong to this lane. If unset, the lane is
+	// always verified with processLaneHandler.
+	processLaneBasicHandler ProcessLaneBasicHandler
+
+	// txInfoCache is an optional cache of transaction information that is consulted
+	// when the information of a transaction is not cached by the lane's mempool.
+	txInfoCache TxInfoCache
+
+	// reverts counts, by hash, the proposals that each transaction that reverts (and is
+	// kept in the mempool) was excluded from (see RemoveRevertingTxs).
+	revertsMtx sync.Mutex
+	reverts    map[string]int
 }
 
 // NewBaseLane returns a new lane base. When creating this lane, the type
@@ -57,10 +91,24 @@ func NewBaseLane(
 		laneName: laneName,
 	}
 
+	if lane.cfg.Metrics == nil {
+		lane.cfg.Metrics = metrics.NewTelemetryMetrics()
+	}
+
+	if lane.cfg.Tracer == nil {
+		lane.cfg.Tracer = noop.NewTracerProvider().Tracer("")
+	}
+
 	lane.LaneMempool = NewMempool(
 		DefaultTxPriority(),
 		lane.cfg.SignerExtractor,
 		lane.cfg.MaxTxs,
+		WithTxReplacementPolicy(lane.cfg.TxReplacement),
+		WithQueuedPool(lane.cfg.AccountKeeper),
+		WithMaxQueuedTxs(lane.cfg.MaxQueuedTxs),
+		WithLogger(lane.cfg.Logger),
+		WithTxEncoder(lane.cfg.TxEncoder),
+		WithEvictLowerPriority(lane.cfg.EvictLowerPriority),
 	)
 
 	lane.matchHandler = DefaultMatchHandler()
@@ -68,6 +116,7 @@ func NewBaseLane(
 	handler := NewDefaultProposalHandler(lane)
 	lane.prepareLaneHandler = handler.PrepareLaneHandler()
 	lane.processLaneHandler = handler.ProcessLaneHandler()
+	lane.processLaneBasicHandler = handler.ProcessLaneBasicHandler()
 
 	for _, option := range options {
 		option(lane)
@@ -128,6 +177,170 @@ func (l *BaseLane) Logger() log.Logger {
 	return l.cfg.Logger
 }
 
+// Metrics returns the metrics the lane reports to.
+func (l *BaseLane) Metrics() metrics.Metrics {
+	return l.cfg.Metrics
+}
+
+// Tracer returns the tracer used to trace the preparation and verification of the lane's
+// partial proposals.
+func (l *BaseLane) Tracer() trace.Tracer {
+	return l.cfg.Tracer
+}
+
+// Lookup returns the transaction in the lane's mempool with the same signer and sequence
+// number as the given transaction, if the lane's mempool implements block.TxReplacer.
+func (l *BaseLane) Lookup(tx sdk.Tx) (sdk.Tx, bool) {
+	if replacer, ok := l.LaneMempool.(block.TxReplacer); ok {
+		return replacer.Lookup(tx)
+	}
+
+	return nil, false
+}
+
+// AllowsReplacement returns true if the new transaction can replace the old transaction in
+// the lane's mempool, if the lane's mempool implements block.TxReplacer.
+func (l *BaseLane) AllowsReplacement(oldTx, newTx sdk.Tx) bool {
+	if replacer, ok := l.LaneMempool.(block.TxReplacer); ok {
+		return replacer.AllowsReplacement(oldTx, newTx)
+	}
+
+	return false
+}
+
+// TxHash returns the hash of the transaction, i.e. the hex-encoded hash of its bytes as
+// encoded by the lane's tx encoder.
+func (l *BaseLane) TxHash(tx sdk.Tx) (string, error) {
+	return utils.GetTxHash(l.cfg.TxEncoder, tx)
+}
+
+// LookupHash returns the transaction in the lane's mempool with the given hash, if any. If
+// the lane's mempool does not implement block.TxHashIndex, the lane's transactions are
+// searched one by one.
+func (l *BaseLane) LookupHash(hash string) (sdk.Tx, bool) {
+	if index, ok := l.LaneMempool.(block.TxHashIndex); ok {
+		return index.LookupHash(hash)
+	}
+
+	for iterator := l.Select(context.Background(), nil); iterator != nil; iterator = iterator.Next() {
+		if txHash, err := l.TxHash(iterator.Tx()); err == nil && txHash == hash {
+			return iterator.Tx(), true
+		}
+	}
+
+	return nil, false
+}
+
+// InsertWithEviction inserts the transaction into the lane's mempool and returns the
+// transactions that were evicted to make room for it, if the lane's mempool implements
+// block.TxEvictor (see LaneConfig.EvictLowerPriority).
+func (l *BaseLane) InsertWithEviction(ctx context.Context, tx sdk.Tx) ([]sdk.Tx, error) {
+	if evictor, ok := l.LaneMempool.(block.TxEvictor); ok {
+		return evictor.InsertWithEviction(ctx, tx)
+	}
+
+	return nil, l.Insert(ctx, tx)
+}
+
+// SetTxHooks sets the hooks that are called with the transactions inserted into and removed
+// from the lane's mempool, if the lane's mempool implements block.TxTracker.
+func (l *BaseLane) SetTxHooks(hooks block.TxHooks) bool {
+	if tracker, ok := l.LaneMempool.(block.TxTracker); ok {
+		return tracker.SetTxHooks(hooks)
+	}
+
+	return false
+}
+
+// SenderTxs returns the transactions of the signer in the lane's mempool, ordered by sequence
+// number. If the lane's mempool does not implement block.TxSenderIndex, the lane's
+// transactions are searched one by one.
+func (l *BaseLane) SenderTxs(signer sdk.AccAddress) []sdk.Tx {
+	if index, ok := l.LaneMempool.(block.TxSenderIndex); ok {
+		return index.SenderTxs(signer)
+	}
+
+	type senderTx struct {
+		tx       sdk.Tx
+		sequence uint64
+	}
+
+	var senderTxs []senderTx
+	for iterator := l.Select(context.Background(), nil); iterator != nil; iterator = iterator.Next() {
+		signers, err := l.cfg.SignerExtractor.GetSigners(iterator.Tx())
+		if err != nil || len(signers) == 0 || signers[0].Unordered || !signers[0].Signer.Equals(signer) {
+			continue
+		}
+
+		senderTxs = append(senderTxs, senderTx{tx: iterator.Tx(), sequence: signers[0].Sequence})
+	}
+
+	sort.Slice(senderTxs, func(i, j int) bool {
+		return senderTxs[i].sequence < senderTxs[j].sequence
+	})
+
+	txs := make([]sdk.Tx, len(senderTxs))
+	for i, senderTx := range senderTxs {
+		txs[i] = senderTx.tx
+	}
+
+	return txs
+}
+
+// LowestPriorityTxs returns up to limit transactions of the lane's mempool from the lowest to
+// the highest priority, after skipping the offset lowest priority transactions. If the
+// lane's mempool does not implement block.TxPriorityIndex, the lane's transactions are
+// walked in reverse selection order.
+func (l *BaseLane) LowestPriorityTxs(offset, limit int) []sdk.Tx {
+	if index, ok := l.LaneMempool.(block.TxPriorityIndex); ok {
+		return index.LowestPriorityTxs(offset, limit)
+	}
+
+	var txs []sdk.Tx
+	for iterator := l.Select(context.Background(), nil); iterator != nil; iterator = iterator.Next() {
+		txs = append(txs, iterator.Tx())
+	}
+
+	if offset >= len(txs) {
+		return nil
+	}
+
+	var lowest []sdk.Tx
+	for i := len(txs) - 1 - offset; i >= 0 && len(lowest) < limit; i-- {
+		lowest = append(lowest, txs[i])
+	}
+
+	return lowest
+}
+
+// PromoteQueuedTxs moves the queued transactions whose sequence gap has closed to the
+// pending pool, if the lane's mempool implements block.QueuedPool.
+func (l *BaseLane) PromoteQueuedTxs(ctx sdk.Context) {
+	if pool, ok := l.LaneMempool.(block.QueuedPool); ok {
+		pool.PromoteQueuedTxs(ctx)
+	}
+}
+
+// SelectQueued returns an iterator over the queued transactions in the lane's mempool. It
+// returns nil if the lane's mempool does not implement block.QueuedPool.
+func (l *BaseLane) SelectQueued(ctx context.Context) sdkmempool.Iterator {
+	if pool, ok := l.LaneMempool.(block.QueuedPool); ok {
+		return pool.SelectQueued(ctx)
+	}
+
+	return nil
+}
+
+// CountPoolTxs returns the number of pending and queued transactions in the lane's mempool.
+// It returns false if the lane's mempool does not have a queued pool.
+func (l *BaseLane) CountPoolTxs() (pending, queued int, ok bool) {
+	if pool, ok := l.LaneMempool.(block.QueuedPool); ok {
+		return pool.CountPoolTxs()
+	}
+
+	return l.CountTx(), 0, false
+}
+
 // TxDecoder returns the tx decoder for the lane.
 func (l *BaseLane) TxDecoder() sdk.TxDecoder {
 	return l.cfg.TxDecoder
@@ -150,6 +363,34 @@ func (l *BaseLane) SetMaxBlockSpace(maxBlockSpace math.LegacyDec) {
 	l.cfg.MaxBlockSpace = maxBlockSpace
 }
 
+// GetBlockSpace returns the byte, gas and transaction count budgets of the lane along
+// with its reserved block space and redistribution weight. The byte and gas ratios
+// default to the max block space of the lane if they are not set explicitly in the
+// lane's configuration.
+func (l *BaseLane) GetBlockSpace() proposals.BlockSpace {
+	return l.GetBlockSpaceFor(l.cfg.MaxBlockSpace)
+}
+
+// GetBlockSpaceFor returns the block space of the lane (see GetBlockSpace) as if it were
+// configured with the given max block space. It implements block.BlockSpaceResolver.
+func (l *BaseLane) GetBlockSpaceFor(maxBlockSpace math.LegacyDec) proposals.BlockSpace {
+	space := proposals.NewBlockSpace(maxBlockSpace)
+	if !l.cfg.MaxBlockSpaceBytes.IsNil() {
+		space.MaxTxBytes = l.cfg.MaxBlockSpaceBytes
+	}
+
+	if !l.cfg.MaxBlockSpaceGas.IsNil() {
+		space.MaxGasLimit = l.cfg.MaxBlockSpaceGas
+	}
+
+	space.MaxTxs = l.cfg.MaxTxsPerBlock
+	space.MinTxBytes = l.cfg.MinBlockSpace
+	space.MinGasLimit = l.cfg.MinBlockSpace
+	space.Weight = l.cfg.RedistributionWeight
+
+	return space
+}
+
 // WithOptions returns a new lane with the given options.
 func (l *BaseLane) WithOptions(options ...LaneOption) *BaseLane {
 	for _, option := range options {
@@ -4,6 +4,8 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/block-sdk/v2/block/proposals"
 )
 
 // DefaultMatchHandler returns a default implementation of the MatchHandler. It matches all
@@ -16,9 +18,14 @@ func DefaultMatchHandler() MatchHandler {
 
 // VerifyNoMatches returns an error if any of the transactions match the lane.
 func (l *BaseLane) VerifyNoMatches(ctx sdk.Context, txs []sdk.Tx) error {
-	for _, tx := range txs {
+	for index, tx := range txs {
 		if l.Match(ctx, tx) {
-			return fmt.Errorf("transaction belongs to lane when it should not")
+			return proposals.NewInvariantError(
+				l.Name(),
+				index,
+				proposals.InvariantMatch,
+				fmt.Errorf("transaction belongs to lane when it should not"),
+			)
 		}
 	}
 
@@ -4,11 +4,15 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync"
 
+	"cosmossdk.io/log"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
 
 	signer_extraction "github.com/skip-mev/block-sdk/v2/adapters/signer_extraction_adapter"
+	"github.com/skip-mev/block-sdk/v2/block"
+	"github.com/skip-mev/block-sdk/v2/block/utils"
 )
 
 type (
@@ -17,7 +21,12 @@ type (
 	// It include's additional helper functions that allow users to determine if a
 	// transaction is already in the mempool and to compare the priority of two
 	// transactions.
+	//
+	// The mempool is safe for concurrent use. Operations that span both the pending
+	// and the queued pool (e.g. promoting queued transactions) are atomic.
 	Mempool[C comparable] struct {
+		mtx sync.RWMutex
+
 		// index defines an index of transactions.
 		index MempoolInterface
 
@@ -30,22 +39,171 @@ type (
 		// of two transactions. The index utilizes this struct to order transactions
 		// in the mempool.
 		txPriority TxPriority[C]
+
+		// txReplacement defines the policy a transaction must satisfy to replace the
+		// transaction with the same signer and sequence number. If nil, transactions
+		// are overwritten by transactions with the same signer and sequence number.
+		txReplacement TxReplacementPolicy
+
+		// queued defines the pool of transactions whose sequence number is above the next
+		// sequence number of their signer, i.e. transactions that cannot be executed until
+		// the gap is closed. The index only holds the pending (executable) transactions. If
+		// nil, the mempool does not have a queued pool.
+		queued MempoolInterface
+
+		// accountKeeper is used to retrieve the sequence number of the signers when the
+		// mempool has a queued pool.
+		accountKeeper AccountKeeper
+
+		// txEncoder is used to compute the hash of the transactions. If nil, transactions
+		// cannot be looked up by hash.
+		txEncoder sdk.TxEncoder
+
+		// maxTx is the maximum number of pending transactions. If zero, there is no limit.
+		maxTx int
+
+		// logger is used to log the errors that do not fail an insertion, e.g. when queued
+		// transactions cannot be promoted.
+		logger log.Logger
+	}
+
+	// AccountKeeper defines the interface used to retrieve the current sequence number of
+	// an account, e.g. x/auth's keeper.
+	AccountKeeper interface {
+		GetSequence(ctx context.Context, addr sdk.AccAddress) (uint64, error)
+	}
+
+	// MempoolOption defines a function that can be used to configure the Mempool.
+	MempoolOption func(*mempoolOptions)
+
+	// mempoolOptions defines the optional configuration of the Mempool.
+	mempoolOptions struct {
+		txReplacement      TxReplacementPolicy
+		accountKeeper      AccountKeeper
+		maxQueuedTxs       int
+		txEncoder          sdk.TxEncoder
+		evictLowerPriority bool
+		logger             log.Logger
 	}
 )
 
+var (
+	_ block.QueuedPool      = (*Mempool[int])(nil)
+	_ block.TxHashIndex     = (*Mempool[int])(nil)
+	_ block.TxSenderIndex   = (*Mempool[int])(nil)
+	_ block.TxPriorityIndex = (*Mempool[int])(nil)
+	_ block.TxEvictor       = (*Mempool[int])(nil)
+	_ block.TxTracker       = (*Mempool[int])(nil)
+	_ TxInfoCache           = (*Mempool[int])(nil)
+)
+
+// WithTxReplacementPolicy sets the policy a transaction must satisfy to replace the
+// transaction in the mempool with the same signer and sequence number (see
+// LaneConfig.TxReplacement).
+func WithTxReplacementPolicy(policy TxReplacementPolicy) MempoolOption {
+	return func(opts *mempoolOptions) {
+		opts.txReplacement = policy
+	}
+}
+
+// WithQueuedPool holds the transactions whose sequence number is above the next sequence
+// number of their signer in a separate queued pool until the gap closes (see
+// LaneConfig.AccountKeeper). If the account keeper is nil, the mempool does not have a
+// queued pool.
+func WithQueuedPool(accountKeeper AccountKeeper) MempoolOption {
+	return func(opts *mempoolOptions) {
+		opts.accountKeeper = accountKeeper
+	}
+}
+
+// WithMaxQueuedTxs sets the maximum number of transactions in the queued pool (see
+// LaneConfig.MaxQueuedTxs). The max number of transactions of the mempool only bounds the
+// pending pool.
+func WithMaxQueuedTxs(maxTxs int) MempoolOption {
+	return func(opts *mempoolOptions) {
+		opts.maxQueuedTxs = maxTxs
+	}
+}
+
+// WithLogger sets the logger of the mempool. By default, nothing is logged.
+func WithLogger(logger log.Logger) MempoolOption {
+	return func(opts *mempoolOptions) {
+		opts.logger = logger
+	}
+}
+
+// WithTxEncoder sets the encoder used to compute the hash of the transactions, which indexes
+// them by hash (see LookupHash) and identifies unordered transactions in the mempool (see
+// signer_extraction.TxWithUnordered). If the encoder is nil, transactions cannot be looked
+// up by hash and unordered transactions are rejected.
+func WithTxEncoder(txEncoder sdk.TxEncoder) MempoolOption {
+	return func(opts *mempoolOptions) {
+		opts.txEncoder = txEncoder
+	}
+}
+
+// WithEvictLowerPriority sets whether a new transaction evicts the lowest priority
+// transactions once the mempool is full, instead of being rejected (see
+// LaneConfig.EvictLowerPriority).
+func WithEvictLowerPriority(evict bool) MempoolOption {
+	return func(opts *mempoolOptions) {
+		opts.evictLowerPriority = evict
+	}
+}
+
 // NewMempool returns a new Mempool.
-func NewMempool[C comparable](txPriority TxPriority[C], extractor signer_extraction.Adapter, maxTx int) *Mempool[C] {
-	return &Mempool[C]{
-		index: NewPriorityMempool(
-			PriorityNonceMempoolConfig[C]{
-				TxPriority: txPriority,
-				MaxTx:      maxTx,
-			},
-			extractor,
-		),
-		extractor:  extractor,
-		txPriority: txPriority,
+func NewMempool[C comparable](
+	txPriority TxPriority[C],
+	extractor signer_extraction.Adapter,
+	maxTx int,
+	opts ...MempoolOption,
+) *Mempool[C] {
+	var options mempoolOptions
+	for _, opt := range opts {
+		opt(&options)
 	}
+
+	cfg := PriorityNonceMempoolConfig[C]{
+		TxPriority:         txPriority,
+		MaxTx:              maxTx,
+		EvictLowerPriority: options.evictLowerPriority,
+		TxEncoder:          options.txEncoder,
+	}
+
+	if options.txReplacement != nil {
+		cfg.TxReplacement = func(_, _ C, oldTx, newTx sdk.Tx) bool {
+			return options.txReplacement(oldTx, newTx)
+		}
+	}
+
+	mempool := &Mempool[C]{
+		index:         NewPriorityMempool(cfg, extractor),
+		extractor:     extractor,
+		txPriority:    txPriority,
+		txReplacement: options.txReplacement,
+		txEncoder:     options.txEncoder,
+		maxTx:         maxTx,
+		logger:        options.logger,
+	}
+
+	if mempool.logger == nil {
+		mempool.logger = log.NewNopLogger()
+	}
+
+	if options.accountKeeper != nil {
+		// The queued pool has its own bound, such that queued transactions cannot crowd
+		// out executable ones.
+		queuedCfg := cfg
+		queuedCfg.MaxTx = options.maxQueuedTxs
+		if queuedCfg.MaxTx == 0 && maxTx > 0 {
+			queuedCfg.MaxTx = max(maxTx/4, 1)
+		}
+
+		mempool.queued = NewPriorityMempool(queuedCfg, extractor)
+		mempool.accountKeeper = options.accountKeeper
+	}
+
+	return mempool
 }
 
 // Priority returns the priority of the transaction.
@@ -53,47 +211,410 @@ func (cm *Mempool[C]) Priority(ctx sdk.Context, tx sdk.Tx) any {
 	return cm.txPriority.GetTxPriority(ctx, tx)
 }
 
-// Insert inserts a transaction into the mempool.
+// Insert inserts a transaction into the mempool (see InsertWithEviction).
 func (cm *Mempool[C]) Insert(ctx context.Context, tx sdk.Tx) error {
-	if err := cm.index.Insert(ctx, tx); err != nil {
-		return fmt.Errorf("failed to insert tx into mempool: %w", err)
+	_, err := cm.InsertWithEviction(ctx, tx)
+	return err
+}
+
+// InsertWithEviction inserts a transaction into the mempool and returns the transactions that
+// were evicted to make room for it (see WithEvictLowerPriority). If the mempool has a queued
+// pool, a transaction whose sequence number is above the next sequence number of its signer is
+// inserted into the queued pool. Otherwise, it is inserted into the pending pool and the
+// queued transactions of the signer that no longer have a gap are promoted. Unordered
+// transactions do not have a sequence number and are never queued.
+func (cm *Mempool[C]) InsertWithEviction(ctx context.Context, tx sdk.Tx) ([]sdk.Tx, error) {
+	cm.mtx.Lock()
+	defer cm.mtx.Unlock()
+
+	if cm.queued == nil {
+		evicted, err := cm.index.InsertWithEviction(ctx, tx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to insert tx into mempool: %w", err)
+		}
+
+		return evicted, nil
 	}
 
-	return nil
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	signer, err := cm.firstSigner(tx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to insert tx into mempool: %w", err)
+	}
+
+	if signer.Unordered {
+		evicted, err := cm.index.InsertWithEviction(ctx, tx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to insert tx into mempool: %w", err)
+		}
+
+		return append(evicted, cm.evictQueued(evicted)...), nil
+	}
+
+	// A transaction that replaces a transaction with the same signer and sequence number
+	// replaces it in its pool.
+	_, queued := cm.queued.Lookup(tx)
+	if _, pending := cm.index.Lookup(tx); !queued && !pending {
+		next, err := cm.nextSequence(sdkCtx, signer.Signer)
+		if err != nil {
+			return nil, fmt.Errorf("failed to insert tx into mempool: %w", err)
+		}
+
+		queued = signer.Sequence > next
+	}
+
+	if queued {
+		evicted, err := cm.queued.InsertWithEviction(ctx, tx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to insert tx into queued pool: %w", err)
+		}
+
+		return evicted, nil
+	}
+
+	evicted, err := cm.index.InsertWithEviction(ctx, tx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to insert tx into mempool: %w", err)
+	}
+
+	// The transaction is inserted even if its signer's queued transactions cannot be
+	// promoted, they are promoted the next time the lane prepares a proposal.
+	if err := cm.promote(sdkCtx, signer.Signer); err != nil {
+		cm.logger.Error(
+			"failed to promote queued txs",
+			"signer", signer.Signer.String(),
+			"err", err,
+		)
+	}
+
+	return append(evicted, cm.evictQueued(evicted)...), nil
+}
+
+// evictQueued evicts the queued transactions that follow the given transactions, which were
+// evicted from the pending pool, in the sequence of their signer. They could no longer be
+// promoted. The caller must hold the lock.
+func (cm *Mempool[C]) evictQueued(evicted []sdk.Tx) []sdk.Tx {
+	var queued []sdk.Tx
+	for _, tx := range evicted {
+		signer, err := cm.firstSigner(tx)
+		if err != nil || signer.Unordered {
+			continue
+		}
+
+		for _, queuedTx := range cm.queued.SenderTxs(signer.Signer.String()) {
+			queuedSigner, err := cm.firstSigner(queuedTx)
+			if err != nil || queuedSigner.Sequence <= signer.Sequence {
+				continue
+			}
+
+			if err := cm.queued.Remove(queuedTx); err == nil {
+				queued = append(queued, queuedTx)
+			}
+		}
+	}
+
+	return queued
 }
 
 // Remove removes a transaction from the mempool.
 func (cm *Mempool[C]) Remove(tx sdk.Tx) error {
+	cm.mtx.Lock()
+	defer cm.mtx.Unlock()
+
 	if err := cm.index.Remove(tx); err != nil && !errors.Is(err, sdkmempool.ErrTxNotFound) {
 		return fmt.Errorf("failed to remove transaction from the mempool: %w", err)
 	}
 
+	if cm.queued == nil {
+		return nil
+	}
+
+	if err := cm.queued.Remove(tx); err != nil && !errors.Is(err, sdkmempool.ErrTxNotFound) {
+		return fmt.Errorf("failed to remove transaction from the queued pool: %w", err)
+	}
+
 	return nil
 }
 
-// Select returns an iterator of all transactions in the mempool. NOTE: If you
-// remove a transaction from the mempool while iterating over the transactions,
-// the iterator will not be aware of the removal and will continue to iterate
-// over the removed transaction. Be sure to reset the iterator if you remove a transaction.
+// Select returns an iterator of all transactions in the mempool. The iterator walks
+// the mempool lazily (see PriorityNonceMempool.Select), so transactions that are
+// removed while iterating are no longer returned once they are removed. If the
+// mempool has a queued pool, only the pending transactions are returned.
 func (cm *Mempool[C]) Select(ctx context.Context, txs [][]byte) sdkmempool.Iterator {
+	cm.mtx.RLock()
+	defer cm.mtx.RUnlock()
+
 	return cm.index.Select(ctx, txs)
 }
 
-// CountTx returns the number of transactions in the mempool.
+// CountTx returns the number of transactions in the mempool, including the queued
+// transactions.
 func (cm *Mempool[C]) CountTx() int {
-	return cm.index.CountTx()
+	cm.mtx.RLock()
+	defer cm.mtx.RUnlock()
+
+	if cm.queued == nil {
+		return cm.index.CountTx()
+	}
+
+	return cm.index.CountTx() + cm.queued.CountTx()
 }
 
-// Contains returns true if the transaction is contained in the mempool.
+// CountPoolTxs returns the number of pending and queued transactions in the mempool. It
+// returns false if the mempool does not have a queued pool.
+func (cm *Mempool[C]) CountPoolTxs() (pending, queued int, ok bool) {
+	cm.mtx.RLock()
+	defer cm.mtx.RUnlock()
+
+	if cm.queued == nil {
+		return cm.index.CountTx(), 0, false
+	}
+
+	return cm.index.CountTx(), cm.queued.CountTx(), true
+}
+
+// SelectQueued returns an iterator over the transactions in the queued pool, which walks
+// the queued pool lazily like Select. It returns nil if the mempool does not have a queued
+// pool or if the queued pool is empty.
+func (cm *Mempool[C]) SelectQueued(ctx context.Context) sdkmempool.Iterator {
+	cm.mtx.RLock()
+	defer cm.mtx.RUnlock()
+
+	if cm.queued == nil {
+		return nil
+	}
+
+	return cm.queued.Select(ctx, nil)
+}
+
+// PromoteQueuedTxs moves the queued transactions whose sequence gap has closed (e.g. because
+// the preceding transactions were included in a block) to the pending pool.
+func (cm *Mempool[C]) PromoteQueuedTxs(ctx sdk.Context) {
+	if cm.queued == nil {
+		return
+	}
+
+	cm.mtx.Lock()
+	defer cm.mtx.Unlock()
+
+	if cm.queued.CountTx() == 0 {
+		return
+	}
+
+	var (
+		signers []sdk.AccAddress
+		seen    = make(map[string]bool)
+	)
+	for iterator := cm.queued.Select(ctx, nil); iterator != nil; iterator = iterator.Next() {
+		signer, err := cm.firstSigner(iterator.Tx())
+		if err != nil || seen[signer.Signer.String()] {
+			continue
+		}
+
+		seen[signer.Signer.String()] = true
+		signers = append(signers, signer.Signer)
+	}
+
+	for _, signer := range signers {
+		// The queued txs of a signer that cannot be promoted stay in the queued pool.
+		_ = cm.promote(ctx, signer)
+	}
+}
+
+// promote moves the queued transactions of the signer that follow the signer's next
+// sequence number without a gap to the pending pool.
+func (cm *Mempool[C]) promote(ctx sdk.Context, signer sdk.AccAddress) error {
+	next, err := cm.nextSequence(ctx, signer)
+	if err != nil {
+		return err
+	}
+
+	for _, tx := range cm.queued.SenderTxs(signer.String()) {
+		// Promoted transactions never evict pending transactions, they stay queued while
+		// the pending pool is full.
+		if cm.maxTx > 0 && cm.index.CountTx() >= cm.maxTx {
+			return nil
+		}
+
+		txSigner, err := cm.firstSigner(tx)
+		if err != nil {
+			return err
+		}
+
+		if txSigner.Sequence > next {
+			return nil
+		}
+
+		// The transaction is removed from the queued pool first, such that it is reported
+		// as removed before it is reported as inserted again (see SetTxHooks).
+		if err := cm.queued.Remove(tx); err != nil {
+			return fmt.Errorf("failed to remove promoted tx from queued pool: %w", err)
+		}
+
+		if err := cm.index.Insert(ctx, tx); err != nil {
+			if requeueErr := cm.queued.Insert(ctx, tx); requeueErr != nil {
+				cm.logger.Error("failed to requeue tx that could not be promoted", "err", requeueErr)
+			}
+
+			return fmt.Errorf("failed to promote queued tx: %w", err)
+		}
+
+		next = txSigner.Sequence + 1
+	}
+
+	return nil
+}
+
+// nextSequence returns the next sequence number of the signer, i.e. the sequence number
+// following the signer's pending transactions or, if there are none, the sequence number
+// of the signer's account.
+func (cm *Mempool[C]) nextSequence(ctx sdk.Context, signer sdk.AccAddress) (uint64, error) {
+	next, err := cm.accountKeeper.GetSequence(ctx, signer)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get sequence of %s: %w", signer, err)
+	}
+
+	if pending := cm.index.SenderTxs(signer.String()); len(pending) > 0 {
+		last, err := cm.firstSigner(pending[len(pending)-1])
+		if err != nil {
+			return 0, err
+		}
+
+		if last.Sequence+1 > next {
+			next = last.Sequence + 1
+		}
+	}
+
+	return next, nil
+}
+
+// firstSigner returns the first signer of the transaction, which identifies the
+// transaction along with its sequence number.
+func (cm *Mempool[C]) firstSigner(tx sdk.Tx) (signer_extraction.SignerData, error) {
+	signers, err := cm.extractor.GetSigners(tx)
+	if err != nil {
+		return signer_extraction.SignerData{}, err
+	}
+
+	if len(signers) == 0 {
+		return signer_extraction.SignerData{}, fmt.Errorf("tx must have at least one signer")
+	}
+
+	return signers[0], nil
+}
+
+// Contains returns true if the transaction is contained in the mempool. A different
+// transaction with the same signer and sequence number does not match (see Lookup).
 func (cm *Mempool[C]) Contains(tx sdk.Tx) bool {
-	return cm.index.Contains(tx)
+	cm.mtx.RLock()
+	defer cm.mtx.RUnlock()
+
+	return cm.index.Contains(tx) || (cm.queued != nil && cm.queued.Contains(tx))
+}
+
+// SetTxHooks sets the hooks that are called with the transactions inserted into and removed
+// from the mempool (see block.TxTracker). Transactions that move between the pending and the
+// queued pool are reported as removed and inserted again. Transactions can only be reported
+// if the mempool has a tx encoder (see WithTxEncoder).
+func (cm *Mempool[C]) SetTxHooks(hooks block.TxHooks) bool {
+	cm.mtx.Lock()
+	defer cm.mtx.Unlock()
+
+	if !cm.index.SetTxHooks(hooks) {
+		return false
+	}
+
+	return cm.queued == nil || cm.queued.SetTxHooks(hooks)
+}
+
+// Lookup returns the transaction in the mempool with the same signer and sequence number
+// (or, for unordered transactions, the same hash) as the given transaction, if any.
+func (cm *Mempool[C]) Lookup(tx sdk.Tx) (sdk.Tx, bool) {
+	cm.mtx.RLock()
+	defer cm.mtx.RUnlock()
+
+	if current, found := cm.index.Lookup(tx); found || cm.queued == nil {
+		return current, found
+	}
+
+	return cm.queued.Lookup(tx)
+}
+
+// SenderTxs returns the transactions of the signer in the mempool, ordered by sequence
+// number. The signer's queued transactions follow its pending transactions, since they
+// have a higher sequence number.
+func (cm *Mempool[C]) SenderTxs(signer sdk.AccAddress) []sdk.Tx {
+	cm.mtx.RLock()
+	defer cm.mtx.RUnlock()
+
+	txs := cm.index.SenderTxs(signer.String())
+	if cm.queued == nil {
+		return txs
+	}
+
+	return append(txs, cm.queued.SenderTxs(signer.String())...)
+}
+
+// LowestPriorityTxs returns up to limit pending transactions from the lowest to the highest
+// priority, after skipping the offset lowest priority transactions. Queued transactions are
+// not included, like in Select.
+func (cm *Mempool[C]) LowestPriorityTxs(offset, limit int) []sdk.Tx {
+	cm.mtx.RLock()
+	defer cm.mtx.RUnlock()
+
+	return cm.index.LowestPriorityTxs(offset, limit)
+}
+
+// TxHash returns the hash of the transaction, i.e. the hex-encoded hash of its bytes as used
+// by CometBFT.
+func (cm *Mempool[C]) TxHash(tx sdk.Tx) (string, error) {
+	if cm.txEncoder == nil {
+		return "", fmt.Errorf("mempool does not have a tx encoder")
+	}
+
+	return utils.GetTxHash(cm.txEncoder, tx)
+}
+
+// LookupHash returns the transaction in the mempool with the given hash, if any, including
+// the queued transactions. Transactions can only be looked up by hash if the mempool has a
+// tx encoder (see WithTxEncoder).
+func (cm *Mempool[C]) LookupHash(hash string) (sdk.Tx, bool) {
+	cm.mtx.RLock()
+	defer cm.mtx.RUnlock()
+
+	if tx, found := cm.index.LookupHash(hash); found || cm.queued == nil {
+		return tx, found
+	}
+
+	return cm.queued.LookupHash(hash)
+}
+
+// TxInfo returns the information of the transaction computed when it was inserted, if the
+// transaction is in the mempool (including the queued pool). The information is only stored
+// if the mempool has a tx encoder (see WithTxEncoder).
+func (cm *Mempool[C]) TxInfo(tx sdk.Tx) (utils.TxWithInfo, bool) {
+	cm.mtx.RLock()
+	defer cm.mtx.RUnlock()
+
+	if txInfo, found := cm.index.TxInfo(tx); found || cm.queued == nil {
+		return txInfo, found
+	}
+
+	return cm.queued.TxInfo(tx)
+}
+
+// AllowsReplacement returns true if the new transaction can replace the old transaction,
+// i.e. the transaction in the mempool with the same signer and sequence number. Replacement
+// is only allowed if the mempool has a replacement policy.
+func (cm *Mempool[C]) AllowsReplacement(oldTx, newTx sdk.Tx) bool {
+	return cm.txReplacement != nil && cm.txReplacement(oldTx, newTx)
 }
 
 // Compare determines the relative priority of two transactions belonging in the same lane.
 // There are two cases to consider:
 //  1. The transactions have the same signer. In this case, we compare the sequence numbers.
-//  2. The transactions have different signers. In this case, we compare the priorities of the
-//     transactions.
+//  2. The transactions have different signers, or one of them is unordered. In this case, we
+//     compare the priorities of the transactions.
 //
 // Compare will return -1 if this transaction has a lower priority than the other transaction, 0 if
 // they have the same priority, and 1 if this transaction has a higher priority than the other transaction.
@@ -117,8 +638,9 @@ func (cm *Mempool[C]) Compare(ctx sdk.Context, this sdk.Tx, other sdk.Tx) (int,
 	}
 	otherSignerInfo := signers[0]
 
-	// If the signers are the same, we compare the sequence numbers.
-	if thisSignerInfo.Signer.Equals(otherSignerInfo.Signer) {
+	// If the signers are the same, we compare the sequence numbers. Unordered transactions
+	// do not have a sequence number and are only ordered by priority.
+	if !thisSignerInfo.Unordered && !otherSignerInfo.Unordered && thisSignerInfo.Signer.Equals(otherSignerInfo.Signer) {
 		switch {
 		case thisSignerInfo.Sequence < otherSignerInfo.Sequence:
 			return 1, nil
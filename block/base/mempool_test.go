@@ -1,16 +1,24 @@
 package base_test
 
 import (
+	"context"
 	"fmt"
 	"math/rand"
+	"sync"
 	"testing"
+	"time"
 
+	"cosmossdk.io/log"
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+	"github.com/huandu/skiplist"
 	"github.com/stretchr/testify/require"
 
 	signerextraction "github.com/skip-mev/block-sdk/v2/adapters/signer_extraction_adapter"
+	"github.com/skip-mev/block-sdk/v2/block"
 	"github.com/skip-mev/block-sdk/v2/block/base"
+	"github.com/skip-mev/block-sdk/v2/block/utils"
 	"github.com/skip-mev/block-sdk/v2/testutils"
 )
 
@@ -57,6 +65,56 @@ func BenchmarkContains(b *testing.B) {
 	}
 }
 
+func BenchmarkGetTxInfo(b *testing.B) {
+	acct := testutils.RandomAccounts(rand.New(rand.NewSource(1)), numAccounts)
+	txc := testutils.CreateTestEncodingConfig().TxConfig
+
+	cfg := base.NewLaneConfig(
+		log.NewNopLogger(),
+		txc.TxEncoder(),
+		txc.TxDecoder(),
+		nil,
+		signerextraction.NewDefaultAdapter(),
+		sdkmath.LegacyOneDec(),
+	)
+
+	txs := make([]sdk.Tx, 0, numAccounts*numTxsPerAcct)
+	for i := 0; i < numAccounts; i++ {
+		for j := 0; j < numTxsPerAcct; j++ {
+			tx, err := testutils.CreateRandomTx(txc, acct[i], uint64(j), 10, 0, 100, sdk.NewCoin("stake", sdkmath.NewInt(1)))
+			require.NoError(b, err)
+			txs = append(txs, tx)
+		}
+	}
+
+	for _, bc := range []struct {
+		name    string
+		mempool block.LaneMempool
+	}{
+		// The information of the txs is computed when they are inserted.
+		{"cached", base.NewMempool(base.DefaultTxPriority(), cfg.SignerExtractor, 0, base.WithTxEncoder(cfg.TxEncoder))},
+		// The txs are encoded every time their information is retrieved.
+		{"uncached", base.NewMempool(base.DefaultTxPriority(), cfg.SignerExtractor, 0)},
+	} {
+		lane, err := base.NewBaseLane(cfg, "default", base.WithMempool(bc.mempool))
+		require.NoError(b, err)
+
+		for _, tx := range txs {
+			require.NoError(b, lane.Insert(sdk.Context{}, tx))
+		}
+
+		b.Run(bc.name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				for _, tx := range txs {
+					if _, err := lane.GetTxInfo(sdk.Context{}, tx); err != nil {
+						b.Fatal(err)
+					}
+				}
+			}
+		})
+	}
+}
+
 func TestMempoolComparison(t *testing.T) {
 	acct := testutils.RandomAccounts(rand.New(rand.NewSource(1)), 2)
 	txc := testutils.CreateTestEncodingConfig().TxConfig
@@ -206,3 +264,708 @@ func TestMempoolSelect(t *testing.T) {
 		}
 	}
 }
+
+func TestMempoolSelectWhileModifying(t *testing.T) {
+	acct := testutils.RandomAccounts(rand.New(rand.NewSource(1)), 4)
+	txc := testutils.CreateTestEncodingConfig().TxConfig
+	ctx := testutils.CreateBaseSDKContext(t)
+
+	mp := base.NewMempool(
+		base.NewDefaultTxPriority(),
+		signerextraction.NewDefaultAdapter(),
+		0,
+	)
+
+	// Each of the first three accounts has 3 txs, with decreasing priorities.
+	var txs []sdk.Tx
+	for i := 0; i < 3; i++ {
+		for nonce := 0; nonce < 3; nonce++ {
+			tx, err := testutils.CreateTx(txc, acct[i], uint64(nonce), 0, nil, sdk.NewCoin("stake", sdkmath.NewInt(1)))
+			require.NoError(t, err)
+			require.NoError(t, mp.Insert(ctx.WithPriority(int64(100-10*nonce-i)), tx))
+			txs = append(txs, tx)
+		}
+	}
+
+	iterator := mp.Select(ctx, nil)
+	require.NotNil(t, iterator)
+
+	seen := make(map[sdk.Tx]int)
+	seen[iterator.Tx()]++
+	first := iterator.Tx()
+
+	iterator = iterator.Next()
+	require.NotNil(t, iterator)
+	seen[iterator.Tx()]++
+	current := iterator.Tx()
+
+	// Remove the txs that were returned, the last tx of the first account and insert
+	// a tx of another account while iterating.
+	require.NoError(t, mp.Remove(first))
+	require.NoError(t, mp.Remove(current))
+	require.NoError(t, mp.Remove(txs[2]))
+
+	newTx, err := testutils.CreateTx(txc, acct[3], 0, 0, nil, sdk.NewCoin("stake", sdkmath.NewInt(1)))
+	require.NoError(t, err)
+	require.NoError(t, mp.Insert(ctx.WithPriority(1), newTx))
+
+	for iterator = iterator.Next(); iterator != nil; iterator = iterator.Next() {
+		seen[iterator.Tx()]++
+	}
+
+	for tx, count := range seen {
+		require.Equal(t, 1, count, "tx returned more than once: %v", tx)
+	}
+
+	require.NotContains(t, seen, txs[2])
+	for _, tx := range txs {
+		if tx != txs[2] {
+			require.Contains(t, seen, tx)
+		}
+	}
+}
+
+func TestPriorityMempoolEviction(t *testing.T) {
+	acct := testutils.RandomAccounts(rand.New(rand.NewSource(1)), 3)
+	txc := testutils.CreateTestEncodingConfig().TxConfig
+	ctx := testutils.CreateBaseSDKContext(t)
+
+	createTx := func(acc testutils.Account, nonce uint64) sdk.Tx {
+		tx, err := testutils.CreateTx(txc, acc, nonce, 0, nil, sdk.NewCoin("stake", sdkmath.NewInt(1)))
+		require.NoError(t, err)
+		return tx
+	}
+
+	newMempool := func(evict bool) *base.PriorityNonceMempool[int64] {
+		cfg := base.DefaultPriorityNonceMempoolConfig()
+		cfg.MaxTx = 3
+		cfg.EvictLowerPriority = evict
+		return base.NewPriorityMempool(cfg, signerextraction.NewDefaultAdapter())
+	}
+
+	// The first account's txs have the lowest priority.
+	tx1 := createTx(acct[0], 0)
+	tx2 := createTx(acct[0], 1)
+	tx3 := createTx(acct[1], 0)
+	fill := func(mp *base.PriorityNonceMempool[int64]) {
+		require.NoError(t, mp.Insert(ctx.WithPriority(1), tx1))
+		require.NoError(t, mp.Insert(ctx.WithPriority(5), tx2))
+		require.NoError(t, mp.Insert(ctx.WithPriority(3), tx3))
+	}
+
+	t.Run("rejects txs without eviction", func(t *testing.T) {
+		mp := newMempool(false)
+		fill(mp)
+
+		_, err := mp.InsertWithEviction(ctx.WithPriority(10), createTx(acct[2], 0))
+		require.ErrorIs(t, err, sdkmempool.ErrMempoolTxMaxCapacity)
+		require.Equal(t, 3, mp.CountTx())
+	})
+
+	t.Run("evicts the lowest priority tx and the later txs of its sender", func(t *testing.T) {
+		mp := newMempool(true)
+		fill(mp)
+
+		tx4 := createTx(acct[2], 0)
+		evicted, err := mp.InsertWithEviction(ctx.WithPriority(2), tx4)
+		require.NoError(t, err)
+		require.Equal(t, []sdk.Tx{tx1, tx2}, evicted)
+		require.Equal(t, 2, mp.CountTx())
+		require.True(t, mp.Contains(tx3))
+		require.True(t, mp.Contains(tx4))
+	})
+
+	t.Run("rejects txs that do not outrank the lowest priority tx", func(t *testing.T) {
+		mp := newMempool(true)
+		fill(mp)
+
+		evicted, err := mp.InsertWithEviction(ctx.WithPriority(1), createTx(acct[2], 0))
+		require.ErrorIs(t, err, sdkmempool.ErrMempoolTxMaxCapacity)
+		require.Empty(t, evicted)
+		require.Equal(t, 3, mp.CountTx())
+	})
+
+	t.Run("rejects txs that depend on the lowest priority tx", func(t *testing.T) {
+		mp := newMempool(true)
+		fill(mp)
+
+		_, err := mp.InsertWithEviction(ctx.WithPriority(10), createTx(acct[0], 2))
+		require.ErrorIs(t, err, sdkmempool.ErrMempoolTxMaxCapacity)
+		require.True(t, mp.Contains(tx1))
+	})
+
+	t.Run("replaces txs without evicting", func(t *testing.T) {
+		mp := newMempool(true)
+		fill(mp)
+
+		evicted, err := mp.InsertWithEviction(ctx.WithPriority(10), createTx(acct[1], 0))
+		require.NoError(t, err)
+		require.Empty(t, evicted)
+		require.Equal(t, 3, mp.CountTx())
+	})
+
+	t.Run("returns the lowest priority txs", func(t *testing.T) {
+		mp := newMempool(true)
+		fill(mp)
+
+		require.Equal(t, []sdk.Tx{tx1, tx3, tx2}, mp.LowestPriorityTxs(0, 5))
+		require.Equal(t, []sdk.Tx{tx1, tx3}, mp.LowestPriorityTxs(0, 2))
+		require.Equal(t, []sdk.Tx{tx3}, mp.LowestPriorityTxs(1, 1))
+		require.Empty(t, mp.LowestPriorityTxs(3, 1))
+	})
+}
+
+// accountKeeper is a static base.AccountKeeper used to mock the sequence numbers of accounts.
+type accountKeeper map[string]uint64
+
+func (k accountKeeper) GetSequence(_ context.Context, addr sdk.AccAddress) (uint64, error) {
+	return k[addr.String()], nil
+}
+
+func TestMempoolQueuedPool(t *testing.T) {
+	acct := testutils.RandomAccounts(rand.New(rand.NewSource(1)), 2)
+	txc := testutils.CreateTestEncodingConfig().TxConfig
+	ctx := testutils.CreateBaseSDKContext(t)
+
+	createTx := func(acc testutils.Account, nonce uint64) sdk.Tx {
+		tx, err := testutils.CreateTx(txc, acc, nonce, 0, nil, sdk.NewCoin("stake", sdkmath.NewInt(1)))
+		require.NoError(t, err)
+		return tx
+	}
+
+	selectTxs := func(mp *base.Mempool[int]) []sdk.Tx {
+		var txs []sdk.Tx
+		for iterator := mp.Select(ctx, nil); iterator != nil; iterator = iterator.Next() {
+			txs = append(txs, iterator.Tx())
+		}
+		return txs
+	}
+
+	keeper := accountKeeper{acct[1].Address.String(): 5}
+	mp := base.NewMempool(
+		base.DefaultTxPriority(),
+		signerextraction.NewDefaultAdapter(),
+		0,
+		base.WithQueuedPool(keeper),
+	)
+
+	tx0 := createTx(acct[0], 0)
+	tx1 := createTx(acct[0], 1)
+	tx2 := createTx(acct[0], 2)
+
+	t.Run("txs with a sequence gap are queued", func(t *testing.T) {
+		require.NoError(t, mp.Insert(ctx, tx0))
+		require.NoError(t, mp.Insert(ctx, tx2))
+
+		pending, queued, ok := mp.CountPoolTxs()
+		require.True(t, ok)
+		require.Equal(t, 1, pending)
+		require.Equal(t, 1, queued)
+		require.Equal(t, 2, mp.CountTx())
+
+		require.True(t, mp.Contains(tx2))
+		require.Equal(t, []sdk.Tx{tx0}, selectTxs(mp))
+	})
+
+	t.Run("queued txs are promoted once the gap closes", func(t *testing.T) {
+		require.NoError(t, mp.Insert(ctx, tx1))
+
+		pending, queued, _ := mp.CountPoolTxs()
+		require.Equal(t, 3, pending)
+		require.Zero(t, queued)
+		require.Equal(t, []sdk.Tx{tx0, tx1, tx2}, selectTxs(mp))
+	})
+
+	t.Run("queued txs are promoted once the account sequence catches up", func(t *testing.T) {
+		tx := createTx(acct[1], 6)
+		require.NoError(t, mp.Insert(ctx, tx))
+
+		mp.PromoteQueuedTxs(ctx)
+		_, queued, _ := mp.CountPoolTxs()
+		require.Equal(t, 1, queued)
+
+		keeper[acct[1].Address.String()] = 6
+		mp.PromoteQueuedTxs(ctx)
+		_, queued, _ = mp.CountPoolTxs()
+		require.Zero(t, queued)
+		require.Contains(t, selectTxs(mp), tx)
+	})
+
+	t.Run("queued txs can be removed", func(t *testing.T) {
+		tx := createTx(acct[1], 10)
+		require.NoError(t, mp.Insert(ctx, tx))
+		require.True(t, mp.Contains(tx))
+
+		require.NoError(t, mp.Remove(tx))
+		require.False(t, mp.Contains(tx))
+
+		_, queued, _ := mp.CountPoolTxs()
+		require.Zero(t, queued)
+	})
+}
+
+// failingAccountKeeper is a base.AccountKeeper that fails once it has been called the given
+// number of times.
+type failingAccountKeeper struct {
+	calls    int
+	maxCalls int
+}
+
+func (k *failingAccountKeeper) GetSequence(_ context.Context, _ sdk.AccAddress) (uint64, error) {
+	k.calls++
+	if k.calls > k.maxCalls {
+		return 0, fmt.Errorf("account keeper unavailable")
+	}
+
+	return 0, nil
+}
+
+func TestMempoolTxHooks(t *testing.T) {
+	acct := testutils.RandomAccounts(rand.New(rand.NewSource(1)), 1)
+	txc := testutils.CreateTestEncodingConfig().TxConfig
+	ctx := testutils.CreateBaseSDKContext(t)
+
+	createTx := func(nonce uint64, fee int64) sdk.Tx {
+		tx, err := testutils.CreateTx(txc, acct[0], nonce, 0, nil, sdk.NewCoin("stake", sdkmath.NewInt(fee)))
+		require.NoError(t, err)
+		return tx
+	}
+
+	txHash := func(tx sdk.Tx) string {
+		hash, err := utils.GetTxHash(txc.TxEncoder(), tx)
+		require.NoError(t, err)
+		return hash
+	}
+
+	t.Run("cannot report txs without a tx encoder", func(t *testing.T) {
+		mp := base.NewMempool(base.DefaultTxPriority(), signerextraction.NewDefaultAdapter(), 0)
+		require.False(t, mp.SetTxHooks(block.TxHooks{}))
+	})
+
+	mp := base.NewMempool(
+		base.DefaultTxPriority(),
+		signerextraction.NewDefaultAdapter(),
+		0,
+		base.WithQueuedPool(accountKeeper{}),
+		base.WithTxEncoder(txc.TxEncoder()),
+		base.WithTxReplacementPolicy(base.NewFeeBumpReplacementPolicy(sdkmath.LegacyMustNewDecFromStr("0.1"))),
+	)
+
+	// The hooks maintain the set of hashes of the txs in the mempool.
+	hashes := make(map[string]bool)
+	require.True(t, mp.SetTxHooks(block.TxHooks{
+		OnInsert: func(event block.TxEvent) {
+			require.False(t, hashes[event.Hash])
+			require.Equal(t, txHash(event.Tx), event.Hash)
+			hashes[event.Hash] = true
+		},
+		OnRemove: func(event block.TxEvent) {
+			require.True(t, hashes[event.Hash])
+			delete(hashes, event.Hash)
+		},
+	}))
+
+	tx0 := createTx(0, 100)
+	tx1 := createTx(1, 100)
+	replacement := createTx(1, 200)
+
+	t.Run("reports queued and promoted txs", func(t *testing.T) {
+		require.NoError(t, mp.Insert(ctx, tx1))
+		require.Equal(t, map[string]bool{txHash(tx1): true}, hashes)
+
+		require.NoError(t, mp.Insert(ctx, tx0))
+		require.Equal(t, map[string]bool{txHash(tx0): true, txHash(tx1): true}, hashes)
+
+		pending, queued, _ := mp.CountPoolTxs()
+		require.Equal(t, 2, pending)
+		require.Zero(t, queued)
+	})
+
+	t.Run("reports replaced txs", func(t *testing.T) {
+		require.NoError(t, mp.Insert(ctx, replacement))
+		require.Equal(t, map[string]bool{txHash(tx0): true, txHash(replacement): true}, hashes)
+	})
+
+	t.Run("does not remove a different tx with the same signer and sequence", func(t *testing.T) {
+		require.False(t, mp.Contains(tx1))
+		require.NoError(t, mp.Remove(tx1))
+		require.True(t, mp.Contains(replacement))
+		require.Equal(t, map[string]bool{txHash(tx0): true, txHash(replacement): true}, hashes)
+	})
+
+	t.Run("reports removed txs", func(t *testing.T) {
+		require.NoError(t, mp.Remove(tx0))
+		require.NoError(t, mp.Remove(replacement))
+		require.Empty(t, hashes)
+		require.Zero(t, mp.CountTx())
+	})
+}
+
+func TestMempoolQueuedPoolBounds(t *testing.T) {
+	acct := testutils.RandomAccounts(rand.New(rand.NewSource(1)), 1)
+	txc := testutils.CreateTestEncodingConfig().TxConfig
+	ctx := testutils.CreateBaseSDKContext(t)
+
+	createTx := func(nonce uint64) sdk.Tx {
+		tx, err := testutils.CreateTx(txc, acct[0], nonce, 0, nil, sdk.NewCoin("stake", sdkmath.NewInt(1)))
+		require.NoError(t, err)
+		return tx
+	}
+
+	t.Run("the queued pool holds a quarter of the max txs by default", func(t *testing.T) {
+		mp := base.NewMempool(
+			base.DefaultTxPriority(),
+			signerextraction.NewDefaultAdapter(),
+			8,
+			base.WithQueuedPool(accountKeeper{}),
+		)
+
+		require.NoError(t, mp.Insert(ctx, createTx(2)))
+		require.NoError(t, mp.Insert(ctx, createTx(3)))
+		require.ErrorIs(t, mp.Insert(ctx, createTx(4)), sdkmempool.ErrMempoolTxMaxCapacity)
+
+		_, queued, _ := mp.CountPoolTxs()
+		require.Equal(t, 2, queued)
+	})
+
+	t.Run("the queued pool has its own bound", func(t *testing.T) {
+		mp := base.NewMempool(
+			base.DefaultTxPriority(),
+			signerextraction.NewDefaultAdapter(),
+			8,
+			base.WithQueuedPool(accountKeeper{}),
+			base.WithMaxQueuedTxs(1),
+		)
+
+		require.NoError(t, mp.Insert(ctx, createTx(2)))
+		require.ErrorIs(t, mp.Insert(ctx, createTx(3)), sdkmempool.ErrMempoolTxMaxCapacity)
+		require.Equal(t, 1, mp.CountTx())
+	})
+
+	t.Run("failing to promote queued txs does not fail the insertion", func(t *testing.T) {
+		mp := base.NewMempool(
+			base.DefaultTxPriority(),
+			signerextraction.NewDefaultAdapter(),
+			0,
+			base.WithQueuedPool(&failingAccountKeeper{maxCalls: 1}),
+		)
+
+		tx := createTx(0)
+		require.NoError(t, mp.Insert(ctx, tx))
+		require.True(t, mp.Contains(tx))
+	})
+}
+
+func TestMempoolEvictionQueuedPool(t *testing.T) {
+	acct := testutils.RandomAccounts(rand.New(rand.NewSource(1)), 3)
+	txc := testutils.CreateTestEncodingConfig().TxConfig
+	ctx := testutils.CreateBaseSDKContext(t)
+
+	createTx := func(acc testutils.Account, nonce uint64) sdk.Tx {
+		tx, err := testutils.CreateTx(txc, acc, nonce, 0, nil, sdk.NewCoin("stake", sdkmath.NewInt(1)))
+		require.NoError(t, err)
+		return tx
+	}
+
+	mp := base.NewMempool(
+		base.NewDefaultTxPriority(),
+		signerextraction.NewDefaultAdapter(),
+		2,
+		base.WithQueuedPool(accountKeeper{}),
+		base.WithEvictLowerPriority(true),
+	)
+
+	// tx2 is queued behind tx1, which has the lowest priority of the pending txs.
+	tx1 := createTx(acct[0], 0)
+	tx2 := createTx(acct[0], 2)
+	tx3 := createTx(acct[1], 0)
+	require.NoError(t, mp.Insert(ctx.WithPriority(1), tx1))
+	require.NoError(t, mp.Insert(ctx.WithPriority(1), tx2))
+	require.NoError(t, mp.Insert(ctx.WithPriority(3), tx3))
+
+	// Evicting tx1 also evicts the queued tx2, which could no longer be promoted.
+	tx4 := createTx(acct[2], 0)
+	evicted, err := mp.InsertWithEviction(ctx.WithPriority(2), tx4)
+	require.NoError(t, err)
+	require.Equal(t, []sdk.Tx{tx1, tx2}, evicted)
+
+	pending, queued, _ := mp.CountPoolTxs()
+	require.Equal(t, 2, pending)
+	require.Zero(t, queued)
+}
+
+func TestMempoolUnorderedTxs(t *testing.T) {
+	acct := testutils.RandomAccounts(rand.New(rand.NewSource(1)), 1)
+	txc := testutils.CreateTestEncodingConfig().TxConfig
+	ctx := testutils.CreateBaseSDKContext(t)
+
+	feePriority := base.TxPriority[int64]{
+		GetTxPriority: func(_ context.Context, tx sdk.Tx) int64 {
+			return tx.(sdk.FeeTx).GetFee().AmountOf("stake").Int64()
+		},
+		Compare: func(a, b int64) int {
+			return skiplist.Int64.Compare(a, b)
+		},
+	}
+
+	createTx := func(nonce uint64, fee int64) sdk.Tx {
+		tx, err := testutils.CreateTx(txc, acct[0], nonce, 0, nil, sdk.NewCoin("stake", sdkmath.NewInt(fee)))
+		require.NoError(t, err)
+		return tx
+	}
+
+	createUnorderedTx := func(timeout int64, fee int64) sdk.Tx {
+		tx, err := testutils.CreateUnorderedTx(txc, acct[0], time.Unix(timeout, 0), 1, sdk.NewCoin("stake", sdkmath.NewInt(fee)))
+		require.NoError(t, err)
+		return tx
+	}
+
+	selectTxs := func(mp *base.Mempool[int64]) []sdk.Tx {
+		var txs []sdk.Tx
+		for iterator := mp.Select(ctx, nil); iterator != nil; iterator = iterator.Next() {
+			txs = append(txs, iterator.Tx())
+		}
+		return txs
+	}
+
+	tx0 := createTx(0, 1)
+	tx1 := createTx(1, 1)
+	unordered1 := createUnorderedTx(100, 3)
+	unordered2 := createUnorderedTx(200, 2)
+
+	t.Run("unordered txs are rejected without a tx encoder", func(t *testing.T) {
+		mp := base.NewMempool(feePriority, signerextraction.NewDefaultAdapter(), 0)
+		require.Error(t, mp.Insert(ctx, unordered1))
+		require.False(t, mp.Contains(unordered1))
+	})
+
+	mp := base.NewMempool(
+		feePriority,
+		signerextraction.NewDefaultAdapter(),
+		0,
+		base.WithTxEncoder(testutils.UnorderedTxEncoder(txc.TxEncoder())),
+		base.WithQueuedPool(accountKeeper{}),
+	)
+
+	t.Run("unordered txs coexist with the txs of the same signer", func(t *testing.T) {
+		for _, tx := range []sdk.Tx{tx0, unordered1, tx1, unordered2} {
+			require.NoError(t, mp.Insert(ctx, tx))
+		}
+
+		require.Equal(t, 4, mp.CountTx())
+		for _, tx := range []sdk.Tx{tx0, tx1, unordered1, unordered2} {
+			require.True(t, mp.Contains(tx))
+		}
+
+		// Unordered txs are never queued.
+		_, queued, _ := mp.CountPoolTxs()
+		require.Zero(t, queued)
+	})
+
+	t.Run("unordered txs are ordered by priority only", func(t *testing.T) {
+		require.Equal(t, []sdk.Tx{unordered1, unordered2, tx0, tx1}, selectTxs(mp))
+
+		cmp, err := mp.Compare(ctx, unordered1, unordered2)
+		require.NoError(t, err)
+		require.Equal(t, 1, cmp)
+
+		cmp, err = mp.Compare(ctx, tx1, unordered2)
+		require.NoError(t, err)
+		require.Equal(t, -1, cmp)
+
+		cmp, err = mp.Compare(ctx, tx0, tx1)
+		require.NoError(t, err)
+		require.Equal(t, 1, cmp)
+	})
+
+	t.Run("unordered txs are looked up by hash", func(t *testing.T) {
+		found, ok := mp.Lookup(unordered2)
+		require.True(t, ok)
+		require.Equal(t, unordered2, found)
+
+		_, ok = mp.Lookup(createUnorderedTx(300, 2))
+		require.False(t, ok)
+	})
+
+	t.Run("unordered txs are removed by hash", func(t *testing.T) {
+		require.NoError(t, mp.Remove(unordered1))
+		require.False(t, mp.Contains(unordered1))
+		require.True(t, mp.Contains(unordered2))
+		require.Equal(t, []sdk.Tx{unordered2, tx0, tx1}, selectTxs(mp))
+	})
+}
+
+func TestPriorityMempoolSenderIndices(t *testing.T) {
+	acct := testutils.RandomAccounts(rand.New(rand.NewSource(1)), 1)
+	txc := testutils.CreateTestEncodingConfig().TxConfig
+	ctx := testutils.CreateBaseSDKContext(t)
+
+	cfg := base.DefaultPriorityNonceMempoolConfig()
+	cfg.TxEncoder = testutils.UnorderedTxEncoder(txc.TxEncoder())
+	mp := base.NewPriorityMempool(cfg, signerextraction.NewDefaultAdapter())
+
+	const numTxs = 50
+	txs := make([]sdk.Tx, 0, numTxs)
+	for i := 0; i < numTxs; i++ {
+		tx, err := testutils.CreateUnorderedTx(txc, acct[0], time.Unix(int64(100+i), 0), 1, sdk.NewCoin("stake", sdkmath.NewInt(1)))
+		require.NoError(t, err)
+		require.NoError(t, mp.Insert(ctx.WithPriority(int64(i)), tx))
+
+		txs = append(txs, tx)
+	}
+
+	// Each unordered tx is indexed as its own sender.
+	require.Equal(t, numTxs, mp.CountSenders())
+
+	// Removing a sender's index while iterating does not break the iterator.
+	iterator := mp.Select(ctx, nil)
+	require.NotNil(t, iterator)
+
+	for _, tx := range txs {
+		require.NoError(t, mp.Remove(tx))
+	}
+
+	require.Nil(t, iterator.Next())
+	require.Equal(t, 0, mp.CountSenders())
+	require.Equal(t, 0, mp.CountTx())
+	require.NoError(t, base.IsEmpty[int64](mp))
+}
+
+func TestMempoolConcurrentAccess(t *testing.T) {
+	acct := testutils.RandomAccounts(rand.New(rand.NewSource(1)), 4)
+	txc := testutils.CreateTestEncodingConfig().TxConfig
+	ctx := testutils.CreateBaseSDKContext(t)
+
+	mp := base.NewMempool(
+		base.DefaultTxPriority(),
+		signerextraction.NewDefaultAdapter(),
+		0,
+		base.WithTxEncoder(txc.TxEncoder()),
+		base.WithQueuedPool(accountKeeper{}),
+	)
+
+	// Each writer inserts the txs of its own account in reverse order, such that txs are
+	// queued and then promoted, and removes every other tx.
+	numTxs := 50
+	txs := make([][]sdk.Tx, len(acct))
+	for i, acc := range acct {
+		for nonce := 0; nonce < numTxs; nonce++ {
+			tx, err := testutils.CreateTx(txc, acc, uint64(nonce), 0, nil, sdk.NewCoin("stake", sdkmath.NewInt(int64(nonce))))
+			require.NoError(t, err)
+			txs[i] = append(txs[i], tx)
+		}
+	}
+
+	var (
+		wg   sync.WaitGroup
+		done = make(chan struct{})
+	)
+	for i := range acct {
+		wg.Add(1)
+		go func(txs []sdk.Tx) {
+			defer wg.Done()
+
+			for j := len(txs) - 1; j >= 0; j-- {
+				require.NoError(t, mp.Insert(ctx.WithPriority(int64(j)), txs[j]))
+			}
+
+			for j := 0; j < len(txs); j += 2 {
+				require.NoError(t, mp.Remove(txs[j]))
+			}
+		}(txs[i])
+	}
+
+	var readers sync.WaitGroup
+	readers.Add(1)
+	go func() {
+		defer readers.Done()
+
+		for {
+			select {
+			case <-done:
+				return
+			default:
+			}
+
+			for iterator := mp.Select(ctx, nil); iterator != nil; iterator = iterator.Next() {
+				mp.Contains(iterator.Tx())
+			}
+
+			mp.CountPoolTxs()
+			mp.PromoteQueuedTxs(ctx)
+			mp.Lookup(txs[0][0])
+		}
+	}()
+
+	wg.Wait()
+	close(done)
+	readers.Wait()
+
+	require.Equal(t, len(acct)*numTxs/2, mp.CountTx())
+	for i := range acct {
+		for j, tx := range txs[i] {
+			require.Equal(t, j%2 == 1, mp.Contains(tx))
+		}
+	}
+}
+
+func TestMempoolTxInfo(t *testing.T) {
+	acct := testutils.RandomAccounts(rand.New(rand.NewSource(1)), 2)
+	txc := testutils.CreateTestEncodingConfig().TxConfig
+	ctx := testutils.CreateBaseSDKContext(t)
+
+	mp := base.NewMempool(
+		base.DefaultTxPriority(),
+		signerextraction.NewDefaultAdapter(),
+		0,
+		base.WithTxEncoder(txc.TxEncoder()),
+		base.WithQueuedPool(accountKeeper{}),
+	)
+
+	tx, err := testutils.CreateRandomTx(txc, acct[0], 0, 2, 0, 100, sdk.NewCoin("stake", sdkmath.NewInt(10)))
+	require.NoError(t, err)
+
+	// A tx with a sequence gap is queued.
+	queuedTx, err := testutils.CreateRandomTx(txc, acct[1], 5, 2, 0, 100, sdk.NewCoin("stake", sdkmath.NewInt(10)))
+	require.NoError(t, err)
+
+	_, found := mp.TxInfo(tx)
+	require.False(t, found)
+
+	require.NoError(t, mp.Insert(ctx, tx))
+	require.NoError(t, mp.Insert(ctx, queuedTx))
+
+	for _, tx := range []sdk.Tx{tx, queuedTx} {
+		txBytes, err := txc.TxEncoder()(tx)
+		require.NoError(t, err)
+
+		txInfo, found := mp.TxInfo(tx)
+		require.True(t, found)
+		require.Equal(t, utils.TxHash(txBytes), txInfo.Hash)
+		require.Equal(t, int64(len(txBytes)), txInfo.Size)
+		require.Equal(t, uint64(100), txInfo.GasLimit)
+		require.Equal(t, txBytes, txInfo.TxBytes)
+		require.Equal(t, mp.Priority(ctx, tx), txInfo.Priority)
+		require.Len(t, txInfo.Signers, 1)
+	}
+
+	// A different instance of the tx (e.g. decoded from a proposal) is not cached.
+	txBytes, err := txc.TxEncoder()(tx)
+	require.NoError(t, err)
+	decodedTx, err := txc.TxDecoder()(txBytes)
+	require.NoError(t, err)
+
+	_, found = mp.TxInfo(decodedTx)
+	require.False(t, found)
+
+	require.NoError(t, mp.Remove(tx))
+	_, found = mp.TxInfo(tx)
+	require.False(t, found)
+
+	// Without a tx encoder, the information of the txs is not stored.
+	mp = base.NewMempool(base.DefaultTxPriority(), signerextraction.NewDefaultAdapter(), 0)
+	require.NoError(t, mp.Insert(ctx, tx))
+
+	_, found = mp.TxInfo(tx)
+	require.False(t, found)
+}
@@ -31,6 +31,10 @@ func WithPrepareLaneHandler(prepareLaneHandler PrepareLaneHandler) LaneOption {
 // is called when a new proposal is being verified and the lane needs to verify
 // that the transactions included in the proposal are valid respecting the verification
 // logic of the lane.
+//
+// NOTE: This unsets the process lane basic handler of the lane since it may no longer
+// match the verification logic of the lane. Use WithProcessLaneBasicHandler after this
+// option to verify the lane's transactions in parallel with other lanes.
 func WithProcessLaneHandler(processLaneHandler ProcessLaneHandler) LaneOption {
 	return func(l *BaseLane) {
 		if processLaneHandler == nil {
@@ -38,6 +42,20 @@ func WithProcessLaneHandler(processLaneHandler ProcessLaneHandler) LaneOption {
 		}
 
 		l.processLaneHandler = processLaneHandler
+		l.processLaneBasicHandler = nil
+	}
+}
+
+// WithProcessLaneBasicHandler sets the process lane basic handler for the lane. This
+// handler is called when a proposal is verified in parallel and the lane needs to verify
+// the stateless invariants of the transactions in the proposal that belong to the lane.
+func WithProcessLaneBasicHandler(processLaneBasicHandler ProcessLaneBasicHandler) LaneOption {
+	return func(l *BaseLane) {
+		if processLaneBasicHandler == nil {
+			panic("process lane basic handler cannot be nil")
+		}
+
+		l.processLaneBasicHandler = processLaneBasicHandler
 	}
 }
 
@@ -66,15 +84,37 @@ func WithMempool(mempool block.LaneMempool) LaneOption {
 	}
 }
 
+// WithTxInfoCache sets an additional cache of transaction information for the lane,
+// which is consulted when the information of a transaction is not cached by the lane's
+// mempool (e.g. transactions that are decoded from other transactions, such as the
+// bundled transactions of a bid). See GetTxInfo.
+func WithTxInfoCache(cache TxInfoCache) LaneOption {
+	return func(l *BaseLane) {
+		if cache == nil {
+			panic("tx info cache cannot be nil")
+		}
+
+		l.txInfoCache = cache
+	}
+}
+
 // WithMempoolConfigs sets the mempool for the lane with the given lane config
 // and TxPriority struct. This mempool is used to store transactions that are waiting
-// to be processed.
+// to be processed. Transactions are replaced according to the config's TxReplacement, are
+// queued if the config has an AccountKeeper and evict lower priority transactions once the
+// mempool is full if the config sets EvictLowerPriority.
 func WithMempoolConfigs[C comparable](cfg LaneConfig, txPriority TxPriority[C]) LaneOption {
 	return func(l *BaseLane) {
 		l.LaneMempool = NewMempool(
 			txPriority,
 			cfg.SignerExtractor,
 			cfg.MaxTxs,
+			WithTxReplacementPolicy(cfg.TxReplacement),
+			WithQueuedPool(cfg.AccountKeeper),
+			WithMaxQueuedTxs(cfg.MaxQueuedTxs),
+			WithLogger(cfg.Logger),
+			WithTxEncoder(cfg.TxEncoder),
+			WithEvictLowerPriority(cfg.EvictLowerPriority),
 		)
 	}
 }
@@ -0,0 +1,73 @@
+package base
+
+import (
+	"errors"
+	"fmt"
+	"runtime/debug"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TxPanicEvent is the name of the span event recorded for each transaction that panicked
+// while the lane was preparing its partial proposal (see RecoverTxPanic).
+const TxPanicEvent = "tx panicked"
+
+// ErrTxPanicked is returned by RecoverTxPanic if processing the transaction panicked.
+var ErrTxPanicked = errors.New("tx panicked")
+
+// RecoverTxPanic runs fn, which processes the given transaction while the lane prepares its
+// partial proposal (e.g. GetTxInfo, Match or VerifyTx), and converts a panic into an error
+// wrapping ErrTxPanicked. Without it, the panic would unwind the whole PrepareLane call and
+// the entire lane would be skipped (see abci.ChainPrepareLanes) for as long as the
+// transaction is in the mempool. The panic is logged along with its stack trace, recorded as
+// an event of the lane's span (if any) and reported to the lane's metrics, such that
+// operators can find the offending transactions. Transactions for which ErrTxPanicked is
+// returned should be removed from the lane's mempool.
+func (l *BaseLane) RecoverTxPanic(ctx sdk.Context, tx sdk.Tx, fn func() error) (err error) {
+	defer func() {
+		r := recover()
+		if r == nil {
+			return
+		}
+
+		txHash := l.panickedTxHash(tx)
+		l.Logger().Error(
+			"recovered from panic while preparing lane; removing tx",
+			"lane", l.Name(),
+			"tx_hash", txHash,
+			"panic", r,
+			"stack", string(debug.Stack()),
+		)
+		l.Metrics().AddTxPanic(l.Name())
+
+		if span := trace.SpanFromContext(ctx.Context()); span.IsRecording() {
+			span.AddEvent(
+				TxPanicEvent,
+				trace.WithAttributes(
+					attribute.String("lane", l.Name()),
+					attribute.String("tx_hash", txHash),
+					attribute.String("panic", fmt.Sprint(r)),
+				),
+			)
+		}
+
+		err = fmt.Errorf("%w: %v", ErrTxPanicked, r)
+	}()
+
+	return fn()
+}
+
+// panickedTxHash returns the hash of a transaction that panicked, or an empty string if the
+// hash cannot be computed (e.g. because encoding the transaction panics as well).
+func (l *BaseLane) panickedTxHash(tx sdk.Tx) (hash string) {
+	defer func() {
+		if recover() != nil {
+			hash = ""
+		}
+	}()
+
+	hash, _ = l.TxHash(tx)
+	return hash
+}
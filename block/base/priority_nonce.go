@@ -16,6 +16,9 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"reflect"
+	"strings"
+	"sync"
 
 	"github.com/huandu/skiplist"
 
@@ -23,6 +26,8 @@ import (
 	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
 
 	signer_extraction "github.com/skip-mev/block-sdk/v2/adapters/signer_extraction_adapter"
+	"github.com/skip-mev/block-sdk/v2/block"
+	"github.com/skip-mev/block-sdk/v2/block/utils"
 )
 
 var (
@@ -34,9 +39,33 @@ type (
 	// MempoolInterface defines the interface a mempool should implement.
 	MempoolInterface interface {
 		sdkmempool.Mempool
+		block.TxTracker
+		block.TxPriorityIndex
 
-		// Contains returns true if the transaction is in the mempool.
+		// Contains returns true if the transaction is in the mempool. A different
+		// transaction with the same signer and sequence number does not match.
 		Contains(tx sdk.Tx) bool
+
+		// InsertWithEviction inserts the transaction and returns the transactions
+		// that were evicted to make room for it (see EvictLowerPriority).
+		InsertWithEviction(ctx context.Context, tx sdk.Tx) ([]sdk.Tx, error)
+
+		// Lookup returns the transaction in the mempool with the same signer and
+		// sequence number as the given transaction (or, for unordered transactions,
+		// the same hash), if any.
+		Lookup(tx sdk.Tx) (sdk.Tx, bool)
+
+		// SenderTxs returns the transactions of the given sender in the mempool,
+		// ordered by sequence number.
+		SenderTxs(sender string) []sdk.Tx
+
+		// LookupHash returns the transaction in the mempool with the given hash,
+		// if any.
+		LookupHash(hash string) (sdk.Tx, bool)
+
+		// TxInfo returns the information of the transaction computed when it was
+		// inserted, if the transaction is in the mempool.
+		TxInfo(tx sdk.Tx) (utils.TxWithInfo, bool)
 	}
 
 	// PriorityNonceMempoolConfig defines the configuration used to configure the
@@ -61,6 +90,21 @@ type (
 		//   (sequence number) when evicting transactions.
 		// - if MaxTx < 0, `Insert` is a no-op.
 		MaxTx int
+
+		// EvictLowerPriority defines the behavior of the mempool once it holds MaxTx
+		// transactions. If false, every new transaction is rejected. If true, a new
+		// transaction with a higher priority than the lowest priority transaction in
+		// the mempool evicts that transaction along with the transactions of the same
+		// sender with a higher nonce (which can no longer be executed).
+		EvictLowerPriority bool
+
+		// TxEncoder is used to compute the hash of the transactions, which indexes
+		// them by hash and identifies unordered transactions in the mempool since
+		// they do not have a sequence number. The encoded transactions are also used
+		// to compute the information of the transactions once, when they are inserted
+		// (see TxInfo). If nil, transactions cannot be looked up by hash, their
+		// information is not stored and unordered transactions are rejected.
+		TxEncoder sdk.TxEncoder
 	}
 
 	// PriorityNonceMempool is a mempool implementation that stores txs
@@ -70,23 +114,57 @@ type (
 	// are multiple txs from the same sender, they are not always comparable by
 	// priority to other sender txs and must be partially ordered by both sender-nonce
 	// and priority.
+	//
+	// The mempool is safe for concurrent use. The iterator returned by Select walks
+	// the mempool lazily, so transactions can be inserted and removed while
+	// iterating.
 	PriorityNonceMempool[C comparable] struct {
+		mtx sync.RWMutex
+
+		// version is incremented whenever the indexes are modified, such that the
+		// iterators returned by Select know when to re-resolve their position.
+		version uint64
+
+		// tiesDirty is set whenever the indexes are modified, such that the weights
+		// of the transactions with the same priority are only recomputed by Select
+		// if they may have changed.
+		tiesDirty bool
+
 		priorityIndex   *skiplist.SkipList
 		priorityCounts  map[C]int
 		senderIndices   map[string]*skiplist.SkipList
 		scores          map[txMeta[C]]txMeta[C]
 		cfg             PriorityNonceMempoolConfig[C]
 		signerExtractor signer_extraction.Adapter
+
+		// hashes and txHashes index the transactions by hash (and the hashes by
+		// sender and nonce) if the config has a TxEncoder. txSizes stores the size of
+		// the encoded transactions by sender and nonce.
+		hashes   map[string]txMeta[C]
+		txHashes map[txMeta[C]]string
+		txSizes  map[txMeta[C]]int64
+
+		// txInfos stores the information of the transactions, computed when they
+		// are inserted, by sender and nonce if the config has a TxEncoder.
+		txInfos map[txMeta[C]]utils.TxWithInfo
+
+		// hooks are called with the transactions inserted into and removed from the
+		// mempool (see SetTxHooks).
+		hooks block.TxHooks
 	}
 
-	// PriorityNonceIterator defines an iterator that is used for mempool iteration
-	// on Select().
+	// PriorityNonceIterator defines an iterator that is used to walk the mempool
+	// in priority and sender-nonce order on Select(). Each step holds the read lock
+	// of the mempool. If the mempool was modified since the previous step, the
+	// iterator re-resolves its position by key (see resync).
 	PriorityNonceIterator[C comparable] struct {
 		mempool       *PriorityNonceMempool[C]
 		priorityNode  *skiplist.Element
 		senderCursors map[string]*skiplist.Element
 		sender        string
 		nextPriority  C
+		version       uint64
+		tx            sdk.Tx
 	}
 
 	// TxPriority defines a type that is used to retrieve and compare transaction
@@ -185,6 +263,10 @@ func NewPriorityMempool[C comparable](cfg PriorityNonceMempoolConfig[C], extract
 		scores:          make(map[txMeta[C]]txMeta[C]),
 		cfg:             cfg,
 		signerExtractor: extractor,
+		hashes:          make(map[string]txMeta[C]),
+		txHashes:        make(map[txMeta[C]]string),
+		txSizes:         make(map[txMeta[C]]int64),
+		txInfos:         make(map[txMeta[C]]utils.TxWithInfo),
 	}
 
 	return mp
@@ -199,6 +281,9 @@ func DefaultPriorityMempool(extractor signer_extraction.DefaultAdapter) *Priorit
 // i.e. the next valid transaction for the sender. If no such transaction exists,
 // nil will be returned.
 func (mp *PriorityNonceMempool[C]) NextSenderTx(sender string) sdk.Tx {
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+
 	senderIndex, ok := mp.senderIndices[sender]
 	if !ok {
 		return nil
@@ -218,26 +303,70 @@ func (mp *PriorityNonceMempool[C]) NextSenderTx(sender string) sdk.Tx {
 // Inserting a duplicate tx with a different priority overwrites the existing tx,
 // changing the total order of the mempool.
 func (mp *PriorityNonceMempool[C]) Insert(ctx context.Context, tx sdk.Tx) error {
-	if mp.cfg.MaxTx > 0 && mp.CountTx() >= mp.cfg.MaxTx {
-		return sdkmempool.ErrMempoolTxMaxCapacity
-	} else if mp.cfg.MaxTx < 0 {
-		return nil
+	_, err := mp.InsertWithEviction(ctx, tx)
+	return err
+}
+
+// InsertWithEviction inserts a Tx into the mempool (see Insert) and returns the
+// transactions that were evicted to make room for it. Transactions are only evicted
+// if the mempool is full and EvictLowerPriority is set.
+func (mp *PriorityNonceMempool[C]) InsertWithEviction(ctx context.Context, tx sdk.Tx) ([]sdk.Tx, error) {
+	if mp.cfg.MaxTx < 0 {
+		return nil, nil
 	}
 
 	signers, err := mp.signerExtractor.GetSigners(tx)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	if len(signers) == 0 {
-		return fmt.Errorf("tx must have at least one signer")
+
+	var txBytes []byte
+	if mp.cfg.TxEncoder != nil {
+		if txBytes, err = mp.cfg.TxEncoder(tx); err != nil {
+			return nil, fmt.Errorf("failed to encode tx: %w", err)
+		}
+	}
+
+	sender, nonce, err := mp.signersKey(tx, signers, txBytes)
+	if err != nil {
+		return nil, err
 	}
 
-	signer := signers[0]
-	sender := signer.Signer.String()
 	priority := mp.cfg.TxPriority.GetTxPriority(ctx, tx)
-	nonce := signer.Sequence
 	key := txMeta[C]{nonce: nonce, priority: priority, sender: sender}
 
+	// The information of the transaction is computed once, such that it does not have to
+	// be encoded again when proposals are built.
+	var (
+		hash    string
+		txInfo  utils.TxWithInfo
+		hasInfo bool
+	)
+	if txBytes != nil {
+		hash = utils.TxHash(txBytes)
+
+		if feeTx, ok := tx.(sdk.FeeTx); ok {
+			txInfo = utils.NewTxInfo(hash, int64(len(txBytes)), feeTx.GetGas(), txBytes, priority, signers)
+			hasInfo = true
+		}
+	}
+
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	full := mp.cfg.MaxTx > 0 && mp.priorityIndex.Len() >= mp.cfg.MaxTx
+	if full && !mp.cfg.EvictLowerPriority {
+		return nil, sdkmempool.ErrMempoolTxMaxCapacity
+	}
+
+	// Replacing a transaction does not require any room in the mempool.
+	var evicted []sdk.Tx
+	if _, replaced := mp.scores[txMeta[C]{nonce: nonce, sender: sender}]; !replaced && full {
+		if evicted, err = mp.evictLowestPriority(key); err != nil {
+			return nil, err
+		}
+	}
+
 	senderIndex, ok := mp.senderIndices[sender]
 	if !ok {
 		senderIndex = skiplist.New(skiplist.LessThanFunc(func(a, b any) int {
@@ -257,8 +386,9 @@ func (mp *PriorityNonceMempool[C]) Insert(ctx context.Context, tx sdk.Tx) error
 	// changes.
 	sk := txMeta[C]{nonce: nonce, sender: sender}
 	if oldScore, txExists := mp.scores[sk]; txExists {
-		if mp.cfg.TxReplacement != nil && !mp.cfg.TxReplacement(oldScore.priority, priority, senderIndex.Get(key).Value.(sdk.Tx), tx) {
-			return fmt.Errorf(
+		oldTx := senderIndex.Get(key).Value.(sdk.Tx)
+		if mp.cfg.TxReplacement != nil && !mp.cfg.TxReplacement(oldScore.priority, priority, oldTx, tx) {
+			return nil, fmt.Errorf(
 				"tx doesn't fit the replacement rule, oldPriority: %v, newPriority: %v, oldTx: %v, newTx: %v",
 				oldScore.priority,
 				priority,
@@ -273,7 +403,8 @@ func (mp *PriorityNonceMempool[C]) Insert(ctx context.Context, tx sdk.Tx) error
 			priority: oldScore.priority,
 			weight:   oldScore.weight,
 		})
-		mp.priorityCounts[oldScore.priority]--
+		mp.decrementPriorityCount(oldScore.priority)
+		mp.onRemove(sk, oldTx)
 	}
 
 	mp.priorityCounts[priority]++
@@ -284,8 +415,65 @@ func (mp *PriorityNonceMempool[C]) Insert(ctx context.Context, tx sdk.Tx) error
 
 	mp.scores[sk] = txMeta[C]{priority: priority}
 	mp.priorityIndex.Set(key, tx)
+	mp.modified()
 
-	return nil
+	if mp.cfg.TxEncoder != nil {
+		// A replaced transaction can no longer be looked up by its hash.
+		if oldHash, ok := mp.txHashes[sk]; ok {
+			delete(mp.hashes, oldHash)
+		}
+
+		mp.hashes[hash] = sk
+		mp.txHashes[sk] = hash
+		mp.txSizes[sk] = int64(len(txBytes))
+	}
+
+	if hasInfo {
+		mp.txInfos[sk] = txInfo
+	} else {
+		delete(mp.txInfos, sk)
+	}
+
+	if mp.hooks.OnInsert != nil {
+		mp.hooks.OnInsert(block.TxEvent{Tx: tx, Hash: hash, Size: int64(len(txBytes))})
+	}
+
+	return evicted, nil
+}
+
+// evictLowestPriority evicts the lowest priority transaction in the mempool, along
+// with the transactions of the same sender with a higher nonce, if the given key
+// has a higher priority. The transaction is not evicted if the given key is a later
+// nonce of the same sender, since the new transaction would depend on it.
+func (mp *PriorityNonceMempool[C]) evictLowestPriority(key txMeta[C]) ([]sdk.Tx, error) {
+	lowest := mp.priorityIndex.Back()
+	if lowest == nil {
+		return nil, sdkmempool.ErrMempoolTxMaxCapacity
+	}
+
+	lowestKey := lowest.Key().(txMeta[C])
+	if mp.cfg.TxPriority.Compare(key.priority, lowestKey.priority) <= 0 {
+		return nil, sdkmempool.ErrMempoolTxMaxCapacity
+	}
+
+	if key.sender == lowestKey.sender && key.nonce > lowestKey.nonce {
+		return nil, sdkmempool.ErrMempoolTxMaxCapacity
+	}
+
+	var evicted []sdk.Tx
+	for cursor := mp.senderIndices[lowestKey.sender].Front(); cursor != nil; cursor = cursor.Next() {
+		if cursor.Key().(txMeta[C]).nonce >= lowestKey.nonce {
+			evicted = append(evicted, cursor.Value.(sdk.Tx))
+		}
+	}
+
+	for _, tx := range evicted {
+		if err := mp.remove(tx); err != nil {
+			return nil, fmt.Errorf("failed to evict tx: %w", err)
+		}
+	}
+
+	return evicted, nil
 }
 
 func (i *PriorityNonceIterator[C]) iteratePriority() sdkmempool.Iterator {
@@ -302,22 +490,42 @@ func (i *PriorityNonceIterator[C]) iteratePriority() sdkmempool.Iterator {
 	}
 
 	i.sender = i.priorityNode.Key().(txMeta[C]).sender
+	i.setNextPriority()
 
+	return i.next()
+}
+
+// setNextPriority sets the priority of the priority node after the current one.
+func (i *PriorityNonceIterator[C]) setNextPriority() {
 	nextPriorityNode := i.priorityNode.Next()
 	if nextPriorityNode != nil {
 		i.nextPriority = nextPriorityNode.Key().(txMeta[C]).priority
 	} else {
 		i.nextPriority = i.mempool.cfg.TxPriority.MinValue
 	}
-
-	return i.Next()
 }
 
+// Next returns the iterator positioned at the next transaction, or nil once all
+// transactions have been returned.
 func (i *PriorityNonceIterator[C]) Next() sdkmempool.Iterator {
+	i.mempool.mtx.RLock()
+	defer i.mempool.mtx.RUnlock()
+
+	return i.next()
+}
+
+// next advances the iterator. The caller must hold the read lock.
+func (i *PriorityNonceIterator[C]) next() sdkmempool.Iterator {
 	if i.priorityNode == nil {
 		return nil
 	}
 
+	// The current priority node was removed, continue with the priority node that
+	// took its place.
+	if i.version != i.mempool.version && !i.resync() {
+		return i.iteratePriority()
+	}
+
 	cursor, ok := i.senderCursors[i.sender]
 	if !ok {
 		// beginning of sender iteration
@@ -350,34 +558,111 @@ func (i *PriorityNonceIterator[C]) Next() sdkmempool.Iterator {
 	}
 
 	i.senderCursors[i.sender] = cursor
+	i.tx = cursor.Value.(sdk.Tx)
+
 	return i
 }
 
+// Tx returns the transaction the iterator is positioned at.
 func (i *PriorityNonceIterator[C]) Tx() sdk.Tx {
-	return i.senderCursors[i.sender].Value.(sdk.Tx)
+	return i.tx
+}
+
+// resync re-resolves the elements the iterator points to once the mempool was
+// modified since its previous step, since removed elements are detached from the
+// indexes. The cursor of each sender is moved back to the last transaction of the
+// sender that precedes it, such that no transaction is returned twice. It returns
+// false if the current priority node was removed, in which case the priority node
+// is moved back to the one that precedes it. The caller must hold the read lock.
+func (i *PriorityNonceIterator[C]) resync() bool {
+	i.version = i.mempool.version
+
+	for sender, cursor := range i.senderCursors {
+		// The index of a sender without transactions is dropped. Its cursor is kept until
+		// the index is recreated, which bumps the version again, since only the priority
+		// node's sender is iterated.
+		senderIndex, ok := i.mempool.senderIndices[sender]
+		if !ok {
+			continue
+		}
+
+		if elem := precedingElement(senderIndex, cursor.Key()); elem != nil {
+			i.senderCursors[sender] = elem
+		} else {
+			delete(i.senderCursors, sender)
+		}
+	}
+
+	key := i.priorityNode.Key()
+	if elem := i.mempool.priorityIndex.Get(key); elem != nil {
+		i.priorityNode = elem
+		i.setNextPriority()
+
+		return true
+	}
+
+	i.priorityNode = precedingElement(i.mempool.priorityIndex, key)
+
+	return false
+}
+
+// precedingElement returns the element of the list with the given key or, if it
+// was removed, the element that precedes it. It returns nil if there is no such
+// element.
+func precedingElement(list *skiplist.SkipList, key any) *skiplist.Element {
+	if elem := list.Get(key); elem != nil {
+		return elem
+	}
+
+	if next := list.Find(key); next != nil {
+		return next.Prev()
+	}
+
+	return list.Back()
 }
 
 // Select returns a set of transactions from the mempool, ordered by priority
 // and sender-nonce in O(n) time. The passed in list of transactions are ignored.
-// This is a readonly operation, the mempool is not modified.
-//
-// The maxBytes parameter defines the maximum number of bytes of transactions to
-// return.
+// The iterator walks the mempool lazily, holding the read lock for each step, so
+// only the transactions the caller consumes are visited. Transactions that are
+// inserted or removed while iterating may or may not be returned, but no
+// transaction is returned twice.
 func (mp *PriorityNonceMempool[C]) Select(_ context.Context, _ [][]byte) sdkmempool.Iterator {
+	// Reordering priority ties updates the priority index, which requires the write
+	// lock. It is only needed if the mempool was modified since the previous Select.
+	mp.mtx.RLock()
+	if mp.tiesDirty {
+		mp.mtx.RUnlock()
+		mp.mtx.Lock()
+		if mp.tiesDirty {
+			mp.reorderPriorityTies()
+			mp.tiesDirty = false
+		}
+		mp.mtx.Unlock()
+		mp.mtx.RLock()
+	}
+	defer mp.mtx.RUnlock()
+
 	if mp.priorityIndex.Len() == 0 {
 		return nil
 	}
 
-	mp.reorderPriorityTies()
-
 	iterator := &PriorityNonceIterator[C]{
 		mempool:       mp,
 		senderCursors: make(map[string]*skiplist.Element),
+		version:       mp.version,
 	}
 
 	return iterator.iteratePriority()
 }
 
+// modified records that the indexes of the mempool were modified. The caller must
+// hold the lock.
+func (mp *PriorityNonceMempool[C]) modified() {
+	mp.version++
+	mp.tiesDirty = true
+}
+
 type reorderKey[C comparable] struct {
 	deleteKey txMeta[C]
 	insertKey txMeta[C]
@@ -399,6 +684,10 @@ func (mp *PriorityNonceMempool[C]) reorderPriorityTies() {
 		node = node.Next()
 	}
 
+	if len(reordering) > 0 {
+		mp.version++
+	}
+
 	for _, k := range reordering {
 		mp.priorityIndex.Remove(k.deleteKey)
 		delete(mp.scores, txMeta[C]{nonce: k.deleteKey.nonce, sender: k.deleteKey.sender})
@@ -432,29 +721,49 @@ func senderWeight[C comparable](txPriority TxPriority[C], senderCursor *skiplist
 
 // CountTx returns the number of transactions in the mempool.
 func (mp *PriorityNonceMempool[C]) CountTx() int {
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+
 	return mp.priorityIndex.Len()
 }
 
+// CountSenders returns the number of senders with transactions in the mempool. Each
+// unordered transaction counts as its own sender (see txKey).
+func (mp *PriorityNonceMempool[C]) CountSenders() int {
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+
+	return len(mp.senderIndices)
+}
+
 // Remove removes a transaction from the mempool in O(log n) time, returning an
-// error if unsuccessful.
+// error if unsuccessful. A different transaction with the same sender and nonce is
+// not removed (see Contains).
 func (mp *PriorityNonceMempool[C]) Remove(tx sdk.Tx) error {
-	signers, err := mp.signerExtractor.GetSigners(tx)
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	return mp.remove(tx)
+}
+
+// remove removes a transaction from the mempool. The caller must hold the lock.
+func (mp *PriorityNonceMempool[C]) remove(tx sdk.Tx) error {
+	sender, nonce, err := mp.txKey(tx)
 	if err != nil {
 		return err
 	}
-	if len(signers) == 0 {
-		return fmt.Errorf("attempted to remove a tx with no signatures")
-	}
-
-	sig := signers[0]
-	sender := sig.Signer.String()
-	nonce := sig.Sequence
 
 	scoreKey := txMeta[C]{nonce: nonce, sender: sender}
 	score, ok := mp.scores[scoreKey]
 	if !ok {
 		return sdkmempool.ErrTxNotFound
 	}
+
+	stored, ok := mp.storedTx(scoreKey, tx)
+	if !ok {
+		return sdkmempool.ErrTxNotFound
+	}
+
 	tk := txMeta[C]{nonce: nonce, priority: score.priority, sender: sender, weight: score.weight}
 
 	senderTxs, ok := mp.senderIndices[sender]
@@ -465,31 +774,268 @@ func (mp *PriorityNonceMempool[C]) Remove(tx sdk.Tx) error {
 	mp.priorityIndex.Remove(tk)
 	senderTxs.Remove(tk)
 	delete(mp.scores, scoreKey)
-	mp.priorityCounts[score.priority]--
+	mp.decrementPriorityCount(score.priority)
+	mp.modified()
+	mp.onRemove(scoreKey, stored)
+
+	// Each unordered transaction is indexed as the only transaction of its own sender
+	// (see txKey), so empty sender indexes must be dropped for the mempool not to grow
+	// with every transaction it has seen.
+	if senderTxs.Len() == 0 {
+		delete(mp.senderIndices, sender)
+	}
+
+	if hash, ok := mp.txHashes[scoreKey]; ok {
+		delete(mp.hashes, hash)
+		delete(mp.txHashes, scoreKey)
+		delete(mp.txSizes, scoreKey)
+	}
+
+	delete(mp.txInfos, scoreKey)
 
 	return nil
 }
 
-// Contains returns true if the transaction is in the mempool.
+// decrementPriorityCount decrements the number of transactions with the given priority,
+// dropping the count once no transaction has the priority. The caller must hold the lock.
+func (mp *PriorityNonceMempool[C]) decrementPriorityCount(priority C) {
+	if mp.priorityCounts[priority] <= 1 {
+		delete(mp.priorityCounts, priority)
+		return
+	}
+
+	mp.priorityCounts[priority]--
+}
+
+// Contains returns true if the transaction is in the mempool. A different
+// transaction with the same sender and nonce does not match: unless it is the
+// same instance, the transaction is encoded to compare its hash with the hash of
+// the stored transaction if the config has a TxEncoder. Use Lookup to find the
+// transaction with the same sender and nonce.
 func (mp *PriorityNonceMempool[C]) Contains(tx sdk.Tx) bool {
-	signers, err := mp.signerExtractor.GetSigners(tx)
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+
+	sender, nonce, err := mp.txKey(tx)
 	if err != nil {
 		return false
 	}
-	if len(signers) == 0 {
+
+	_, ok := mp.storedTx(txMeta[C]{nonce: nonce, sender: sender}, tx)
+	return ok
+}
+
+// storedTx returns the transaction stored with the given sender and nonce if it is the
+// same transaction as the given one, i.e. the same instance or a transaction with the
+// same hash. Without a TxEncoder, transactions can only be compared by sender and nonce.
+// Unordered transactions are keyed by their hash already. The caller must hold the lock.
+func (mp *PriorityNonceMempool[C]) storedTx(sk txMeta[C], tx sdk.Tx) (sdk.Tx, bool) {
+	senderIndex, ok := mp.senderIndices[sk.sender]
+	if !ok {
+		return nil, false
+	}
+
+	element := senderIndex.Get(sk)
+	if element == nil {
+		return nil, false
+	}
+
+	stored := element.Value.(sdk.Tx)
+	if sameTx(stored, tx) || mp.cfg.TxEncoder == nil || strings.HasPrefix(sk.sender, utils.UnorderedSenderPrefix) {
+		return stored, true
+	}
+
+	storedHash, ok := mp.txHashes[sk]
+	if !ok {
+		return stored, true
+	}
+
+	hash, err := utils.GetTxHash(mp.cfg.TxEncoder, tx)
+	if err != nil || hash != storedHash {
+		return nil, false
+	}
+
+	return stored, true
+}
+
+// SetTxHooks sets the hooks that are called with the transactions inserted into and
+// removed from the mempool, including the transactions that are replaced or evicted.
+// The hooks are called while the mempool is locked. Transactions are only reported
+// if the config has a TxEncoder, since they are reported along with their hash.
+func (mp *PriorityNonceMempool[C]) SetTxHooks(hooks block.TxHooks) bool {
+	if mp.cfg.TxEncoder == nil {
 		return false
 	}
 
-	sig := signers[0]
-	nonce := sig.Sequence
-	sender := sig.Signer.String()
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
 
-	_, ok := mp.scores[txMeta[C]{nonce: nonce, sender: sender}]
-	return ok
+	mp.hooks = hooks
+
+	return true
+}
+
+// onRemove reports the removal of the transaction stored with the given sender and
+// nonce (see SetTxHooks). It must be called before the transaction's hash is removed
+// from the index. The caller must hold the lock.
+func (mp *PriorityNonceMempool[C]) onRemove(sk txMeta[C], tx sdk.Tx) {
+	if mp.hooks.OnRemove == nil {
+		return
+	}
+
+	if hash, ok := mp.txHashes[sk]; ok {
+		mp.hooks.OnRemove(block.TxEvent{Tx: tx, Hash: hash, Size: mp.txSizes[sk]})
+	}
+}
+
+// Lookup returns the transaction in the mempool with the same sender and nonce as
+// the given transaction, if any.
+func (mp *PriorityNonceMempool[C]) Lookup(tx sdk.Tx) (sdk.Tx, bool) {
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+
+	sender, nonce, err := mp.txKey(tx)
+	if err != nil {
+		return nil, false
+	}
+
+	senderIndex, ok := mp.senderIndices[sender]
+	if !ok {
+		return nil, false
+	}
+
+	element := senderIndex.Get(txMeta[C]{nonce: nonce, sender: sender})
+	if element == nil {
+		return nil, false
+	}
+
+	return element.Value.(sdk.Tx), true
+}
+
+// LookupHash returns the transaction in the mempool with the given hash, if any.
+// Transactions are only indexed by hash if the config has a TxEncoder.
+func (mp *PriorityNonceMempool[C]) LookupHash(hash string) (sdk.Tx, bool) {
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+
+	sk, ok := mp.hashes[hash]
+	if !ok {
+		return nil, false
+	}
+
+	element := mp.senderIndices[sk.sender].Get(sk)
+	if element == nil {
+		return nil, false
+	}
+
+	return element.Value.(sdk.Tx), true
+}
+
+// TxInfo returns the information of the transaction computed when it was inserted
+// (i.e. with the priority it was inserted with), if the transaction is in the mempool.
+// A different transaction with the same sender and nonce does not match. The
+// information is only stored if the config has a TxEncoder.
+func (mp *PriorityNonceMempool[C]) TxInfo(tx sdk.Tx) (utils.TxWithInfo, bool) {
+	sender, nonce, err := mp.txKey(tx)
+	if err != nil {
+		return utils.TxWithInfo{}, false
+	}
+
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+
+	sk := txMeta[C]{nonce: nonce, sender: sender}
+	txInfo, ok := mp.txInfos[sk]
+	if !ok {
+		return utils.TxWithInfo{}, false
+	}
+
+	element := mp.senderIndices[sender].Get(sk)
+	if element == nil || !sameTx(element.Value.(sdk.Tx), tx) {
+		return utils.TxWithInfo{}, false
+	}
+
+	return txInfo, true
+}
+
+// SenderTxs returns the transactions of the given sender in the mempool, ordered
+// by nonce. Unordered transactions are not included.
+func (mp *PriorityNonceMempool[C]) SenderTxs(sender string) []sdk.Tx {
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+
+	senderIndex, ok := mp.senderIndices[sender]
+	if !ok {
+		return nil
+	}
+
+	txs := make([]sdk.Tx, 0, senderIndex.Len())
+	for element := senderIndex.Front(); element != nil; element = element.Next() {
+		txs = append(txs, element.Value.(sdk.Tx))
+	}
+
+	return txs
+}
+
+// LowestPriorityTxs returns up to limit transactions from the lowest to the highest
+// priority, after skipping the offset lowest priority transactions.
+func (mp *PriorityNonceMempool[C]) LowestPriorityTxs(offset, limit int) []sdk.Tx {
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+
+	element := mp.priorityIndex.Back()
+	for i := 0; i < offset && element != nil; i++ {
+		element = element.Prev()
+	}
+
+	var txs []sdk.Tx
+	for ; len(txs) < limit && element != nil; element = element.Prev() {
+		txs = append(txs, element.Value.(sdk.Tx))
+	}
+
+	return txs
+}
+
+// txKey returns the sender and nonce that identify the transaction in the mempool,
+// i.e. its first signer and sequence number. Unordered transactions do not have a
+// sequence number, so each one is indexed as the only transaction of a sender
+// derived from its hash. Unordered transactions are therefore only ordered by
+// priority and any number of them can coexist with the signer's transactions.
+func (mp *PriorityNonceMempool[C]) txKey(tx sdk.Tx) (string, uint64, error) {
+	signers, err := mp.signerExtractor.GetSigners(tx)
+	if err != nil {
+		return "", 0, err
+	}
+
+	return mp.signersKey(tx, signers, nil)
+}
+
+// signersKey returns the sender and nonce that identify the transaction with the
+// given signers (see txKey and utils.SenderKey). The encoded transaction is only needed
+// for unordered transactions; it is encoded if txBytes is nil.
+func (mp *PriorityNonceMempool[C]) signersKey(
+	tx sdk.Tx,
+	signers []signer_extraction.SignerData,
+	txBytes []byte,
+) (string, uint64, error) {
+	return utils.SenderKey(mp.cfg.TxEncoder, tx, signers, txBytes)
+}
+
+// sameTx returns true if both transactions are the same instance, i.e. the same
+// pointer. Transactions that are not pointers are never the same.
+func sameTx(a, b sdk.Tx) bool {
+	if a == nil || b == nil || reflect.TypeOf(a).Kind() != reflect.Ptr {
+		return false
+	}
+
+	return a == b
 }
 
 func IsEmpty[C comparable](mempool sdkmempool.Mempool) error {
 	mp := mempool.(*PriorityNonceMempool[C])
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+
 	if mp.priorityIndex.Len() != 0 {
 		return fmt.Errorf("priorityIndex not empty")
 	}
@@ -4,8 +4,21 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 
 	"github.com/skip-mev/block-sdk/v2/block/proposals"
+	"github.com/skip-mev/block-sdk/v2/block/utils"
+)
+
+const (
+	// RejectedTxEvent is the name of the span event recorded for each transaction that is not
+	// selected by the DefaultProposalHandler's PrepareLaneHandler.
+	RejectedTxEvent = "tx rejected"
+
+	// PreviousTxNotIncludedReason is the reason recorded for transactions that are not selected
+	// because a previous transaction of their sender was not selected for the proposal.
+	PreviousTxNotIncludedReason = "previous tx of the sender was not included"
 )
 
 // DefaultProposalHandler returns a default implementation of the PrepareLaneHandler and
@@ -25,6 +38,14 @@ func NewDefaultProposalHandler(lane *BaseLane) *DefaultProposalHandler {
 // selects all transactions in the mempool that are valid and not already in the partial
 // proposal. It will continue to reap transactions until the maximum blockspace/gas for this
 // lane has been reached. Additionally, any transactions that are invalid will be returned.
+//
+// Once a transaction of a sender is not selected (e.g. it fails verification or does not fit),
+// the sender's next transactions are skipped for this proposal since their sequence numbers
+// would no longer match. They are kept in the mempool; only the transaction that failed is
+// removed.
+//
+// A transaction that panics while its information is computed or while it is verified is
+// removed as well, without affecting the other transactions of the lane (see RecoverTxPanic).
 func (h *DefaultProposalHandler) PrepareLaneHandler() PrepareLaneHandler {
 	return func(ctx sdk.Context, proposal proposals.Proposal, limit proposals.LaneLimits) ([]sdk.Tx, []sdk.Tx, error) {
 		var (
@@ -32,21 +53,53 @@ func (h *DefaultProposalHandler) PrepareLaneHandler() PrepareLaneHandler {
 			totalGas     uint64
 			txsToInclude []sdk.Tx
 			txsToRemove  []sdk.Tx
+
+			// skippedSenders are the senders whose remaining transactions cannot be selected.
+			skippedSenders = make(map[string]struct{})
 		)
 
 		// Select all transactions in the mempool that are valid and not already in the
 		// partial proposal.
 		for iterator := h.lane.Select(ctx, nil); iterator != nil; iterator = iterator.Next() {
+			// If the lane has reached its maximum number of transactions, we stop selecting.
+			if limit.MaxTxs > 0 && uint64(len(txsToInclude)) >= limit.MaxTxs {
+				h.lane.Logger().Info(
+					"lane reached the maximum number of transactions allowed",
+					"lane", h.lane.Name(),
+					"max_txs", limit.MaxTxs,
+				)
+
+				break
+			}
+
 			tx := iterator.Tx()
 
-			txInfo, err := h.lane.GetTxInfo(ctx, tx)
+			// A transaction that panics is removed instead of failing the whole lane.
+			var txInfo utils.TxWithInfo
+			err := h.lane.RecoverTxPanic(ctx, tx, func() (err error) {
+				txInfo, err = h.lane.GetTxInfo(ctx, tx)
+				return err
+			})
 			if err != nil {
 				h.lane.Logger().Info("failed to get hash of tx", "err", err)
+				h.traceRejectedTx(ctx, "", fmt.Sprintf("failed to get tx info: %s", err), true)
 
 				txsToRemove = append(txsToRemove, tx)
 				continue
 			}
 
+			sender := txSender(txInfo)
+			if _, ok := skippedSenders[sender]; ok {
+				h.lane.Logger().Info(
+					"failed to select tx for lane; previous tx of the sender was not included",
+					"tx_hash", txInfo.Hash,
+					"lane", h.lane.Name(),
+				)
+				h.traceRejectedTx(ctx, txInfo.Hash, PreviousTxNotIncludedReason, false)
+
+				continue
+			}
+
 			if txInfo.GasLimit > limit.MaxGasLimit {
 				h.lane.Logger().Info(
 					"failed to select tx for lane; gas limit above the maximum allowed",
@@ -55,7 +108,9 @@ func (h *DefaultProposalHandler) PrepareLaneHandler() PrepareLaneHandler {
 					"max_gas", limit.MaxGasLimit,
 					"tx_hash", txInfo.Hash,
 				)
+				h.traceRejectedTx(ctx, txInfo.Hash, "gas limit above the maximum allowed", true)
 
+				skippedSenders[sender] = struct{}{}
 				txsToRemove = append(txsToRemove, tx)
 				continue
 			}
@@ -68,19 +123,42 @@ func (h *DefaultProposalHandler) PrepareLaneHandler() PrepareLaneHandler {
 					"max_tx_bytes", limit.MaxTxBytes,
 					"tx_hash", txInfo.Hash,
 				)
+				h.traceRejectedTx(ctx, txInfo.Hash, "tx bytes above the maximum allowed", true)
 
+				skippedSenders[sender] = struct{}{}
 				txsToRemove = append(txsToRemove, tx)
 				continue
 			}
 
 			// Double check that the transaction belongs to this lane.
-			if !h.lane.Match(ctx, tx) {
+			var matches bool
+			err = h.lane.RecoverTxPanic(ctx, tx, func() error {
+				matches = h.lane.Match(ctx, tx)
+				return nil
+			})
+			if err != nil {
+				h.lane.Logger().Info(
+					"failed to select tx for lane; failed to match tx",
+					"tx_hash", txInfo.Hash,
+					"lane", h.lane.Name(),
+					"err", err,
+				)
+				h.traceRejectedTx(ctx, txInfo.Hash, fmt.Sprintf("failed to match tx: %s", err), true)
+
+				skippedSenders[sender] = struct{}{}
+				txsToRemove = append(txsToRemove, tx)
+				continue
+			}
+
+			if !matches {
 				h.lane.Logger().Info(
 					"failed to select tx for lane; tx does not belong to lane",
 					"tx_hash", txInfo.Hash,
 					"lane", h.lane.Name(),
 				)
+				h.traceRejectedTx(ctx, txInfo.Hash, "tx does not belong to lane", true)
 
+				skippedSenders[sender] = struct{}{}
 				txsToRemove = append(txsToRemove, tx)
 				continue
 			}
@@ -92,6 +170,7 @@ func (h *DefaultProposalHandler) PrepareLaneHandler() PrepareLaneHandler {
 					"tx_hash", txInfo.Hash,
 					"lane", h.lane.Name(),
 				)
+				h.traceRejectedTx(ctx, txInfo.Hash, "tx is already in proposal", false)
 
 				continue
 			}
@@ -106,8 +185,10 @@ func (h *DefaultProposalHandler) PrepareLaneHandler() PrepareLaneHandler {
 					"max_tx_bytes", limit.MaxTxBytes,
 					"tx_hash", txInfo.Hash,
 				)
+				h.traceRejectedTx(ctx, txInfo.Hash, "lane bytes limit reached", false)
 
 				// TODO: Determine if there is any trade off with breaking or continuing here.
+				skippedSenders[sender] = struct{}{}
 				continue
 			}
 
@@ -121,20 +202,30 @@ func (h *DefaultProposalHandler) PrepareLaneHandler() PrepareLaneHandler {
 					"max_gas", limit.MaxGasLimit,
 					"tx_hash", txInfo.Hash,
 				)
+				h.traceRejectedTx(ctx, txInfo.Hash, "lane gas limit reached", false)
 
 				// TODO: Determine if there is any trade off with breaking or continuing here.
+				skippedSenders[sender] = struct{}{}
 				continue
 			}
 
 			// Verify the transaction.
-			if err = h.lane.VerifyTx(ctx, tx, false); err != nil {
+			err = h.lane.RecoverTxPanic(ctx, tx, func() error {
+				return h.lane.VerifyAndExecuteTx(ctx, tx)
+			})
+			if err != nil {
+				remove := h.lane.removeOnVerifyError(txInfo.Hash, err)
 				h.lane.Logger().Info(
 					"failed to verify tx",
 					"tx_hash", txInfo.Hash,
 					"err", err,
 				)
+				h.traceRejectedTx(ctx, txInfo.Hash, fmt.Sprintf("failed to verify tx: %s", err), remove)
 
-				txsToRemove = append(txsToRemove, tx)
+				skippedSenders[sender] = struct{}{}
+				if remove {
+					txsToRemove = append(txsToRemove, tx)
+				}
 				continue
 			}
 
@@ -147,6 +238,26 @@ func (h *DefaultProposalHandler) PrepareLaneHandler() PrepareLaneHandler {
 	}
 }
 
+// traceRejectedTx records a transaction that was not selected for the proposal, and the reason
+// why, as an event of the lane's span (if any). Removed indicates whether the transaction is
+// removed from the lane's mempool.
+func (h *DefaultProposalHandler) traceRejectedTx(ctx sdk.Context, txHash, reason string, removed bool) {
+	span := trace.SpanFromContext(ctx.Context())
+	if !span.IsRecording() {
+		return
+	}
+
+	span.AddEvent(
+		RejectedTxEvent,
+		trace.WithAttributes(
+			attribute.String("lane", h.lane.Name()),
+			attribute.String("tx_hash", txHash),
+			attribute.String("reason", reason),
+			attribute.Bool("removed", removed),
+		),
+	)
+}
+
 // DefaultProcessLaneHandler returns a default implementation of the ProcessLaneHandler. It verifies
 // the following invariants:
 //  1. Transactions belonging to the lane must be contiguous from the beginning of the partial proposal.
@@ -165,7 +276,11 @@ func (h *DefaultProposalHandler) ProcessLaneHandler() ProcessLaneHandler {
 				// iff there are no matches in the remaining transactions after this index.
 				if index+1 < len(partialProposal) {
 					if err := h.lane.VerifyNoMatches(ctx, partialProposal[index+1:]); err != nil {
-						return nil, nil, fmt.Errorf("failed to verify no matches: %w", err)
+						return nil, nil, proposals.OffsetInvariantError(
+							h.lane.Name(),
+							index+1,
+							fmt.Errorf("failed to verify no matches: %w", err),
+						)
 					}
 				}
 
@@ -176,12 +291,22 @@ func (h *DefaultProposalHandler) ProcessLaneHandler() ProcessLaneHandler {
 			// to be invalid
 			if index > 0 {
 				if v, err := h.lane.Compare(ctx, partialProposal[index-1], tx); v == -1 || err != nil {
-					return nil, nil, fmt.Errorf("transaction at index %d has a higher priority than %d", index, index-1)
+					return nil, nil, proposals.NewInvariantError(
+						h.lane.Name(),
+						index,
+						proposals.InvariantOrdering,
+						fmt.Errorf("transaction at index %d has a higher priority than %d", index, index-1),
+					)
 				}
 			}
 
 			if err := h.lane.VerifyTx(ctx, tx, false); err != nil {
-				return nil, nil, fmt.Errorf("failed to verify tx: %w", err)
+				return nil, nil, proposals.NewInvariantError(
+					h.lane.Name(),
+					index,
+					proposals.InvariantVerifyTx,
+					fmt.Errorf("failed to verify tx: %w", err),
+				)
 			}
 		}
 
@@ -190,3 +315,47 @@ func (h *DefaultProposalHandler) ProcessLaneHandler() ProcessLaneHandler {
 		return partialProposal, nil, nil
 	}
 }
+
+// ProcessLaneBasicHandler returns a default implementation of the ProcessLaneBasicHandler. It
+// verifies the same invariants as the ProcessLaneHandler except for the verification logic of
+// the lane (VerifyTx):
+//  1. All transactions in the partial proposal must belong to the lane.
+//  2. None of the remaining transactions may belong to the lane.
+//  3. Transactions must be ordered respecting the priority defined by the lane (e.g. gas price).
+func (h *DefaultProposalHandler) ProcessLaneBasicHandler() ProcessLaneBasicHandler {
+	return func(ctx sdk.Context, partialProposal []sdk.Tx, remainingTxs []sdk.Tx) error {
+		for index, tx := range partialProposal {
+			if !h.lane.Match(ctx, tx) {
+				return proposals.NewInvariantError(
+					h.lane.Name(),
+					index,
+					proposals.InvariantMatch,
+					fmt.Errorf("transaction at index %d does not belong to lane %s", index, h.lane.Name()),
+				)
+			}
+
+			// If the transactions do not respect the priority defined by the mempool, we consider the proposal
+			// to be invalid
+			if index > 0 {
+				if v, err := h.lane.Compare(ctx, partialProposal[index-1], tx); v == -1 || err != nil {
+					return proposals.NewInvariantError(
+						h.lane.Name(),
+						index,
+						proposals.InvariantOrdering,
+						fmt.Errorf("transaction at index %d has a higher priority than %d", index, index-1),
+					)
+				}
+			}
+		}
+
+		if err := h.lane.VerifyNoMatches(ctx, remainingTxs); err != nil {
+			return proposals.OffsetInvariantError(
+				h.lane.Name(),
+				len(partialProposal),
+				fmt.Errorf("failed to verify no matches: %w", err),
+			)
+		}
+
+		return nil
+	}
+}
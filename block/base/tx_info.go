@@ -1,25 +1,53 @@
 package base
 
 import (
-	"encoding/hex"
 	"fmt"
-	"strings"
 
-	comettypes "github.com/cometbft/cometbft/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/skip-mev/block-sdk/v2/block/utils"
 )
 
+// TxInfoCache defines the interface of a cache of transaction information, such
+// that the information of a transaction does not have to be computed (i.e. the
+// transaction encoded) every time a proposal is built or verified. The lane's mempool
+// is used as a cache if it implements this interface (see Mempool.TxInfo).
+type TxInfoCache interface {
+	// TxInfo returns the cached information of the transaction, if any.
+	TxInfo(tx sdk.Tx) (utils.TxWithInfo, bool)
+}
+
 // GetTxInfo returns various information about the transaction that
 // belongs to the lane including its priority, signer's, sequence number,
 // size and more.
+//
+// NOTE: The information is first looked up in the lane's mempool and then in the
+// lane's tx info cache (see WithTxInfoCache). The priority of a cached transaction
+// is the priority it had when it was inserted into the mempool.
 func (l *BaseLane) GetTxInfo(ctx sdk.Context, tx sdk.Tx) (utils.TxWithInfo, error) {
+	if cache, ok := l.LaneMempool.(TxInfoCache); ok {
+		if txInfo, found := cache.TxInfo(tx); found {
+			return txInfo, nil
+		}
+	}
+
+	if l.txInfoCache != nil {
+		if txInfo, found := l.txInfoCache.TxInfo(tx); found {
+			return txInfo, nil
+		}
+	}
+
 	txBytes, err := l.cfg.TxEncoder(tx)
 	if err != nil {
 		return utils.TxWithInfo{}, fmt.Errorf("failed to encode transaction: %w", err)
 	}
 
+	return l.GetTxInfoFromBytes(ctx, tx, txBytes)
+}
+
+// GetTxInfoFromBytes returns the information about the transaction (see GetTxInfo)
+// given its encoded bytes, which avoids encoding the transaction again.
+func (l *BaseLane) GetTxInfoFromBytes(ctx sdk.Context, tx sdk.Tx, txBytes []byte) (utils.TxWithInfo, error) {
 	// TODO: Add an adapter to lanes so that this can be flexible to support EVM, etc.
 	gasTx, ok := tx.(sdk.FeeTx)
 	if !ok {
@@ -32,7 +60,7 @@ func (l *BaseLane) GetTxInfo(ctx sdk.Context, tx sdk.Tx) (utils.TxWithInfo, erro
 	}
 
 	return utils.TxWithInfo{
-		Hash:     strings.ToUpper(hex.EncodeToString(comettypes.Tx(txBytes).Hash())),
+		Hash:     utils.TxHash(txBytes),
 		Size:     int64(len(txBytes)),
 		GasLimit: gasTx.GetGas(),
 		TxBytes:  txBytes,
@@ -2,10 +2,17 @@ package base
 
 import (
 	"context"
+	"strings"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// DenomConverter returns the price of one unit of the given fee denom in the reference
+// denom that fees are compared in, e.g. 1 for the chain's native denom. Fees paid in
+// denoms for which false is returned are ignored.
+type DenomConverter func(ctx context.Context, denom string) (math.LegacyDec, bool)
+
 // DefaultTxPriority
 func DefaultTxPriority() TxPriority[int] {
 	return TxPriority[int]{
@@ -18,3 +25,125 @@ func DefaultTxPriority() TxPriority[int] {
 		MinValue: 0,
 	}
 }
+
+// SingleDenomConverter returns a DenomConverter that only accepts fees paid in the given
+// denom, which is used as the reference denom.
+func SingleDenomConverter(denom string) DenomConverter {
+	return func(_ context.Context, feeDenom string) (math.LegacyDec, bool) {
+		if feeDenom != denom {
+			return math.LegacyDec{}, false
+		}
+
+		return math.LegacyOneDec(), true
+	}
+}
+
+// StaticDenomConverter returns a DenomConverter that converts fees with the given fixed
+// prices, by denom, in the reference denom. The reference denom itself should have a
+// price of 1.
+func StaticDenomConverter(prices map[string]math.LegacyDec) DenomConverter {
+	return func(_ context.Context, denom string) (math.LegacyDec, bool) {
+		price, ok := prices[denom]
+		if !ok || price.IsNil() || !price.IsPositive() {
+			return math.LegacyDec{}, false
+		}
+
+		return price, true
+	}
+}
+
+// GasPriceTxPriority returns a TxPriority that orders transactions by their gas price,
+// i.e. their fee (converted to the reference denom) per unit of gas. Transactions that
+// are not fee transactions or that have no gas limit have the lowest priority.
+func GasPriceTxPriority(converter DenomConverter) TxPriority[string] {
+	return feeTxPriority(func(ctx context.Context, feeTx sdk.FeeTx) (math.LegacyDec, bool) {
+		return gasPrice(ctx, feeTx, converter)
+	})
+}
+
+// TotalFeeTxPriority returns a TxPriority that orders transactions by their total fee,
+// converted to the reference denom, regardless of their gas limit. Transactions that
+// are not fee transactions have the lowest priority.
+func TotalFeeTxPriority(converter DenomConverter) TxPriority[string] {
+	return feeTxPriority(func(ctx context.Context, feeTx sdk.FeeTx) (math.LegacyDec, bool) {
+		return feeValue(ctx, feeTx.GetFee(), converter), true
+	})
+}
+
+// TipTxPriority returns a TxPriority that orders transactions by their tip, i.e. the
+// amount by which their gas price (see GasPriceTxPriority) exceeds the lane's base gas
+// price, in the reference denom. Transactions that pay less than the base gas price have
+// the lowest priority, below transactions that pay exactly the base gas price.
+func TipTxPriority(baseGasPrice math.LegacyDec, converter DenomConverter) TxPriority[string] {
+	return feeTxPriority(func(ctx context.Context, feeTx sdk.FeeTx) (math.LegacyDec, bool) {
+		price, ok := gasPrice(ctx, feeTx, converter)
+		if !ok || price.LT(baseGasPrice) {
+			return math.LegacyDec{}, false
+		}
+
+		return price.Sub(baseGasPrice), true
+	})
+}
+
+// feeTxPriority returns a TxPriority over the (non-negative) fee value of transactions.
+// Priorities are the decimal representation of the values, which are compared without
+// being parsed (see compareDecStrings). The empty priority is the lowest priority.
+func feeTxPriority(value func(ctx context.Context, feeTx sdk.FeeTx) (math.LegacyDec, bool)) TxPriority[string] {
+	return TxPriority[string]{
+		GetTxPriority: func(ctx context.Context, tx sdk.Tx) string {
+			feeTx, ok := tx.(sdk.FeeTx)
+			if !ok {
+				return ""
+			}
+
+			v, ok := value(ctx, feeTx)
+			if !ok || v.IsNil() || v.IsNegative() {
+				return ""
+			}
+
+			return v.String()
+		},
+		Compare:  compareDecStrings,
+		MinValue: "",
+	}
+}
+
+// gasPrice returns the fee of the transaction, in the reference denom, per unit of gas.
+func gasPrice(ctx context.Context, feeTx sdk.FeeTx, converter DenomConverter) (math.LegacyDec, bool) {
+	gas := feeTx.GetGas()
+	if gas == 0 {
+		return math.LegacyDec{}, false
+	}
+
+	return feeValue(ctx, feeTx.GetFee(), converter).QuoInt(math.NewIntFromUint64(gas)), true
+}
+
+// feeValue returns the value of the fee in the reference denom.
+func feeValue(ctx context.Context, fee sdk.Coins, converter DenomConverter) math.LegacyDec {
+	value := math.LegacyZeroDec()
+	for _, coin := range fee {
+		price, ok := converter(ctx, coin.Denom)
+		if !ok {
+			continue
+		}
+
+		value = value.Add(price.MulInt(coin.Amount))
+	}
+
+	return value
+}
+
+// compareDecStrings compares the string representations of two non-negative decimals.
+// Since decimals are formatted with a fixed number of decimal places and without leading
+// zeros, a longer representation is a larger decimal and representations of the same
+// length compare lexicographically. The empty string is lower than any decimal.
+func compareDecStrings(a, b string) int {
+	switch {
+	case len(a) < len(b):
+		return -1
+	case len(a) > len(b):
+		return 1
+	default:
+		return strings.Compare(a, b)
+	}
+}
@@ -9,6 +9,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
+	signerextraction "github.com/skip-mev/block-sdk/v2/adapters/signer_extraction_adapter"
 	"github.com/skip-mev/block-sdk/v2/block/base"
 	"github.com/skip-mev/block-sdk/v2/testutils"
 )
@@ -40,3 +41,147 @@ func TestNopTxPriority(t *testing.T) {
 		require.Equal(t, priority1, priority2)
 	})
 }
+
+func TestFeeTxPriorities(t *testing.T) {
+	txc := testutils.CreateTestEncodingConfig().TxConfig
+	accounts := testutils.RandomAccounts(rand.New(rand.NewSource(1)), 1)
+
+	// 1 atom is worth 2 stake; osmo fees are not accepted.
+	converter := base.StaticDenomConverter(map[string]math.LegacyDec{
+		"stake": math.LegacyOneDec(),
+		"atom":  math.LegacyNewDec(2),
+	})
+
+	// fee: 100stake + 50atom = 200 stake, gas limit: 100 => gas price: 2 stake.
+	tx, err := testutils.CreateRandomTx(
+		txc,
+		accounts[0],
+		0,
+		1,
+		0,
+		100,
+		sdk.NewCoin("stake", math.NewInt(100)),
+		sdk.NewCoin("atom", math.NewInt(50)),
+		sdk.NewCoin("osmo", math.NewInt(1000)),
+	)
+	require.NoError(t, err)
+
+	// A tx without a gas limit has no gas price.
+	noGasTx, err := testutils.CreateRandomTx(txc, accounts[0], 0, 1, 0, 0, sdk.NewCoin("stake", math.NewInt(100)))
+	require.NoError(t, err)
+
+	t.Run("gas price", func(t *testing.T) {
+		txp := base.GasPriceTxPriority(converter)
+
+		require.Equal(t, math.LegacyNewDec(2).String(), txp.GetTxPriority(context.Background(), tx))
+		require.Equal(t, txp.MinValue, txp.GetTxPriority(context.Background(), noGasTx))
+	})
+
+	t.Run("total fee", func(t *testing.T) {
+		txp := base.TotalFeeTxPriority(converter)
+
+		require.Equal(t, math.LegacyNewDec(200).String(), txp.GetTxPriority(context.Background(), tx))
+		require.Equal(t, math.LegacyNewDec(100).String(), txp.GetTxPriority(context.Background(), noGasTx))
+	})
+
+	t.Run("tip", func(t *testing.T) {
+		txp := base.TipTxPriority(math.LegacyMustNewDecFromStr("1.5"), converter)
+		require.Equal(t, math.LegacyMustNewDecFromStr("0.5").String(), txp.GetTxPriority(context.Background(), tx))
+
+		txp = base.TipTxPriority(math.LegacyNewDec(2), converter)
+		require.Equal(t, math.LegacyZeroDec().String(), txp.GetTxPriority(context.Background(), tx))
+
+		// Txs below the base gas price have a lower priority than txs that pay exactly
+		// the base gas price.
+		txp = base.TipTxPriority(math.LegacyNewDec(3), converter)
+		require.Equal(t, txp.MinValue, txp.GetTxPriority(context.Background(), tx))
+		require.Equal(t, -1, txp.Compare(txp.MinValue, math.LegacyZeroDec().String()))
+	})
+
+	t.Run("single denom", func(t *testing.T) {
+		txp := base.TotalFeeTxPriority(base.SingleDenomConverter("atom"))
+		require.Equal(t, math.LegacyNewDec(50).String(), txp.GetTxPriority(context.Background(), tx))
+	})
+
+	t.Run("priorities compare numerically", func(t *testing.T) {
+		txp := base.GasPriceTxPriority(converter)
+
+		values := []math.LegacyDec{
+			math.LegacyZeroDec(),
+			math.LegacyMustNewDecFromStr("0.000000000000000001"),
+			math.LegacyMustNewDecFromStr("0.9"),
+			math.LegacyNewDec(2),
+			math.LegacyMustNewDecFromStr("10.25"),
+			math.LegacyNewDec(100),
+		}
+
+		require.Equal(t, -1, txp.Compare(txp.MinValue, values[0].String()))
+		for i, a := range values {
+			for j, b := range values {
+				require.Equal(t, a.BigInt().Cmp(b.BigInt()), txp.Compare(a.String(), b.String()), "%d vs %d", i, j)
+			}
+		}
+	})
+}
+
+func TestFeeTxPriorityOrdering(t *testing.T) {
+	txc := testutils.CreateTestEncodingConfig().TxConfig
+	ctx := testutils.CreateBaseSDKContext(t)
+	accounts := testutils.RandomAccounts(rand.New(rand.NewSource(1)), 3)
+
+	// 1 atom is worth 10 stake.
+	txp := base.GasPriceTxPriority(base.StaticDenomConverter(map[string]math.LegacyDec{
+		"stake": math.LegacyOneDec(),
+		"atom":  math.LegacyNewDec(10),
+	}))
+
+	newTx := func(account testutils.Account, nonce uint64, gasLimit uint64, fee sdk.Coin) sdk.Tx {
+		tx, err := testutils.CreateRandomTx(txc, account, nonce, 1, 0, gasLimit, fee)
+		require.NoError(t, err)
+		return tx
+	}
+
+	var (
+		// gas price: 5 stake
+		tx0 = newTx(accounts[0], 0, 100, sdk.NewCoin("stake", math.NewInt(500)))
+		// gas price: 1 stake, but must follow tx0
+		tx1 = newTx(accounts[0], 1, 100, sdk.NewCoin("stake", math.NewInt(100)))
+		// gas price: 10 stake (paid in atom)
+		tx2 = newTx(accounts[1], 0, 100, sdk.NewCoin("atom", math.NewInt(100)))
+		// gas price: 2 stake, with a higher total fee than tx2
+		tx3 = newTx(accounts[2], 0, 1000, sdk.NewCoin("stake", math.NewInt(2000)))
+	)
+
+	mp := base.NewMempool(txp, signerextraction.NewDefaultAdapter(), 0)
+	for _, tx := range []sdk.Tx{tx1, tx3, tx0, tx2} {
+		require.NoError(t, mp.Insert(ctx, tx))
+	}
+
+	expected := []sdk.Tx{tx2, tx0, tx3, tx1}
+
+	var selected []sdk.Tx
+	for iterator := mp.Select(ctx, nil); iterator != nil; iterator = iterator.Next() {
+		selected = append(selected, iterator.Tx())
+	}
+	require.Equal(t, expected, selected)
+
+	t.Run("compare matches the order across lanes", func(t *testing.T) {
+		// Each tx is held by a different lane with the same priority.
+		for i := 0; i < len(expected); i++ {
+			for j := i + 1; j < len(expected); j++ {
+				this := base.NewMempool(txp, signerextraction.NewDefaultAdapter(), 0)
+				other := base.NewMempool(txp, signerextraction.NewDefaultAdapter(), 0)
+				require.NoError(t, this.Insert(ctx, expected[i]))
+				require.NoError(t, other.Insert(ctx, expected[j]))
+
+				cmp, err := this.Compare(ctx, expected[i], expected[j])
+				require.NoError(t, err)
+				require.Equal(t, 1, cmp, "tx %d should be ordered before tx %d", i, j)
+
+				cmp, err = other.Compare(ctx, expected[j], expected[i])
+				require.NoError(t, err)
+				require.Equal(t, -1, cmp, "tx %d should be ordered after tx %d", j, i)
+			}
+		}
+	})
+}
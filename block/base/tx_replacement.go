@@ -0,0 +1,82 @@
+package base
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// TxReplacementPolicy determines whether a new transaction can replace the transaction in
+// the lane's mempool with the same signer and sequence number, e.g. to speed up a transaction
+// that is stuck in the mempool by paying a higher fee.
+type TxReplacementPolicy func(oldTx, newTx sdk.Tx) bool
+
+// NewFeeBumpReplacementPolicy returns a TxReplacementPolicy that allows a transaction to be
+// replaced if the fee of the new transaction is at least the fee of the old transaction
+// increased by the given bump (e.g. 0.1 for 10%), for every denom of the old fee.
+func NewFeeBumpReplacementPolicy(bump math.LegacyDec) TxReplacementPolicy {
+	if bump.IsNil() || bump.IsNegative() {
+		panic("fee bump cannot be negative")
+	}
+
+	return func(oldTx, newTx sdk.Tx) bool {
+		oldFeeTx, ok := oldTx.(sdk.FeeTx)
+		if !ok {
+			return false
+		}
+
+		newFeeTx, ok := newTx.(sdk.FeeTx)
+		if !ok {
+			return false
+		}
+
+		return isBumped(oldFeeTx.GetFee(), math.LegacyOneDec(), newFeeTx.GetFee(), math.LegacyOneDec(), bump)
+	}
+}
+
+// NewGasPriceBumpReplacementPolicy returns a TxReplacementPolicy that allows a transaction to
+// be replaced if the gas price (fee per unit of gas) of the new transaction is at least the
+// gas price of the old transaction increased by the given bump (e.g. 0.1 for 10%), for every
+// denom of the old fee. Unlike NewFeeBumpReplacementPolicy, a transaction cannot be replaced
+// by a transaction that pays a higher fee only because it requests more gas.
+func NewGasPriceBumpReplacementPolicy(bump math.LegacyDec) TxReplacementPolicy {
+	if bump.IsNil() || bump.IsNegative() {
+		panic("gas price bump cannot be negative")
+	}
+
+	return func(oldTx, newTx sdk.Tx) bool {
+		oldFeeTx, ok := oldTx.(sdk.FeeTx)
+		if !ok || oldFeeTx.GetGas() == 0 {
+			return false
+		}
+
+		newFeeTx, ok := newTx.(sdk.FeeTx)
+		if !ok || newFeeTx.GetGas() == 0 {
+			return false
+		}
+
+		return isBumped(
+			oldFeeTx.GetFee(),
+			math.LegacyNewDec(int64(oldFeeTx.GetGas())),
+			newFeeTx.GetFee(),
+			math.LegacyNewDec(int64(newFeeTx.GetGas())),
+			bump,
+		)
+	}
+}
+
+// isBumped returns true if, for every denom of the old fee, the new fee divided by newGas
+// is at least the old fee divided by oldGas increased by the bump.
+func isBumped(oldFee sdk.Coins, oldGas math.LegacyDec, newFee sdk.Coins, newGas math.LegacyDec, bump math.LegacyDec) bool {
+	multiplier := math.LegacyOneDec().Add(bump)
+
+	for _, coin := range oldFee {
+		required := math.LegacyNewDecFromInt(coin.Amount).Mul(multiplier).Quo(oldGas)
+		actual := math.LegacyNewDecFromInt(newFee.AmountOf(coin.Denom)).Quo(newGas)
+
+		if actual.LT(required) {
+			return false
+		}
+	}
+
+	return true
+}
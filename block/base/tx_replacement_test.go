@@ -0,0 +1,71 @@
+package base_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/block-sdk/v2/block/base"
+	"github.com/skip-mev/block-sdk/v2/testutils"
+)
+
+func TestFeeBumpReplacementPolicy(t *testing.T) {
+	txc := testutils.CreateTestEncodingConfig().TxConfig
+	account := testutils.RandomAccounts(rand.New(rand.NewSource(1)), 1)[0]
+	policy := base.NewFeeBumpReplacementPolicy(math.LegacyMustNewDecFromStr("0.1"))
+
+	createTx := func(gasLimit uint64, fees ...sdk.Coin) sdk.Tx {
+		tx, err := testutils.CreateRandomTx(txc, account, 0, 1, 0, gasLimit, fees...)
+		require.NoError(t, err)
+		return tx
+	}
+
+	oldTx := createTx(100, sdk.NewCoin("stake", math.NewInt(100)))
+
+	t.Run("allows a replacement that bumps the fee", func(t *testing.T) {
+		require.True(t, policy(oldTx, createTx(100, sdk.NewCoin("stake", math.NewInt(110)))))
+	})
+
+	t.Run("rejects a replacement that does not bump the fee enough", func(t *testing.T) {
+		require.False(t, policy(oldTx, createTx(100, sdk.NewCoin("stake", math.NewInt(109)))))
+	})
+
+	t.Run("rejects a replacement that pays in another denom", func(t *testing.T) {
+		require.False(t, policy(oldTx, createTx(100, sdk.NewCoin("atom", math.NewInt(1000)))))
+	})
+
+	t.Run("allows a replacement that requests more gas", func(t *testing.T) {
+		require.True(t, policy(oldTx, createTx(1000, sdk.NewCoin("stake", math.NewInt(110)))))
+	})
+
+	t.Run("panics on a negative bump", func(t *testing.T) {
+		require.Panics(t, func() {
+			base.NewFeeBumpReplacementPolicy(math.LegacyNewDec(-1))
+		})
+	})
+}
+
+func TestGasPriceBumpReplacementPolicy(t *testing.T) {
+	txc := testutils.CreateTestEncodingConfig().TxConfig
+	account := testutils.RandomAccounts(rand.New(rand.NewSource(1)), 1)[0]
+	policy := base.NewGasPriceBumpReplacementPolicy(math.LegacyMustNewDecFromStr("0.1"))
+
+	createTx := func(gasLimit uint64, fees ...sdk.Coin) sdk.Tx {
+		tx, err := testutils.CreateRandomTx(txc, account, 0, 1, 0, gasLimit, fees...)
+		require.NoError(t, err)
+		return tx
+	}
+
+	oldTx := createTx(100, sdk.NewCoin("stake", math.NewInt(100)))
+
+	t.Run("allows a replacement that bumps the gas price", func(t *testing.T) {
+		require.True(t, policy(oldTx, createTx(50, sdk.NewCoin("stake", math.NewInt(55)))))
+	})
+
+	t.Run("rejects a replacement that only requests more gas", func(t *testing.T) {
+		require.False(t, policy(oldTx, createTx(1000, sdk.NewCoin("stake", math.NewInt(110)))))
+	})
+}
@@ -28,6 +28,13 @@ type (
 		remainingTxs []sdk.Tx,
 		err error,
 	)
+
+	// ProcessLaneBasicHandler is responsible for verifying the stateless invariants of the transactions
+	// that are included in a block and belong to a given lane (e.g. matching and ordering). It must
+	// perform the same checks as the lane's ProcessLaneHandler except for any stateful verification
+	// (i.e. VerifyTx), which is done separately. The handler is given the transactions that belong to
+	// the lane and the transactions that belong to the lanes after it.
+	ProcessLaneBasicHandler func(ctx sdk.Context, partialProposal []sdk.Tx, remainingTxs []sdk.Tx) error
 )
 
 // NoOpPrepareLaneHandler returns a no-op prepare lane handler.
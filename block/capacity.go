@@ -0,0 +1,288 @@
+package block
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+
+	signer_extraction "github.com/skip-mev/block-sdk/v2/adapters/signer_extraction_adapter"
+	"github.com/skip-mev/block-sdk/v2/block/utils"
+)
+
+type (
+	// MempoolCapacity defines the global capacity of the laned mempool, across all lanes.
+	MempoolCapacity struct {
+		// MaxTxs is the maximum number of transactions in the mempool. If set to zero, there
+		// is no limit on the number of transactions.
+		MaxTxs int
+
+		// MaxBytes is the maximum total size (in bytes) of the transactions in the mempool. If
+		// set to zero, there is no limit on the size of the transactions. The size of the
+		// transactions is reported by the lanes (see TxTracker), so every lane must be able to
+		// report its transactions if set.
+		MaxBytes int64
+
+		// EvictionPolicy selects the transactions that are evicted when inserting a transaction
+		// would exceed the capacity of the mempool. If nil, the transaction is rejected instead.
+		EvictionPolicy EvictionPolicy
+
+		// SignerExtractor is used to identify the transactions selected for eviction that are
+		// replaced by the new transaction. Like the lanes, the mempool identifies transactions by
+		// their first signer and its sequence number.
+		SignerExtractor signer_extraction.Adapter
+
+		// TxEncoder is used to identify unordered transactions, which do not have a sequence
+		// number, by their hash. If nil, unordered transactions are rejected.
+		TxEncoder sdk.TxEncoder
+	}
+
+	// MempoolUsage defines the number of transactions in (a lane of) the mempool and their
+	// total size in bytes.
+	MempoolUsage struct {
+		NumTxs int
+		Bytes  int64
+	}
+
+	// EvictionHandler is called with the transactions that were evicted from the mempool to
+	// make room for a new transaction, e.g. to purge them from the CometBFT mempool. The
+	// handler is called while the mempool is locked, so it must not call the mempool.
+	EvictionHandler func(ctx sdk.Context, evictions []Eviction)
+)
+
+// Validate validates the mempool capacity.
+func (c MempoolCapacity) Validate() error {
+	if c.MaxTxs < 0 {
+		return fmt.Errorf("max txs cannot be negative")
+	}
+
+	if c.MaxBytes < 0 {
+		return fmt.Errorf("max bytes cannot be negative")
+	}
+
+	if c.SignerExtractor == nil {
+		return fmt.Errorf("signer extractor cannot be nil")
+	}
+
+	return nil
+}
+
+// Fits returns true if the given usage is within the capacity.
+func (c MempoolCapacity) Fits(usage MempoolUsage) bool {
+	return (c.MaxTxs == 0 || usage.NumTxs <= c.MaxTxs) && (c.MaxBytes == 0 || usage.Bytes <= c.MaxBytes)
+}
+
+// WithCapacity sets the global capacity of the mempool. Once the mempool is full, inserting a
+// transaction evicts the transactions selected by the capacity's eviction policy or, if no
+// eviction policy is set (or no transactions are selected), the transaction is rejected with
+// ErrMempoolTxMaxCapacity. The per-lane limits (see base.LaneConfig.MaxTxs) still apply.
+func WithCapacity(capacity MempoolCapacity) LanedMempoolOption {
+	return func(mempool *LanedMempool) {
+		if err := capacity.Validate(); err != nil {
+			panic(fmt.Sprintf("invalid mempool capacity: %s", err))
+		}
+
+		mempool.capacity = &capacity
+	}
+}
+
+// WithEvictionHandler sets the handler that is called with the transactions that are evicted
+// from the mempool to make room for new transactions, either by the eviction policy of the
+// mempool's capacity (see WithCapacity) or by the lanes themselves (see TxEvictor).
+func WithEvictionHandler(handler EvictionHandler) LanedMempoolOption {
+	return func(mempool *LanedMempool) {
+		mempool.evictionHandler = handler
+	}
+}
+
+// Usage returns the number of transactions in each lane and their total size in bytes. The
+// size of the transactions is only known for the lanes that report their transactions (see
+// TxTracker).
+func (m *LanedMempool) Usage() map[string]MempoolUsage {
+	m.mtx.RLock()
+	defer m.mtx.RUnlock()
+
+	return m.usage()
+}
+
+// usage returns the usage of each lane (see Usage). The caller must hold the lock.
+func (m *LanedMempool) usage() map[string]MempoolUsage {
+	// The lanes are not called while holding the lock of the index (see lookupHash).
+	counts := make(map[string]int, len(m.registry))
+	for _, lane := range m.registry {
+		counts[lane.Name()] = lane.CountTx()
+	}
+
+	m.indexMtx.Lock()
+	defer m.indexMtx.Unlock()
+
+	usage := make(map[string]MempoolUsage, len(m.registry))
+	for _, lane := range m.registry {
+		usage[lane.Name()] = MempoolUsage{NumTxs: counts[lane.Name()], Bytes: m.laneBytes[lane.Name()]}
+	}
+
+	return usage
+}
+
+// bytes returns the total size of the transactions in the mempool. The caller must hold the
+// lock.
+func (m *LanedMempool) bytes() int64 {
+	m.indexMtx.Lock()
+	defer m.indexMtx.Unlock()
+
+	return m.txBytes
+}
+
+// ensureCapacity ensures that the transaction can be inserted into the given lane without
+// exceeding the capacity of the mempool. It returns the transactions selected by the eviction
+// policy to make room for it, which must only be evicted once the transaction is inserted
+// (see evict).
+func (m *LanedMempool) ensureCapacity(ctx sdk.Context, lane Lane, tx sdk.Tx) ([]Eviction, error) {
+	txInfo, err := lane.GetTxInfo(ctx, tx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tx info: %w", err)
+	}
+
+	if m.capacity.MaxBytes > 0 && txInfo.Size > m.capacity.MaxBytes {
+		return nil, fmt.Errorf("tx size %d exceeds the max bytes of the mempool: %w", txInfo.Size, sdkmempool.ErrMempoolTxMaxCapacity)
+	}
+
+	key, err := m.txKey(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Usage of the mempool once the transaction is inserted. If the transaction replaces a
+	// transaction with the same signer and sequence, the replaced transaction is discounted.
+	total := MempoolUsage{NumTxs: m.countTx() + 1, Bytes: m.bytes() + txInfo.Size}
+	if replacer, ok := lane.(TxReplacer); ok {
+		if replaced, found := replacer.Lookup(tx); found {
+			replacedInfo, err := lane.GetTxInfo(ctx, replaced)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get tx info: %w", err)
+			}
+
+			total.NumTxs--
+			total.Bytes -= replacedInfo.Size
+		}
+	} else if lane.Contains(tx) {
+		total.NumTxs--
+		total.Bytes -= txInfo.Size
+	}
+
+	if m.capacity.Fits(total) {
+		return nil, nil
+	}
+
+	if m.capacity.EvictionPolicy == nil {
+		return nil, sdkmempool.ErrMempoolTxMaxCapacity
+	}
+
+	evictions, err := m.capacity.EvictionPolicy.SelectEvictions(ctx, EvictionRequest{
+		Lanes:    m.registry,
+		Lane:     lane,
+		Tx:       tx,
+		TxSize:   txInfo.Size,
+		Capacity: *m.capacity,
+		Usage:    m.usage(),
+		Total:    total,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to select txs to evict: %w", err)
+	}
+
+	selected := make([]Eviction, 0, len(evictions))
+	for _, eviction := range evictions {
+		// The replaced transaction is already discounted and is removed by the insertion.
+		if evictedKey, err := m.txKey(eviction.Tx); err == nil && evictedKey == key && eviction.Lane.Name() == lane.Name() {
+			continue
+		}
+
+		selected = append(selected, eviction)
+		total.NumTxs--
+		total.Bytes -= eviction.Size
+	}
+
+	if len(selected) == 0 || !m.capacity.Fits(total) {
+		return nil, sdkmempool.ErrMempoolTxMaxCapacity
+	}
+
+	return selected, nil
+}
+
+// evict removes the transactions selected by ensureCapacity once the new transaction has
+// been inserted into the given lane.
+func (m *LanedMempool) evict(ctx sdk.Context, lane Lane, evictions []Eviction) {
+	evicted := make([]Eviction, 0, len(evictions))
+	for _, eviction := range evictions {
+		if err := eviction.Lane.Remove(eviction.Tx); err != nil {
+			m.logger.Error(
+				"failed to evict tx from mempool",
+				"lane", eviction.Lane.Name(),
+				"err", err,
+			)
+
+			continue
+		}
+
+		evicted = append(evicted, eviction)
+	}
+
+	m.handleEvictions(ctx, lane, evicted)
+}
+
+// laneEvictions returns the evictions of the transactions that the lane evicted by itself
+// (see TxEvictor). The size of the transactions is left unset if it cannot be computed.
+func (m *LanedMempool) laneEvictions(ctx sdk.Context, lane Lane, txs []sdk.Tx) []Eviction {
+	evictions := make([]Eviction, 0, len(txs))
+	for _, tx := range txs {
+		eviction := Eviction{Lane: lane, Tx: tx}
+		if txInfo, err := lane.GetTxInfo(ctx, tx); err == nil {
+			eviction.Size = txInfo.Size
+		}
+
+		evictions = append(evictions, eviction)
+	}
+
+	return evictions
+}
+
+// handleEvictions reports the transactions that were evicted from their lanes to make room
+// for a new transaction in the given lane to the metrics and passes them to the eviction
+// handler. The lanes have already reported them as removed (see TxTracker).
+func (m *LanedMempool) handleEvictions(ctx sdk.Context, lane Lane, evictions []Eviction) {
+	if len(evictions) == 0 {
+		return
+	}
+
+	for _, eviction := range evictions {
+		m.metrics.AddTxsEvicted(eviction.Lane.Name(), 1)
+		m.metrics.SetLaneSize(eviction.Lane.Name(), eviction.Lane.CountTx())
+	}
+
+	m.logger.Info(
+		"evicted txs from mempool to make room for new tx",
+		"lane", lane.Name(),
+		"num_evicted_txs", len(evictions),
+	)
+
+	if m.evictionHandler != nil {
+		m.evictionHandler(ctx, evictions)
+	}
+}
+
+// txKey returns the key that identifies the transaction in the mempool, i.e. its first
+// signer and sequence number or, for unordered transactions, its hash (see utils.SenderKey).
+func (m *LanedMempool) txKey(tx sdk.Tx) (string, error) {
+	signers, err := m.capacity.SignerExtractor.GetSigners(tx)
+	if err != nil {
+		return "", err
+	}
+
+	sender, sequence, err := utils.SenderKey(m.capacity.TxEncoder, tx, signers, nil)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%s/%d", sender, sequence), nil
+}
@@ -0,0 +1,389 @@
+package block
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/block-sdk/v2/block/utils"
+)
+
+var (
+	_ EvictionPolicy = LanePriorityEvictionPolicy{}
+	_ EvictionPolicy = LaneShareEvictionPolicy{}
+)
+
+type (
+	// EvictionPolicy selects the transactions to evict from the mempool when inserting a
+	// transaction would exceed the capacity of the mempool (see WithCapacity).
+	EvictionPolicy interface {
+		// SelectEvictions returns the transactions to evict such that the transaction of the
+		// request can be inserted without exceeding the capacity of the mempool. If no
+		// transactions are returned, or if the returned transactions do not free up enough
+		// space, the transaction is rejected and nothing is evicted.
+		SelectEvictions(ctx sdk.Context, req EvictionRequest) ([]Eviction, error)
+	}
+
+	// EvictionRequest defines the transaction that is being inserted into a full mempool
+	// along with the current usage of the mempool.
+	EvictionRequest struct {
+		// Lanes are the lanes in the mempool, ordered by priority.
+		Lanes []Lane
+		// Lane is the lane the transaction is inserted into.
+		Lane Lane
+		// Tx is the transaction that is inserted.
+		Tx sdk.Tx
+		// TxSize is the size of the transaction in bytes.
+		TxSize int64
+		// Capacity is the capacity of the mempool.
+		Capacity MempoolCapacity
+		// Usage is the current usage of each lane (by name), without the transaction.
+		Usage map[string]MempoolUsage
+		// Total is the usage of the mempool once the transaction is inserted.
+		Total MempoolUsage
+	}
+
+	// Eviction defines a transaction that is evicted from a lane.
+	Eviction struct {
+		Lane Lane
+		Tx   sdk.Tx
+		Size int64
+	}
+
+	// LanePriorityEvictionPolicy evicts the lowest priority transactions of the lane the new
+	// transaction is inserted into. Only transactions with a lower priority than the new
+	// transaction are evicted, otherwise the new transaction is rejected. Each evicted
+	// transaction is evicted along with the transactions of its signer with a higher sequence
+	// number in the lane, which could no longer be executed.
+	LanePriorityEvictionPolicy struct{}
+
+	// LaneShareEvictionPolicy evicts transactions from the lanes that use more than their share
+	// of the mempool's capacity. The share of a lane is its max block space; lanes without a max
+	// block space share whatever remains. The lowest priority transaction of the lane that most
+	// exceeds its share is evicted (along with the transactions of its signer with a higher
+	// sequence number in the lane) until the new transaction fits. Transactions are only evicted
+	// from the lane the new transaction is inserted into if they have a lower priority than the
+	// new transaction.
+	LaneShareEvictionPolicy struct{}
+
+	// evictionCandidates contains the transactions of a lane that may be evicted, from the
+	// lowest to the highest priority. The candidates are loaded lazily from the lane's priority
+	// index (see TxPriorityIndex), such that only as many transactions are loaded as are
+	// evicted.
+	evictionCandidates struct {
+		txs     []sdk.Tx
+		offset  int
+		loaded  bool
+		evicted map[string]struct{}
+		usage   MempoolUsage
+	}
+)
+
+// minEvictionBatchSize is the number of candidates that are first loaded from a lane. Each
+// following batch doubles the number of loaded candidates.
+const minEvictionBatchSize = 16
+
+// NewLanePriorityEvictionPolicy returns a new LanePriorityEvictionPolicy.
+func NewLanePriorityEvictionPolicy() LanePriorityEvictionPolicy {
+	return LanePriorityEvictionPolicy{}
+}
+
+// SelectEvictions implements EvictionPolicy.
+func (LanePriorityEvictionPolicy) SelectEvictions(ctx sdk.Context, req EvictionRequest) ([]Eviction, error) {
+	candidates := &evictionCandidates{}
+
+	var evictions []Eviction
+	for total := req.Total; !req.Capacity.Fits(total); {
+		evicted, ok, err := candidates.evictLowestPriority(ctx, req.Lane, req.Tx)
+		if err != nil || !ok {
+			return nil, err
+		}
+
+		for _, eviction := range evicted {
+			evictions = append(evictions, eviction)
+			total.NumTxs--
+			total.Bytes -= eviction.Size
+		}
+	}
+
+	return evictions, nil
+}
+
+// NewLaneShareEvictionPolicy returns a new LaneShareEvictionPolicy.
+func NewLaneShareEvictionPolicy() LaneShareEvictionPolicy {
+	return LaneShareEvictionPolicy{}
+}
+
+// SelectEvictions implements EvictionPolicy.
+func (LaneShareEvictionPolicy) SelectEvictions(ctx sdk.Context, req EvictionRequest) ([]Eviction, error) {
+	shares := laneShares(req.Lanes)
+
+	candidates := make(map[string]*evictionCandidates, len(req.Lanes))
+	for _, lane := range req.Lanes {
+		usage := req.Usage[lane.Name()]
+
+		// The new transaction counts towards the share of its lane.
+		if lane.Name() == req.Lane.Name() {
+			usage.NumTxs++
+			usage.Bytes += req.TxSize
+		}
+
+		candidates[lane.Name()] = &evictionCandidates{usage: usage}
+	}
+
+	var evictions []Eviction
+	for total := req.Total; !req.Capacity.Fits(total); {
+		// Find the lane that most exceeds its share of the capacity.
+		var (
+			lane       Lane
+			maxOverage float64
+		)
+		for _, l := range req.Lanes {
+			overage := candidates[l.Name()].usageRatio(req.Capacity) - shares[l.Name()]
+			if overage > maxOverage && candidates[l.Name()].usage.NumTxs > 0 {
+				lane, maxOverage = l, overage
+			}
+		}
+
+		if lane == nil {
+			return nil, nil
+		}
+
+		laneCandidates := candidates[lane.Name()]
+
+		// Transactions of other lanes are evicted regardless of their priority.
+		var newTx sdk.Tx
+		if lane.Name() == req.Lane.Name() {
+			newTx = req.Tx
+		}
+
+		evicted, ok, err := laneCandidates.evictLowestPriority(ctx, lane, newTx)
+		if err != nil || !ok {
+			return nil, err
+		}
+
+		for _, eviction := range evicted {
+			evictions = append(evictions, eviction)
+			total.NumTxs--
+			total.Bytes -= eviction.Size
+		}
+	}
+
+	return evictions, nil
+}
+
+// load loads the next batch of candidates from the lane. Lanes without a priority index are
+// loaded at once, in reverse selection order.
+func (c *evictionCandidates) load(ctx sdk.Context, lane Lane) {
+	index, ok := lane.(TxPriorityIndex)
+	if !ok {
+		var txs []sdk.Tx
+		for iterator := lane.Select(ctx, nil); iterator != nil; iterator = iterator.Next() {
+			txs = append(txs, iterator.Tx())
+		}
+
+		for i := len(txs) - 1; i >= 0; i-- {
+			c.txs = append(c.txs, txs[i])
+		}
+
+		c.loaded = true
+
+		return
+	}
+
+	limit := c.offset
+	if limit < minEvictionBatchSize {
+		limit = minEvictionBatchSize
+	}
+
+	txs := index.LowestPriorityTxs(c.offset, limit)
+	c.txs = append(c.txs, txs...)
+	c.offset += len(txs)
+	c.loaded = len(txs) < limit
+}
+
+// lowestPriority returns the lowest priority candidate that has not been evicted yet along
+// with its information, loading more candidates from the lane if needed. It returns false
+// if there are no candidates left.
+func (c *evictionCandidates) lowestPriority(ctx sdk.Context, lane Lane) (sdk.Tx, utils.TxWithInfo, bool, error) {
+	for {
+		if len(c.txs) == 0 {
+			if c.loaded {
+				return nil, utils.TxWithInfo{}, false, nil
+			}
+
+			c.load(ctx, lane)
+			continue
+		}
+
+		txInfo, err := lane.GetTxInfo(ctx, c.txs[0])
+		if err != nil {
+			return nil, utils.TxWithInfo{}, false, fmt.Errorf("failed to get tx info: %w", err)
+		}
+
+		// Skip the transactions that were already evicted along with an earlier transaction
+		// of their signer.
+		if c.isEvicted(txInfo) {
+			c.txs = c.txs[1:]
+			continue
+		}
+
+		return c.txs[0], txInfo, true, nil
+	}
+}
+
+// evictLowestPriority evicts the lowest priority transaction among the candidates along with
+// the transactions of the same signer in the lane with a higher sequence number, which can no
+// longer be executed once the transaction is evicted. If newTx is set, the transaction is only
+// evicted if it has a lower priority than newTx and if newTx does not follow it in the
+// sequence of its signer.
+func (c *evictionCandidates) evictLowestPriority(ctx sdk.Context, lane Lane, newTx sdk.Tx) ([]Eviction, bool, error) {
+	tx, txInfo, ok, err := c.lowestPriority(ctx, lane)
+	if err != nil || !ok {
+		return nil, false, err
+	}
+
+	if newTx != nil {
+		if cmp, err := lane.Compare(ctx, newTx, tx); err != nil || cmp <= 0 {
+			return nil, false, nil
+		}
+
+		newTxInfo, err := lane.GetTxInfo(ctx, newTx)
+		if err != nil {
+			return nil, false, fmt.Errorf("failed to get tx info: %w", err)
+		}
+
+		if followsInSequence(txInfo, newTxInfo) {
+			return nil, false, nil
+		}
+	}
+
+	tailTxs, tailInfos, err := senderTail(ctx, lane, txInfo)
+	if err != nil {
+		return nil, false, err
+	}
+
+	c.txs = c.txs[1:]
+	c.markEvicted(txInfo)
+	evictions := []Eviction{{Lane: lane, Tx: tx, Size: txInfo.Size}}
+
+	for i, info := range tailInfos {
+		if c.isEvicted(info) {
+			continue
+		}
+
+		c.markEvicted(info)
+		evictions = append(evictions, Eviction{Lane: lane, Tx: tailTxs[i], Size: info.Size})
+	}
+
+	for _, eviction := range evictions {
+		c.usage.NumTxs--
+		c.usage.Bytes -= eviction.Size
+	}
+
+	return evictions, true, nil
+}
+
+// isEvicted returns true if the transaction has been evicted.
+func (c *evictionCandidates) isEvicted(txInfo utils.TxWithInfo) bool {
+	_, ok := c.evicted[txInfo.Hash]
+	return ok
+}
+
+// markEvicted marks the transaction as evicted.
+func (c *evictionCandidates) markEvicted(txInfo utils.TxWithInfo) {
+	if c.evicted == nil {
+		c.evicted = make(map[string]struct{})
+	}
+
+	c.evicted[txInfo.Hash] = struct{}{}
+}
+
+// senderTail returns the transactions of the transaction's signer in the lane with a higher
+// sequence number, looked up with the lane's sender index (see TxSenderIndex). Lanes without
+// a sender index are not searched.
+func senderTail(ctx sdk.Context, lane Lane, txInfo utils.TxWithInfo) ([]sdk.Tx, []utils.TxWithInfo, error) {
+	index, ok := lane.(TxSenderIndex)
+	if !ok || len(txInfo.Signers) == 0 || txInfo.Signers[0].Unordered {
+		return nil, nil, nil
+	}
+
+	var (
+		txs   []sdk.Tx
+		infos []utils.TxWithInfo
+	)
+	for _, tx := range index.SenderTxs(txInfo.Signers[0].Signer) {
+		info, err := lane.GetTxInfo(ctx, tx)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to get tx info: %w", err)
+		}
+
+		if followsInSequence(txInfo, info) {
+			txs = append(txs, tx)
+			infos = append(infos, info)
+		}
+	}
+
+	return txs, infos, nil
+}
+
+// followsInSequence returns true if the other transaction has the same (ordered) signer as
+// the transaction and a higher sequence number.
+func followsInSequence(txInfo, other utils.TxWithInfo) bool {
+	if len(txInfo.Signers) == 0 || len(other.Signers) == 0 {
+		return false
+	}
+
+	signer, otherSigner := txInfo.Signers[0], other.Signers[0]
+	if signer.Unordered || otherSigner.Unordered {
+		return false
+	}
+
+	return signer.Signer.Equals(otherSigner.Signer) && otherSigner.Sequence > signer.Sequence
+}
+
+// usageRatio returns the fraction of the capacity used by the candidates (the larger of the
+// fractions of the max number of transactions and of the max bytes).
+func (c *evictionCandidates) usageRatio(capacity MempoolCapacity) float64 {
+	var ratio float64
+	if capacity.MaxTxs > 0 {
+		ratio = float64(c.usage.NumTxs) / float64(capacity.MaxTxs)
+	}
+
+	if capacity.MaxBytes > 0 {
+		if bytesRatio := float64(c.usage.Bytes) / float64(capacity.MaxBytes); bytesRatio > ratio {
+			ratio = bytesRatio
+		}
+	}
+
+	return ratio
+}
+
+// laneShares returns the share of the mempool's capacity of each lane, i.e. its max block
+// space. Lanes without a max block space share the remaining capacity equally.
+func laneShares(lanes []Lane) map[string]float64 {
+	shares := make(map[string]float64, len(lanes))
+	remaining := math.LegacyOneDec()
+
+	var unlimited []string
+	for _, lane := range lanes {
+		maxBlockSpace := lane.GetMaxBlockSpace()
+		if maxBlockSpace.IsNil() || maxBlockSpace.IsZero() {
+			unlimited = append(unlimited, lane.Name())
+			continue
+		}
+
+		shares[lane.Name()] = maxBlockSpace.MustFloat64()
+		remaining = remaining.Sub(maxBlockSpace)
+	}
+
+	if len(unlimited) > 0 && remaining.IsPositive() {
+		share := remaining.QuoInt64(int64(len(unlimited))).MustFloat64()
+		for _, name := range unlimited {
+			shares[name] = share
+		}
+	}
+
+	return shares
+}
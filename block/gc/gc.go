@@ -0,0 +1,238 @@
+package gc
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/log"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+
+	signer_extraction "github.com/skip-mev/block-sdk/v2/adapters/signer_extraction_adapter"
+	"github.com/skip-mev/block-sdk/v2/block"
+	"github.com/skip-mev/block-sdk/v2/block/base"
+)
+
+// Collector removes stale transactions from the lanes of the laned mempool once a block has
+// been committed (see PrepareCheckStater). A transaction is stale if:
+//
+//   - the sequence number of one of its signers is below the current sequence number of the
+//     signer's account, i.e. the sequence number has already been used.
+//   - its timeout height has passed, i.e. it can no longer be included in the next block.
+//   - it is an unordered transaction whose timeout timestamp has passed.
+//   - it has been in a lane for longer than the lane's TTL (see WithLaneTTL).
+//
+// Without garbage collection, stale transactions stay in the lanes until a proposal or a
+// recheck happens to hit them. Removed transactions are also purged from the CometBFT mempool
+// on the next recheck, since MempoolParityCheckTx fails for transactions that are no longer in
+// the lanes.
+type Collector struct {
+	logger        log.Logger
+	mempool       block.Mempool
+	accountKeeper base.AccountKeeper
+
+	// ttls are the TTLs of the lanes (by name) that have one.
+	ttls map[string]time.Duration
+	// firstSeen is the block time at which each transaction (by hash) of a lane with a TTL was
+	// first seen by the collector.
+	firstSeen map[string]time.Time
+}
+
+// Option is a functional option for the Collector.
+type Option func(*Collector)
+
+// WithLaneTTL sets the TTL of the given lane. The transactions of the lane are removed once
+// the time between the block in which they were first seen by the collector and the committed
+// block exceeds the TTL. Since the collector only runs after a block is committed, the age of
+// a transaction is measured in block time and is only as precise as the block interval.
+func WithLaneTTL(lane string, ttl time.Duration) Option {
+	return func(c *Collector) {
+		if ttl <= 0 {
+			panic(fmt.Sprintf("ttl of lane %s must be positive", lane))
+		}
+
+		c.ttls[lane] = ttl
+	}
+}
+
+// NewCollector returns a new Collector that removes the stale transactions of the given
+// mempool. The account keeper is used to retrieve the current sequence numbers of the
+// signers of the transactions.
+func NewCollector(
+	logger log.Logger,
+	mempool block.Mempool,
+	accountKeeper base.AccountKeeper,
+	opts ...Option,
+) (*Collector, error) {
+	if mempool == nil {
+		return nil, fmt.Errorf("mempool cannot be nil")
+	}
+
+	if accountKeeper == nil {
+		return nil, fmt.Errorf("account keeper cannot be nil")
+	}
+
+	c := &Collector{
+		logger:        logger,
+		mempool:       mempool,
+		accountKeeper: accountKeeper,
+		ttls:          make(map[string]time.Duration),
+		firstSeen:     make(map[string]time.Time),
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c, nil
+}
+
+// PrepareCheckStater returns a PrepareCheckStater that calls the given PrepareCheckStater (if
+// any) and then removes the stale transactions from the mempool. The context is the check
+// state of the committed block, so the account sequence numbers include its transactions.
+func (c *Collector) PrepareCheckStater(next sdk.PrepareCheckStater) sdk.PrepareCheckStater {
+	return func(ctx sdk.Context) {
+		if next != nil {
+			next(ctx)
+		}
+
+		c.Collect(ctx)
+	}
+}
+
+// Collect removes the stale transactions from every lane of the mempool, including the queued
+// transactions (see block.QueuedPool), and returns the number of transactions that were
+// removed. Queued transactions whose sequence gap has closed are promoted first, such that
+// queued transactions with a used sequence number are removed as well.
+func (c *Collector) Collect(ctx sdk.Context) int {
+	var (
+		sequences = make(map[string]uint64)
+		firstSeen = make(map[string]time.Time)
+		removed   int
+	)
+
+	for _, lane := range c.mempool.Registry() {
+		pool, hasQueue := lane.(block.QueuedPool)
+		if hasQueue {
+			pool.PromoteQueuedTxs(ctx)
+		}
+
+		stale := c.collectStale(ctx, lane, lane.Select(ctx, nil), sequences, firstSeen)
+		if hasQueue {
+			stale = append(stale, c.collectStale(ctx, lane, pool.SelectQueued(ctx), sequences, firstSeen)...)
+		}
+
+		for _, tx := range stale {
+			if err := c.mempool.Remove(tx); err != nil {
+				c.logger.Info("failed to remove stale tx from mempool", "lane", lane.Name(), "err", err)
+				continue
+			}
+
+			removed++
+		}
+
+		if len(stale) > 0 {
+			c.logger.Info(
+				"removed stale txs from lane",
+				"lane", lane.Name(),
+				"num_removed_txs", len(stale),
+				"height", ctx.BlockHeight(),
+			)
+		}
+	}
+
+	// Only the transactions that are still in the mempool are tracked.
+	c.firstSeen = firstSeen
+
+	return removed
+}
+
+// collectStale returns the stale transactions of the lane returned by the iterator, including
+// the transactions that exceeded the lane's TTL. The time at which the other transactions of
+// the lane were first seen is recorded in firstSeen.
+func (c *Collector) collectStale(
+	ctx sdk.Context,
+	lane block.Lane,
+	iterator sdkmempool.Iterator,
+	sequences map[string]uint64,
+	firstSeen map[string]time.Time,
+) []sdk.Tx {
+	ttl, hasTTL := c.ttls[lane.Name()]
+
+	var stale []sdk.Tx
+	for ; iterator != nil; iterator = iterator.Next() {
+		tx := iterator.Tx()
+
+		txInfo, err := lane.GetTxInfo(ctx, tx)
+		if err != nil {
+			c.logger.Info("failed to get tx info for mempool gc", "lane", lane.Name(), "err", err)
+			continue
+		}
+
+		if c.isStale(ctx, tx, txInfo.Signers, sequences) {
+			stale = append(stale, tx)
+			continue
+		}
+
+		if !hasTTL {
+			continue
+		}
+
+		seen, ok := c.firstSeen[txInfo.Hash]
+		if !ok {
+			seen = ctx.BlockTime()
+		}
+
+		if ctx.BlockTime().Sub(seen) > ttl {
+			stale = append(stale, tx)
+			continue
+		}
+
+		firstSeen[txInfo.Hash] = seen
+	}
+
+	return stale
+}
+
+// isStale returns true if the transaction has a signer whose sequence number has already been
+// used or if its timeout height (or, for unordered transactions, its timeout timestamp) has
+// passed. The sequence numbers of the accounts are cached in the given map.
+func (c *Collector) isStale(
+	ctx sdk.Context,
+	tx sdk.Tx,
+	signers []signer_extraction.SignerData,
+	sequences map[string]uint64,
+) bool {
+	if timeoutTx, ok := tx.(sdk.TxWithTimeoutHeight); ok {
+		// The committed block is the last block in which the transaction could be included.
+		if timeout := timeoutTx.GetTimeoutHeight(); timeout > 0 && uint64(ctx.BlockHeight()) >= timeout {
+			return true
+		}
+	}
+
+	// Unordered transactions do not use the sequence numbers of their signers.
+	if unorderedTx, ok := tx.(signer_extraction.TxWithUnordered); ok && unorderedTx.GetUnordered() {
+		return !ctx.BlockTime().Before(unorderedTx.GetTimeoutTimeStamp())
+	}
+
+	for _, signer := range signers {
+		key := signer.Signer.String()
+
+		sequence, ok := sequences[key]
+		if !ok {
+			var err error
+			if sequence, err = c.accountKeeper.GetSequence(ctx, signer.Signer); err != nil {
+				c.logger.Info("failed to get sequence for mempool gc", "signer", key, "err", err)
+				continue
+			}
+
+			sequences[key] = sequence
+		}
+
+		if signer.Sequence < sequence {
+			return true
+		}
+	}
+
+	return false
+}
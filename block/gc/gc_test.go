@@ -0,0 +1,284 @@
+package gc_test
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	signeradaptors "github.com/skip-mev/block-sdk/v2/adapters/signer_extraction_adapter"
+	"github.com/skip-mev/block-sdk/v2/block"
+	"github.com/skip-mev/block-sdk/v2/block/base"
+	"github.com/skip-mev/block-sdk/v2/block/gc"
+	defaultlane "github.com/skip-mev/block-sdk/v2/lanes/base"
+	testutils "github.com/skip-mev/block-sdk/v2/testutils"
+)
+
+type GCTestSuite struct {
+	suite.Suite
+
+	ctx            sdk.Context
+	encodingConfig testutils.EncodingConfig
+	accounts       []testutils.Account
+	gasTokenDenom  string
+
+	// sequences contains the current sequence number of the accounts.
+	sequences accountKeeper
+}
+
+// accountKeeper is a mock account keeper that returns the sequence numbers of the accounts
+// (by address), defaulting to zero.
+type accountKeeper map[string]uint64
+
+func (ak accountKeeper) GetSequence(_ context.Context, addr sdk.AccAddress) (uint64, error) {
+	return ak[addr.String()], nil
+}
+
+func TestGCTestSuite(t *testing.T) {
+	suite.Run(t, new(GCTestSuite))
+}
+
+func (s *GCTestSuite) SetupTest() {
+	s.encodingConfig = testutils.CreateTestEncodingConfig()
+	s.accounts = testutils.RandomAccounts(rand.New(rand.NewSource(1)), 3)
+	s.gasTokenDenom = "stake"
+	s.sequences = make(accountKeeper)
+
+	key := storetypes.NewKVStoreKey("test")
+	testCtx := testutil.DefaultContextWithDB(s.T(), key, storetypes.NewTransientStoreKey("transient_test"))
+	s.ctx = testCtx.Ctx.WithIsCheckTx(true).WithBlockHeight(10).WithBlockTime(time.Unix(1000, 0))
+}
+
+// setUpMempool returns a new mempool with a lane that only matches transactions signed by the
+// first account and a default lane.
+func (s *GCTestSuite) setUpMempool() *block.LanedMempool {
+	return s.newMempool(nil)
+}
+
+// setUpQueuedMempool returns the same mempool as setUpMempool, except that the lane that
+// matches the transactions signed by the first account queues transactions with a sequence
+// gap.
+func (s *GCTestSuite) setUpQueuedMempool() *block.LanedMempool {
+	return s.newMempool(s.sequences)
+}
+
+func (s *GCTestSuite) newMempool(accountKeeper base.AccountKeeper) *block.LanedMempool {
+	cfg := base.LaneConfig{
+		Logger:          log.NewNopLogger(),
+		TxEncoder:       testutils.UnorderedTxEncoder(s.encodingConfig.TxConfig.TxEncoder()),
+		TxDecoder:       s.encodingConfig.TxConfig.TxDecoder(),
+		MaxBlockSpace:   math.LegacyMustNewDecFromStr("0.5"),
+		SignerExtractor: signeradaptors.NewDefaultAdapter(),
+		AccountKeeper:   accountKeeper,
+	}
+	mh := func(_ sdk.Context, tx sdk.Tx) bool {
+		signers, err := cfg.SignerExtractor.GetSigners(tx)
+		return err == nil && len(signers) > 0 && signers[0].Signer.Equals(s.accounts[0].Address)
+	}
+
+	lane, err := base.NewBaseLane(
+		cfg,
+		"first",
+		base.WithMatchHandler(mh),
+		base.WithMempoolConfigs(cfg, base.DefaultTxPriority()),
+	)
+	s.Require().NoError(err)
+
+	defaultCfg := cfg
+	defaultCfg.AccountKeeper = nil
+	defaultCfg.MaxBlockSpace = math.LegacyZeroDec()
+	defaultLane := defaultlane.NewDefaultLane(defaultCfg, base.DefaultMatchHandler())
+
+	mempool, err := block.NewLanedMempool(log.NewNopLogger(), []block.Lane{lane, defaultLane})
+	s.Require().NoError(err)
+
+	return mempool
+}
+
+func (s *GCTestSuite) createTx(account testutils.Account, nonce, timeout uint64) sdk.Tx {
+	tx, err := testutils.CreateRandomTx(
+		s.encodingConfig.TxConfig,
+		account,
+		nonce,
+		1,
+		timeout,
+		1,
+		sdk.NewCoin(s.gasTokenDenom, math.NewInt(1)),
+	)
+	s.Require().NoError(err)
+
+	return tx
+}
+
+func (s *GCTestSuite) TestNewCollector() {
+	s.Run("returns an error if the mempool is nil", func() {
+		_, err := gc.NewCollector(log.NewNopLogger(), nil, s.sequences)
+		s.Require().Error(err)
+	})
+
+	s.Run("returns an error if the account keeper is nil", func() {
+		_, err := gc.NewCollector(log.NewNopLogger(), s.setUpMempool(), nil)
+		s.Require().Error(err)
+	})
+
+	s.Run("panics if a ttl is not positive", func() {
+		s.Require().Panics(func() {
+			_, _ = gc.NewCollector(log.NewNopLogger(), s.setUpMempool(), s.sequences, gc.WithLaneTTL("first", 0))
+		})
+	})
+}
+
+func (s *GCTestSuite) TestCollect() {
+	s.Run("removes txs with a used sequence number", func() {
+		tx1 := s.createTx(s.accounts[0], 0, 0)
+		tx2 := s.createTx(s.accounts[0], 1, 0)
+		tx3 := s.createTx(s.accounts[1], 0, 0)
+		tx4 := s.createTx(s.accounts[2], 0, 0)
+
+		mempool := s.setUpMempool()
+		for _, tx := range []sdk.Tx{tx1, tx2, tx3, tx4} {
+			s.Require().NoError(mempool.Insert(s.ctx, tx))
+		}
+
+		s.sequences[s.accounts[0].Address.String()] = 1
+		s.sequences[s.accounts[1].Address.String()] = 3
+		defer func() { s.sequences = make(accountKeeper) }()
+
+		collector, err := gc.NewCollector(log.NewNopLogger(), mempool, s.sequences)
+		s.Require().NoError(err)
+
+		s.Require().Equal(2, collector.Collect(s.ctx))
+		s.Require().False(mempool.Contains(tx1))
+		s.Require().True(mempool.Contains(tx2))
+		s.Require().False(mempool.Contains(tx3))
+		s.Require().True(mempool.Contains(tx4))
+	})
+
+	s.Run("removes txs whose timeout height has passed", func() {
+		tx1 := s.createTx(s.accounts[0], 0, 10)
+		tx2 := s.createTx(s.accounts[1], 0, 11)
+		tx3 := s.createTx(s.accounts[2], 0, 0)
+
+		mempool := s.setUpMempool()
+		for _, tx := range []sdk.Tx{tx1, tx2, tx3} {
+			s.Require().NoError(mempool.Insert(s.ctx, tx))
+		}
+
+		collector, err := gc.NewCollector(log.NewNopLogger(), mempool, s.sequences)
+		s.Require().NoError(err)
+
+		s.Require().Equal(1, collector.Collect(s.ctx))
+		s.Require().False(mempool.Contains(tx1))
+		s.Require().True(mempool.Contains(tx2))
+		s.Require().True(mempool.Contains(tx3))
+
+		s.Require().Equal(1, collector.Collect(s.ctx.WithBlockHeight(11)))
+		s.Require().False(mempool.Contains(tx2))
+		s.Require().True(mempool.Contains(tx3))
+	})
+
+	s.Run("removes unordered txs whose timeout timestamp has passed", func() {
+		tx1, err := testutils.CreateUnorderedTx(s.encodingConfig.TxConfig, s.accounts[1], s.ctx.BlockTime(), 1)
+		s.Require().NoError(err)
+		tx2, err := testutils.CreateUnorderedTx(s.encodingConfig.TxConfig, s.accounts[1], s.ctx.BlockTime().Add(time.Minute), 1)
+		s.Require().NoError(err)
+
+		mempool := s.setUpMempool()
+		for _, tx := range []sdk.Tx{tx1, tx2} {
+			s.Require().NoError(mempool.Insert(s.ctx, tx))
+		}
+
+		// Unordered txs are not checked against the sequence of their signers.
+		s.sequences[s.accounts[1].Address.String()] = 5
+		defer func() { s.sequences = make(accountKeeper) }()
+
+		collector, err := gc.NewCollector(log.NewNopLogger(), mempool, s.sequences)
+		s.Require().NoError(err)
+
+		s.Require().Equal(1, collector.Collect(s.ctx))
+		s.Require().False(mempool.Contains(tx1))
+		s.Require().True(mempool.Contains(tx2))
+	})
+
+	s.Run("removes txs that exceed the ttl of their lane", func() {
+		tx1 := s.createTx(s.accounts[0], 0, 0)
+		tx2 := s.createTx(s.accounts[1], 0, 0)
+
+		mempool := s.setUpMempool()
+		for _, tx := range []sdk.Tx{tx1, tx2} {
+			s.Require().NoError(mempool.Insert(s.ctx, tx))
+		}
+
+		collector, err := gc.NewCollector(log.NewNopLogger(), mempool, s.sequences, gc.WithLaneTTL("first", time.Minute))
+		s.Require().NoError(err)
+
+		// The txs are first seen by the collector at the current block time.
+		s.Require().Zero(collector.Collect(s.ctx))
+
+		// A tx that is inserted later has its own TTL.
+		tx3 := s.createTx(s.accounts[0], 1, 0)
+		s.Require().NoError(mempool.Insert(s.ctx, tx3))
+		s.Require().Zero(collector.Collect(s.ctx.WithBlockTime(s.ctx.BlockTime().Add(30 * time.Second))))
+
+		s.Require().Equal(1, collector.Collect(s.ctx.WithBlockTime(s.ctx.BlockTime().Add(61*time.Second))))
+		s.Require().False(mempool.Contains(tx1))
+		s.Require().True(mempool.Contains(tx2))
+		s.Require().True(mempool.Contains(tx3))
+
+		s.Require().Equal(1, collector.Collect(s.ctx.WithBlockTime(s.ctx.BlockTime().Add(91*time.Second))))
+		s.Require().False(mempool.Contains(tx3))
+		s.Require().True(mempool.Contains(tx2))
+	})
+
+	s.Run("removes stale queued txs", func() {
+		tx1 := s.createTx(s.accounts[0], 2, 10)
+		tx2 := s.createTx(s.accounts[0], 3, 0)
+
+		mempool := s.setUpQueuedMempool()
+		for _, tx := range []sdk.Tx{tx1, tx2} {
+			s.Require().NoError(mempool.Insert(s.ctx, tx))
+		}
+
+		pool, ok := mempool.Registry()[0].(block.QueuedPool)
+		s.Require().True(ok)
+		pending, queued, ok := pool.CountPoolTxs()
+		s.Require().True(ok)
+		s.Require().Zero(pending)
+		s.Require().Equal(2, queued)
+
+		collector, err := gc.NewCollector(log.NewNopLogger(), mempool, s.sequences, gc.WithLaneTTL("first", time.Minute))
+		s.Require().NoError(err)
+
+		s.Require().Equal(1, collector.Collect(s.ctx))
+		s.Require().False(mempool.Contains(tx1))
+		s.Require().True(mempool.Contains(tx2))
+
+		s.Require().Equal(1, collector.Collect(s.ctx.WithBlockTime(s.ctx.BlockTime().Add(61*time.Second))))
+		s.Require().False(mempool.Contains(tx2))
+	})
+}
+
+func (s *GCTestSuite) TestPrepareCheckStater() {
+	tx := s.createTx(s.accounts[1], 0, 0)
+
+	mempool := s.setUpMempool()
+	s.Require().NoError(mempool.Insert(s.ctx, tx))
+
+	s.sequences[s.accounts[1].Address.String()] = 1
+
+	collector, err := gc.NewCollector(log.NewNopLogger(), mempool, s.sequences)
+	s.Require().NoError(err)
+
+	var called int
+	collector.PrepareCheckStater(func(sdk.Context) { called++ })(s.ctx)
+
+	s.Require().Equal(1, called)
+	s.Require().False(mempool.Contains(tx))
+}
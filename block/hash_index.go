@@ -0,0 +1,136 @@
+package block
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+// ContainsHash returns true if the mempool contains the transaction with the given hash,
+// i.e. the hex-encoded hash of the transaction's bytes as used by CometBFT (see
+// utils.TxHash). Like Contains, a different transaction with the same signer and sequence
+// number does not match.
+func (m *LanedMempool) ContainsHash(hash string) bool {
+	m.mtx.RLock()
+	defer m.mtx.RUnlock()
+
+	_, _, err := m.lookupHash(hash)
+	return err == nil
+}
+
+// GetTx returns the transaction in the mempool with the given hash. It returns
+// ErrTxNotFound if the mempool does not contain the transaction.
+func (m *LanedMempool) GetTx(hash string) (sdk.Tx, error) {
+	m.mtx.RLock()
+	defer m.mtx.RUnlock()
+
+	_, tx, err := m.lookupHash(hash)
+	return tx, err
+}
+
+// LookupTx returns the transaction in the mempool with the given hash along with the lane
+// that contains it. It returns ErrTxNotFound if the mempool does not contain the
+// transaction.
+func (m *LanedMempool) LookupTx(hash string) (Lane, sdk.Tx, error) {
+	m.mtx.RLock()
+	defer m.mtx.RUnlock()
+
+	return m.lookupHash(hash)
+}
+
+// RemoveByHash removes the transaction with the given hash from the mempool. It returns
+// ErrTxNotFound if the mempool does not contain the transaction.
+func (m *LanedMempool) RemoveByHash(hash string) error {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	lane, tx, err := m.lookupHash(hash)
+	if err != nil {
+		return err
+	}
+
+	if err := lane.Remove(tx); err != nil {
+		return err
+	}
+
+	m.metrics.SetLaneSize(lane.Name(), lane.CountTx())
+
+	return nil
+}
+
+// trackLanes registers the hooks that keep the hash index of the mempool and the size of its
+// transactions exact with the lanes that report their transactions (see TxTracker). Since
+// such lanes report every transaction they insert or remove, including the transactions
+// they insert or remove without going through the mempool (e.g. when preparing a proposal),
+// the index never holds stale entries. The lanes that do not report their transactions are
+// searched by hash instead (see lookupHash).
+func (m *LanedMempool) trackLanes() {
+	for _, lane := range m.registry {
+		tracker, ok := lane.(TxTracker)
+		if ok && tracker.SetTxHooks(m.txHooks(lane)) {
+			continue
+		}
+
+		m.untracked = append(m.untracked, lane)
+	}
+}
+
+// txHooks returns the hooks that index the transactions inserted into and removed from the
+// given lane by hash and account for their size. The hooks are called while the lane is
+// locked, so they only take the lock of the index.
+func (m *LanedMempool) txHooks(lane Lane) TxHooks {
+	return TxHooks{
+		OnInsert: func(event TxEvent) {
+			m.indexMtx.Lock()
+			defer m.indexMtx.Unlock()
+
+			if _, ok := m.hashIndex[event.Hash]; ok {
+				return
+			}
+
+			m.hashIndex[event.Hash] = lane
+			m.txBytes += event.Size
+			m.laneBytes[lane.Name()] += event.Size
+		},
+		OnRemove: func(event TxEvent) {
+			m.indexMtx.Lock()
+			defer m.indexMtx.Unlock()
+
+			if indexed, ok := m.hashIndex[event.Hash]; ok && indexed.Name() == lane.Name() {
+				delete(m.hashIndex, event.Hash)
+				m.txBytes -= event.Size
+				m.laneBytes[lane.Name()] -= event.Size
+			}
+		},
+	}
+}
+
+// lookupHash returns the transaction with the given hash and the lane that contains it. The
+// lanes that report their transactions are looked up in the hash index of the mempool, the
+// other lanes are searched with their own hash index (see TxHashIndex), if any. The caller
+// must hold the lock.
+func (m *LanedMempool) lookupHash(hash string) (Lane, sdk.Tx, error) {
+	m.indexMtx.Lock()
+	lane, ok := m.hashIndex[hash]
+	m.indexMtx.Unlock()
+
+	// The lane is not called while holding the lock of the index, since the lane calls
+	// the hooks of the index while it is locked.
+	if index, isIndex := lane.(TxHashIndex); ok && isIndex {
+		if tx, found := index.LookupHash(hash); found {
+			return lane, tx, nil
+		}
+	}
+
+	for _, lane := range m.untracked {
+		index, ok := lane.(TxHashIndex)
+		if !ok {
+			continue
+		}
+
+		if tx, found := index.LookupHash(hash); found {
+			return lane, tx, nil
+		}
+	}
+
+	return nil, nil, sdkmempool.ErrTxNotFound
+}
@@ -0,0 +1,109 @@
+package block
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+var _ sdkmempool.Iterator = (*MempoolIterator)(nil)
+
+type (
+	// SelectFilter determines whether a transaction that belongs to the given lane
+	// should be returned by the mempool iterator. Returning false skips the transaction.
+	SelectFilter func(lane Lane, tx sdk.Tx) bool
+
+	// MempoolIterator is an iterator over all of the transactions in the laned mempool.
+	// Lanes are walked in the order in which they are registered and transactions within
+	// a lane are returned in the order defined by the lane's own Select implementation
+	// (e.g. priority and sender-nonce for the PriorityNonceMempool).
+	//
+	// The transactions of a lane are read when the iterator first reaches the lane. Any
+	// transaction that is removed from the lane after that point is skipped, so callers
+	// are free to remove transactions from the mempool while iterating.
+	MempoolIterator struct {
+		ctx    context.Context
+		txs    [][]byte
+		lanes  []Lane
+		filter SelectFilter
+
+		// laneIndex is the index of the lane currently being iterated over.
+		laneIndex int
+		// laneTxs are the transactions of the current lane in the lane's order.
+		laneTxs []sdk.Tx
+		// txIndex is the index of the current transaction in laneTxs.
+		txIndex int
+	}
+)
+
+// NewMempoolIterator returns an iterator over the transactions of the given lanes. If
+// a filter is provided, only transactions accepted by the filter are returned. Nil is
+// returned if there are no transactions to iterate over.
+func NewMempoolIterator(
+	ctx context.Context,
+	lanes []Lane,
+	txs [][]byte,
+	filter SelectFilter,
+) sdkmempool.Iterator {
+	iterator := &MempoolIterator{
+		ctx:       ctx,
+		txs:       txs,
+		lanes:     lanes,
+		filter:    filter,
+		laneIndex: -1,
+	}
+
+	return iterator.advance()
+}
+
+// Next returns the next transaction in the mempool. Nil is returned once all lanes
+// have been exhausted.
+func (i *MempoolIterator) Next() sdkmempool.Iterator {
+	i.txIndex++
+	return i.advance()
+}
+
+// Tx returns the current transaction.
+func (i *MempoolIterator) Tx() sdk.Tx {
+	return i.laneTxs[i.txIndex]
+}
+
+// Lane returns the lane that the current transaction belongs to.
+func (i *MempoolIterator) Lane() Lane {
+	return i.lanes[i.laneIndex]
+}
+
+// advance moves the iterator to the next transaction that is still contained in its
+// lane and accepted by the filter, moving on to the next lane in the registry once the
+// current one is exhausted.
+func (i *MempoolIterator) advance() sdkmempool.Iterator {
+	for {
+		for ; i.txIndex < len(i.laneTxs); i.txIndex++ {
+			lane := i.lanes[i.laneIndex]
+			tx := i.laneTxs[i.txIndex]
+
+			// The transaction may have been removed since the lane was read.
+			if !lane.Contains(tx) {
+				continue
+			}
+
+			if i.filter != nil && !i.filter(lane, tx) {
+				continue
+			}
+
+			return i
+		}
+
+		i.laneIndex++
+		if i.laneIndex >= len(i.lanes) {
+			return nil
+		}
+
+		i.txIndex = 0
+		i.laneTxs = i.laneTxs[:0]
+		for iterator := i.lanes[i.laneIndex].Select(i.ctx, i.txs); iterator != nil; iterator = iterator.Next() {
+			i.laneTxs = append(i.laneTxs, iterator.Tx())
+		}
+	}
+}
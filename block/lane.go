@@ -1,6 +1,9 @@
 package block
 
 import (
+	"context"
+	"errors"
+
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
@@ -9,6 +12,11 @@ import (
 	"github.com/skip-mev/block-sdk/v2/block/utils"
 )
 
+// ErrProcessLaneBasicNotSupported is returned by lanes whose stateless proposal verification
+// cannot be run separately from their stateful verification. Such lanes are always verified
+// with ProcessLane.
+var ErrProcessLaneBasicNotSupported = errors.New("lane does not support basic proposal verification")
+
 // LaneMempool defines the interface a lane's mempool should implement. The basic API
 // is the same as the sdk.Mempool, but it also includes a Compare function that is used
 // to determine the relative priority of two transactions belonging in the same lane.
@@ -29,6 +37,122 @@ type LaneMempool interface {
 	Priority(ctx sdk.Context, tx sdk.Tx) any
 }
 
+// TxReplacer is an optional interface implemented by lanes (and lane mempools) that keep
+// track of which transaction holds a given signer and sequence number. It is used by the
+// check tx handlers to replace transactions (e.g. replace-by-fee) and to drop the replaced
+// transactions from the CometBFT mempool.
+type TxReplacer interface {
+	// Lookup returns the transaction in the mempool with the same signer and sequence number
+	// as the given transaction, if any.
+	Lookup(tx sdk.Tx) (sdk.Tx, bool)
+
+	// AllowsReplacement returns true if the new transaction can replace the old transaction,
+	// i.e. the transaction in the mempool with the same signer and sequence number.
+	AllowsReplacement(oldTx, newTx sdk.Tx) bool
+}
+
+// TxHashIndex is an optional interface implemented by lanes (and lane mempools) that index their
+// transactions by hash, i.e. the hex-encoded hash of the transaction's bytes as used by CometBFT
+// (see utils.TxHash). Unlike Contains, which matches transactions by signer and sequence number,
+// a hash only matches the exact same transaction. It is used by the hash index of the
+// LanedMempool (see LanedMempool.GetTx).
+type TxHashIndex interface {
+	// TxHash returns the hash of the transaction.
+	TxHash(tx sdk.Tx) (string, error)
+
+	// LookupHash returns the transaction with the given hash, if any.
+	LookupHash(hash string) (sdk.Tx, bool)
+}
+
+// TxTracker is an optional interface implemented by lanes (and lane mempools) that report every
+// transaction inserted into or removed from them. This includes the transactions the lane
+// inserts or removes by itself (e.g. when preparing a proposal or evicting transactions) and the
+// transactions inserted into the lane directly (e.g. by the MEV check tx handler). The
+// LanedMempool uses it to keep its hash index exact.
+type TxTracker interface {
+	// SetTxHooks sets the hooks that are called with the transactions inserted into and removed
+	// from the lane. It returns false if the lane cannot report its transactions. The hooks are
+	// called while the lane is locked, so they must not call the lane.
+	SetTxHooks(hooks TxHooks) bool
+}
+
+// TxHooks are called with the transactions inserted into and removed from a lane (see
+// TxTracker). A transaction that replaces a transaction with the same signer and sequence
+// number is reported after the replaced transaction is reported as removed.
+type TxHooks struct {
+	// OnInsert is called with each transaction inserted into the lane.
+	OnInsert func(event TxEvent)
+
+	// OnRemove is called with each transaction removed from the lane.
+	OnRemove func(event TxEvent)
+}
+
+// TxEvent defines a transaction that was inserted into or removed from a lane.
+type TxEvent struct {
+	// Tx is the transaction stored in the lane.
+	Tx sdk.Tx
+
+	// Hash is the hex-encoded hash of the transaction's bytes (see utils.TxHash).
+	Hash string
+
+	// Size is the size of the transaction's bytes.
+	Size int64
+}
+
+// TxSenderIndex is an optional interface implemented by lanes (and lane mempools) that index
+// their transactions by signer. It is used to find the transactions of a signer without
+// walking the whole lane, e.g. to evict the later transactions of a signer along with an
+// evicted transaction (see EvictionPolicy).
+type TxSenderIndex interface {
+	// SenderTxs returns the transactions of the signer in the mempool (including queued
+	// transactions), ordered by sequence number. Unordered transactions are not included.
+	SenderTxs(signer sdk.AccAddress) []sdk.Tx
+}
+
+// TxPriorityIndex is an optional interface implemented by lanes (and lane mempools) that index
+// their transactions by priority. It is used to find the lowest priority transactions of a lane
+// without walking the whole lane, e.g. to select the transactions to evict (see
+// EvictionPolicy).
+type TxPriorityIndex interface {
+	// LowestPriorityTxs returns up to limit transactions of the mempool (excluding queued
+	// transactions), ordered from the lowest to the highest priority, after skipping the
+	// offset lowest priority transactions.
+	LowestPriorityTxs(offset, limit int) []sdk.Tx
+}
+
+// TxEvictor is an optional interface implemented by lanes (and lane mempools) that evict lower
+// priority transactions to make room for new transactions once they are full (e.g. see
+// base.LaneConfig.EvictLowerPriority). The LanedMempool inserts transactions with
+// InsertWithEviction, such that its indexes and eviction handler learn about the evicted
+// transactions.
+type TxEvictor interface {
+	// InsertWithEviction inserts the transaction and returns the transactions that were
+	// evicted to make room for it.
+	InsertWithEviction(ctx context.Context, tx sdk.Tx) ([]sdk.Tx, error)
+}
+
+// QueuedPoolSuffix is appended to the name of a lane to report the number of transactions in
+// its queued pool (see QueuedPool and LanedMempool.GetTxDistribution).
+const QueuedPoolSuffix = "/queued"
+
+// QueuedPool is an optional interface implemented by lanes (and lane mempools) that hold the
+// transactions whose sequence number is above the next sequence number of their signer in a
+// separate queued pool, like Ethereum clients do. Queued transactions are not selected for
+// proposals; they are moved to the pending pool once the sequence gap closes.
+type QueuedPool interface {
+	// PromoteQueuedTxs moves the queued transactions whose sequence gap has closed to the
+	// pending pool.
+	PromoteQueuedTxs(ctx sdk.Context)
+
+	// CountPoolTxs returns the number of pending and queued transactions. It returns false
+	// if there is no queued pool.
+	CountPoolTxs() (pending, queued int, ok bool)
+
+	// SelectQueued returns an iterator over the queued transactions, which are not returned
+	// by Select. It returns nil if there are no queued transactions.
+	SelectQueued(ctx context.Context) sdkmempool.Iterator
+}
+
 // Lane defines an interface used for matching transactions to lanes, storing transactions,
 // and constructing partial blocks.
 //
@@ -64,6 +188,10 @@ type Lane interface {
 	// SetMaxBlockSpace sets the max block space for the lane as a relative percentage.
 	SetMaxBlockSpace(math.LegacyDec)
 
+	// GetBlockSpace returns the byte, gas and transaction count budgets of the lane. Unless
+	// configured otherwise, the byte and gas budgets are both equal to the max block space.
+	GetBlockSpace() proposals.BlockSpace
+
 	// Name returns the name of the lane.
 	Name() string
 
@@ -76,6 +204,50 @@ type Lane interface {
 	GetTxInfo(ctx sdk.Context, tx sdk.Tx) (utils.TxWithInfo, error)
 }
 
+// TxVerifier is an optional interface implemented by lanes that can verify a single transaction
+// against state. It is used to verify transactions outside of proposals, e.g. transactions
+// restored from a mempool snapshot.
+type TxVerifier interface {
+	// VerifyTx verifies that the transaction is valid respecting the stateful verification logic
+	// of the lane (e.g. the ante handler).
+	VerifyTx(ctx sdk.Context, tx sdk.Tx, simulate bool) error
+}
+
+// ParallelProcessLane is an optional interface implemented by lanes whose verification of a
+// proposal can be split into stateless checks of the lane's portion of the proposal and the
+// stateful verification of each of its transactions. It is used to verify the lanes of proposals
+// that include the proposal info concurrently (see abci.ProcessLanesInParallel). Other lanes are
+// verified sequentially with ProcessLane.
+type ParallelProcessLane interface {
+	TxVerifier
+
+	// ProcessLaneBasic verifies the stateless invariants of this lane's portion of a proposed block
+	// (e.g. that the transactions belong to the lane and are ordered correctly). It inputs the
+	// transactions that belong to this lane and the transactions that belong to the lanes after it.
+	// This must not read from or write to state as it may be called concurrently for every lane.
+	// Lanes that cannot separate their stateless checks must return ErrProcessLaneBasicNotSupported.
+	ProcessLaneBasic(ctx sdk.Context, partialProposal []sdk.Tx, remainingTxs []sdk.Tx) error
+}
+
+// BlockSpaceResolver is implemented by lanes that can compute their block space for a max
+// block space other than the one they are configured with (e.g. the max block space of the
+// lane stored on-chain). Budgets the lane configures independently of its max block space
+// are kept as is.
+type BlockSpaceResolver interface {
+	GetBlockSpaceFor(maxBlockSpace math.LegacyDec) proposals.BlockSpace
+}
+
+// PartialProposalCounter is an optional interface implemented by lanes whose partial proposals
+// include transactions the lane does not match on their own, e.g. the bundled transactions of
+// an auction bid. It is used to attribute the transactions of a committed block to lanes the
+// same way the lanes verify them in ProcessLane. For other lanes, the partial proposal is made
+// up of the leading transactions the lane matches.
+type PartialProposalCounter interface {
+	// CountPartialProposal returns the number of leading transactions of txs that make up the
+	// lane's partial proposal.
+	CountPartialProposal(ctx sdk.Context, txs []sdk.Tx) int
+}
+
 // FindLane finds a Lanes from in an array of Lanes and returns it and its index if found.
 // Returns nil, 0 and false if not found.
 func FindLane(lanes []Lane, name string) (lane Lane, index int, found bool) {
@@ -3,11 +3,16 @@ package block
 import (
 	"context"
 	"fmt"
+	"sync"
 
 	"cosmossdk.io/log"
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+
+	"github.com/skip-mev/block-sdk/v2/block/metrics"
+	"github.com/skip-mev/block-sdk/v2/block/proposals"
+	blocksdktypes "github.com/skip-mev/block-sdk/v2/x/blocksdk/types"
 )
 
 var _ Mempool = (*LanedMempool)(nil)
@@ -19,24 +24,115 @@ type (
 
 		// Registry returns the lanes in the mempool.
 		Registry() []Lane
+		// GetProposalLanes returns the lanes that build and verify proposals, ordered and
+		// configured according to the lane configurations stored on-chain (if any).
+		GetProposalLanes(ctx sdk.Context) ProposalLanes
 		// Contains returns true if any of the lanes currently contain the transaction.
 		Contains(tx sdk.Tx) bool
-		// GetTxDistribution returns the number of transactions in each lane.
+		// ContainsHash returns true if any of the lanes currently contain the transaction
+		// with the given hash.
+		ContainsHash(hash string) bool
+		// GetTx returns the transaction with the given hash.
+		GetTx(hash string) (sdk.Tx, error)
+		// LookupTx returns the transaction with the given hash and the lane that contains it.
+		LookupTx(hash string) (Lane, sdk.Tx, error)
+		// RemoveByHash removes the transaction with the given hash.
+		RemoveByHash(hash string) error
+		// GetTxDistribution returns the number of transactions in each lane (and in the
+		// queued pool of each lane that has one).
 		GetTxDistribution() map[string]uint64
+		// Snapshot returns a read-only copy of the transactions in the mempool.
+		Snapshot(ctx context.Context) *MempoolSnapshot
+	}
+
+	// LaneFetcher defines the interface used to retrieve the lane configurations
+	// stored on-chain (i.e. the x/blocksdk keeper).
+	LaneFetcher interface {
+		// GetLanes returns the lane configurations ordered by their order field.
+		GetLanes(ctx sdk.Context) ([]blocksdktypes.Lane, error)
+	}
+
+	// ProposalLanes are the lanes that build and verify a proposal along with the block
+	// space each lane uses in the proposal (see LanedMempool.GetProposalLanes).
+	ProposalLanes struct {
+		// Lanes are the lanes in the order in which they build and verify proposals.
+		Lanes []Lane
+		// BlockSpaces maps the name of each lane to the block space it uses in proposals.
+		// It is nil if the lanes use the block space they are configured with.
+		BlockSpaces map[string]proposals.BlockSpace
 	}
 
 	// LanedMempool defines the Block SDK mempool implementation. It contains a registry
 	// of lanes, which allows for customizable block proposal construction.
+	//
+	// The mempool is safe for concurrent use. Writes (Insert, Remove, ...)
+	// exclude each other, while reads (Contains, CountTx, GetTxDistribution, Snapshot, ...)
+	// run concurrently. Each lane's mempool is locked separately, so lanes can also be
+	// read and written directly (e.g. when preparing a proposal).
 	LanedMempool struct {
+		// mtx guards the registry and the indexes of the mempool.
+		mtx sync.RWMutex
+
 		logger log.Logger
 
 		// registry contains the lanes in the mempool. The lanes are ordered
 		// according to their priority. The first lane in the registry has the
 		// highest priority and the last lane has the lowest priority.
 		registry []Lane
+
+		// laneFetcher is used to retrieve the lane configurations stored on-chain.
+		// If nil, the lanes are used as configured at construction.
+		laneFetcher LaneFetcher
+
+		// metrics is used to report the number of transactions inserted into, rejected
+		// by and stored in each lane.
+		metrics metrics.Metrics
+
+		// capacity is the global capacity of the mempool. If nil, the mempool is only
+		// bounded by the capacity of each lane.
+		capacity *MempoolCapacity
+
+		// evictionHandler is called with the transactions that are evicted to make room
+		// for new transactions.
+		evictionHandler EvictionHandler
+
+		// indexMtx guards the hash index and the size of the transactions. It is separate
+		// from mtx since they are updated by the lanes (see TxTracker), which may be written
+		// without going through the mempool.
+		indexMtx sync.Mutex
+
+		// hashIndex maps the hash of each transaction in the lanes that report their
+		// transactions to the lane that contains it (see ContainsHash, GetTx and
+		// RemoveByHash).
+		hashIndex map[string]Lane
+
+		// txBytes and laneBytes are the total size of the transactions in the lanes that
+		// report their transactions (per lane).
+		txBytes   int64
+		laneBytes map[string]int64
+
+		// untracked are the lanes that do not report their transactions, which are not in
+		// the hash index.
+		untracked []Lane
 	}
+
+	// LanedMempoolOption defines a function that can be used to configure the
+	// laned mempool.
+	LanedMempoolOption func(*LanedMempool)
 )
 
+// WithMetrics sets the metrics the mempool reports to. By default, the mempool reports
+// to the Cosmos SDK telemetry.
+func WithMetrics(m metrics.Metrics) LanedMempoolOption {
+	return func(mempool *LanedMempool) {
+		if m == nil {
+			panic("metrics cannot be nil")
+		}
+
+		mempool.metrics = m
+	}
+}
+
 // NewLanedMempool returns a new Block SDK LanedMempool. The laned mempool comprises
 // a registry of lanes. Each lane is responsible for selecting transactions according
 // to its own selection logic. The lanes are ordered according to their priority. The
@@ -45,22 +141,74 @@ type (
 func NewLanedMempool(
 	logger log.Logger,
 	lanes []Lane,
+	opts ...LanedMempoolOption,
 ) (*LanedMempool, error) {
 	mempool := &LanedMempool{
-		logger:   logger,
-		registry: lanes,
+		logger:    logger,
+		registry:  lanes,
+		metrics:   metrics.NewTelemetryMetrics(),
+		hashIndex: make(map[string]Lane),
+		laneBytes: make(map[string]int64),
+	}
+
+	for _, opt := range opts {
+		opt(mempool)
 	}
 
 	if err := mempool.ValidateBasic(); err != nil {
 		return nil, err
 	}
 
+	mempool.trackLanes()
+
+	// The size of the transactions is only known for the lanes that report them.
+	if mempool.capacity != nil && mempool.capacity.MaxBytes > 0 && len(mempool.untracked) > 0 {
+		return nil, fmt.Errorf(
+			"lane %s cannot report its transactions, which is required by the max bytes of the mempool",
+			mempool.untracked[0].Name(),
+		)
+	}
+
+	return mempool, nil
+}
+
+// NewLanedMempoolWithLaneFetcher returns a new Block SDK LanedMempool whose lane order
+// and max block space are read from the on-chain lane configurations retrieved with the
+// given lane fetcher (i.e. the x/blocksdk keeper) each time a proposal is prepared or
+// processed. If there are no lane configurations stored on-chain, the lanes are used as
+// configured at construction.
+func NewLanedMempoolWithLaneFetcher(
+	logger log.Logger,
+	lanes []Lane,
+	laneFetcher LaneFetcher,
+	opts ...LanedMempoolOption,
+) (*LanedMempool, error) {
+	mempool, err := NewLanedMempool(logger, lanes, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if laneFetcher == nil {
+		return nil, fmt.Errorf("lane fetcher cannot be nil")
+	}
+
+	mempool.laneFetcher = laneFetcher
+
 	return mempool, nil
 }
 
 // CountTx returns the total number of transactions in the mempool. This will
 // be the sum of the number of transactions in each lane.
 func (m *LanedMempool) CountTx() int {
+	m.mtx.RLock()
+	defer m.mtx.RUnlock()
+
+	return m.countTx()
+}
+
+// countTx returns the total number of transactions in the mempool. The caller must hold
+// the lock.
+func (m *LanedMempool) countTx() int {
 	var total int
 	for _, lane := range m.registry {
 		total += lane.CountTx()
@@ -69,11 +217,24 @@ func (m *LanedMempool) CountTx() int {
 	return total
 }
 
-// GetTxDistribution returns the number of transactions in each lane.
+// GetTxDistribution returns the number of transactions in each lane. For lanes with a
+// queued pool (see QueuedPool), the lane's name maps to the number of pending transactions
+// and the lane's name with the QueuedPoolSuffix to the number of queued transactions.
 func (m *LanedMempool) GetTxDistribution() map[string]uint64 {
+	m.mtx.RLock()
+	defer m.mtx.RUnlock()
+
 	counts := make(map[string]uint64, len(m.registry))
 
 	for _, lane := range m.registry {
+		if pool, ok := lane.(QueuedPool); ok {
+			if pending, queued, ok := pool.CountPoolTxs(); ok {
+				counts[lane.Name()] = uint64(pending)
+				counts[lane.Name()+QueuedPoolSuffix] = uint64(queued)
+				continue
+			}
+		}
+
 		counts[lane.Name()] = uint64(lane.CountTx())
 	}
 
@@ -81,7 +242,9 @@ func (m *LanedMempool) GetTxDistribution() map[string]uint64 {
 }
 
 // Insert will insert a transaction into the mempool. It inserts the transaction
-// into the first lane that it matches.
+// into the first lane that it matches. Transactions that do not match any lane are
+// dropped. If the mempool has a capacity (see WithCapacity) and is full, transactions
+// are evicted according to the eviction policy or the transaction is rejected.
 func (m *LanedMempool) Insert(ctx context.Context, tx sdk.Tx) (err error) {
 	defer func() {
 		if r := recover(); r != nil {
@@ -90,28 +253,73 @@ func (m *LanedMempool) Insert(ctx context.Context, tx sdk.Tx) (err error) {
 		}
 	}()
 
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	for _, lane := range m.registry {
 		if lane.Match(sdkCtx, tx) {
-			return lane.Insert(ctx, tx)
+			var evictions []Eviction
+			if m.capacity != nil {
+				if evictions, err = m.ensureCapacity(sdkCtx, lane, tx); err != nil {
+					m.metrics.AddTxsRejected(lane.Name(), 1)
+					return err
+				}
+			}
+
+			// The transactions selected for eviction are only evicted once the transaction is
+			// inserted, such that nothing is evicted if the lane rejects it.
+			laneEvicted, err := m.insertIntoLane(ctx, lane, tx)
+			if err != nil {
+				m.metrics.AddTxsRejected(lane.Name(), 1)
+				return err
+			}
+
+			// Transactions evicted by the lane itself have already been removed from it.
+			m.handleEvictions(sdkCtx, lane, m.laneEvictions(sdkCtx, lane, laneEvicted))
+			m.evict(sdkCtx, lane, evictions)
+
+			m.metrics.AddTxsInserted(lane.Name(), 1)
+			m.metrics.SetLaneSize(lane.Name(), lane.CountTx())
+
+			return nil
 		}
 	}
 
+	m.metrics.AddTxsRejected("", 1)
+
 	return nil
 }
 
-// Select returns a nil iterator.
-//
-// TODO:
-// - Determine if it even makes sense to return an iterator. What does that even
-// mean in the context where you have multiple lanes?
-// - Perhaps consider implementing and returning a no-op iterator?
-func (m *LanedMempool) Select(_ context.Context, _ [][]byte) sdkmempool.Iterator {
-	return nil
+// insertIntoLane inserts the transaction into the lane and returns the transactions the lane
+// evicted to make room for it, if the lane evicts transactions (see TxEvictor).
+func (m *LanedMempool) insertIntoLane(ctx context.Context, lane Lane, tx sdk.Tx) ([]sdk.Tx, error) {
+	if evictor, ok := lane.(TxEvictor); ok {
+		return evictor.InsertWithEviction(ctx, tx)
+	}
+
+	return nil, lane.Insert(ctx, tx)
+}
+
+// Select returns an iterator over all of the transactions in the mempool. Lanes are
+// walked in the order in which they are registered and each lane's transactions are
+// returned in the lane's own priority order. Transactions can safely be removed from
+// the mempool while iterating, and the iterator can be used concurrently with writes to
+// the mempool.
+func (m *LanedMempool) Select(ctx context.Context, txs [][]byte) sdkmempool.Iterator {
+	return NewMempoolIterator(ctx, m.Registry(), txs, nil)
+}
+
+// SelectWithFilter returns an iterator over all of the transactions in the mempool that
+// are accepted by the given filter. See Select for the iteration order.
+func (m *LanedMempool) SelectWithFilter(ctx context.Context, txs [][]byte, filter SelectFilter) sdkmempool.Iterator {
+	return NewMempoolIterator(ctx, m.Registry(), txs, filter)
 }
 
 // Remove removes a transaction from the mempool. This assumes that the transaction
-// is contained in only one of the lanes.
+// is contained in only one of the lanes. A different transaction with the same signer
+// and sequence number is not removed. Use RemoveByHash if the hash of the transaction
+// is known.
 func (m *LanedMempool) Remove(tx sdk.Tx) (err error) {
 	defer func() {
 		if r := recover(); r != nil {
@@ -120,16 +328,25 @@ func (m *LanedMempool) Remove(tx sdk.Tx) (err error) {
 		}
 	}()
 
-	for _, lane := range m.registry {
-		if lane.Contains(tx) {
-			return lane.Remove(tx)
-		}
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	lane, found := m.findLane(tx)
+	if !found {
+		return nil
 	}
 
+	if err := lane.Remove(tx); err != nil {
+		return err
+	}
+
+	m.metrics.SetLaneSize(lane.Name(), lane.CountTx())
+
 	return nil
 }
 
-// Contains returns true if the transaction is contained in any of the lanes.
+// Contains returns true if the transaction is contained in any of the lanes. A different
+// transaction with the same signer and sequence number does not match.
 func (m *LanedMempool) Contains(tx sdk.Tx) (contains bool) {
 	defer func() {
 		if r := recover(); r != nil {
@@ -138,48 +355,218 @@ func (m *LanedMempool) Contains(tx sdk.Tx) (contains bool) {
 		}
 	}()
 
+	m.mtx.RLock()
+	defer m.mtx.RUnlock()
+
+	_, found := m.findLane(tx)
+	return found
+}
+
+// findLane returns the lane that contains the transaction. Lanes look up transactions by
+// signer and sequence number and only compare the hash of the transaction with the hash
+// of the stored transaction if they are not the same instance, so transactions are only
+// encoded if the lane holds a transaction with the same signer and sequence number. The
+// caller must hold the lock.
+func (m *LanedMempool) findLane(tx sdk.Tx) (Lane, bool) {
 	for _, lane := range m.registry {
 		if lane.Contains(tx) {
-			return true
+			return lane, true
 		}
 	}
 
-	return false
+	return nil, false
 }
 
 // Registry returns the lanes in the mempool.
 func (m *LanedMempool) Registry() []Lane {
+	m.mtx.RLock()
+	defer m.mtx.RUnlock()
+
 	return m.registry
 }
 
+// GetProposalLanes returns the lanes that build and verify proposals, ordered and configured
+// according to the lane configurations stored on-chain (if any). The max block space stored
+// on-chain for each lane is returned as the block space the lane uses in proposals (see
+// ProposalLanes.NewProposal); the lanes themselves are never modified, so they can be used
+// concurrently (e.g. by CheckTx).
+//
+// The lanes are used as configured at construction if no lane fetcher is set, if there are
+// no lane configurations stored on-chain, or if the lane configurations stored on-chain do
+// not match the lanes of the mempool or are invalid. Since the lanes of the mempool and the
+// state are the same for all validators, they all fall back in the same way instead of
+// failing to build and verify proposals.
+func (m *LanedMempool) GetProposalLanes(ctx sdk.Context) ProposalLanes {
+	registry := m.Registry()
+	static := ProposalLanes{Lanes: registry}
+
+	if m.laneFetcher == nil {
+		return static
+	}
+
+	configs, err := m.laneFetcher.GetLanes(ctx)
+	if err != nil {
+		m.logger.Error("failed to fetch lanes from state; using the lanes as configured", "err", err)
+		return static
+	}
+
+	if len(configs) == 0 {
+		return static
+	}
+
+	lanes, err := resolveLaneConfigs(registry, configs)
+	if err != nil {
+		m.logger.Error("invalid lane configuration in state; using the lanes as configured", "err", err)
+		return static
+	}
+
+	return lanes
+}
+
+// NewProposal returns a new empty proposal in which each lane uses the block space of the
+// proposal lanes.
+func (l ProposalLanes) NewProposal(logger log.Logger, maxBlockSize int64, maxGasLimit uint64) proposals.Proposal {
+	proposal := proposals.NewProposal(logger, maxBlockSize, maxGasLimit)
+	proposal.BlockSpaces = l.BlockSpaces
+
+	return proposal
+}
+
+// GetBlockSpace returns the block space the given lane uses in proposals.
+func (l ProposalLanes) GetBlockSpace(lane Lane) proposals.BlockSpace {
+	if space, ok := l.BlockSpaces[lane.Name()]; ok {
+		return space
+	}
+
+	return lane.GetBlockSpace()
+}
+
+// resolveLaneConfigs orders the lanes according to the given on-chain lane configurations and
+// computes the block space of each lane from its on-chain max block space. Every lane must
+// have a corresponding on-chain configuration (matched by name) and vice versa, and the
+// resulting lanes must be valid.
+func resolveLaneConfigs(registry []Lane, configs []blocksdktypes.Lane) (ProposalLanes, error) {
+	if len(configs) != len(registry) {
+		return ProposalLanes{}, fmt.Errorf(
+			"number of lanes in state (%d) does not match the number of lanes in the mempool (%d)",
+			len(configs),
+			len(registry),
+		)
+	}
+
+	lanes := ProposalLanes{
+		Lanes:       make([]Lane, len(configs)),
+		BlockSpaces: make(map[string]proposals.BlockSpace, len(configs)),
+	}
+	for i, config := range configs {
+		lane, _, found := FindLane(registry, config.Id)
+		if !found {
+			return ProposalLanes{}, fmt.Errorf("lane %s in state not found in the mempool", config.Id)
+		}
+
+		lanes.Lanes[i] = lane
+		lanes.BlockSpaces[lane.Name()] = blockSpaceFor(lane, config.MaxBlockSpace)
+	}
+
+	if err := validateLanes(lanes.Lanes, lanes.GetBlockSpace); err != nil {
+		return ProposalLanes{}, err
+	}
+
+	return lanes, nil
+}
+
+// blockSpaceFor returns the block space of the lane for the given max block space. Lanes that
+// do not implement BlockSpaceResolver use the max block space for both their byte and gas
+// budgets.
+func blockSpaceFor(lane Lane, maxBlockSpace math.LegacyDec) proposals.BlockSpace {
+	if resolver, ok := lane.(BlockSpaceResolver); ok {
+		return resolver.GetBlockSpaceFor(maxBlockSpace)
+	}
+
+	space := lane.GetBlockSpace()
+	space.MaxTxBytes = maxBlockSpace
+	space.MaxGasLimit = maxBlockSpace
+
+	return space
+}
+
 // ValidateBasic validates the mempools configuration. ValidateBasic ensures
-// the following:
+// the following for both the byte and gas budgets of the lanes:
 // - The sum of the lane max block space percentages is less than or equal to 1.
 // - There is no unused block space.
+// - The sum of the lane min block space percentages is less than or equal to 1.
+// - The min block space of a lane does not exceed its max block space.
 func (m *LanedMempool) ValidateBasic() error {
-	if len(m.registry) == 0 {
+	return validateLanes(m.registry, Lane.GetBlockSpace)
+}
+
+// validateLanes validates the given lanes (see ValidateBasic) using the given function to
+// retrieve the block space of each lane.
+func validateLanes(lanes []Lane, blockSpace func(Lane) proposals.BlockSpace) error {
+	if len(lanes) == 0 {
 		return fmt.Errorf("registry cannot be nil; must configure at least one lane")
 	}
 
-	sum := math.LegacyZeroDec()
-	seenZeroMaxBlockSpace := false
 	seenLanes := make(map[string]struct{})
+	bytesRatios := make([]math.LegacyDec, len(lanes))
+	minBytesRatios := make([]math.LegacyDec, len(lanes))
+	gasRatios := make([]math.LegacyDec, len(lanes))
+	minGasRatios := make([]math.LegacyDec, len(lanes))
 
-	for _, lane := range m.registry {
+	for i, lane := range lanes {
 		name := lane.Name()
 		if _, seen := seenLanes[name]; seen {
 			return fmt.Errorf("duplicate lane name %s", name)
 		}
 
-		maxBlockSpace := lane.GetMaxBlockSpace()
-		if seenZeroMaxBlockSpace && maxBlockSpace.IsZero() {
+		space := blockSpace(lane)
+		bytesRatios[i] = space.MaxTxBytes
+		minBytesRatios[i] = space.MinTxBytes
+		gasRatios[i] = space.MaxGasLimit
+		minGasRatios[i] = space.MinGasLimit
+		seenLanes[name] = struct{}{}
+	}
+
+	if err := validateBlockSpaceRatios(bytesRatios, minBytesRatios); err != nil {
+		return fmt.Errorf("invalid max block space (bytes): %w", err)
+	}
+
+	if err := validateBlockSpaceRatios(gasRatios, minGasRatios); err != nil {
+		return fmt.Errorf("invalid max block space (gas): %w", err)
+	}
+
+	return nil
+}
+
+// validateBlockSpaceRatios ensures that the given max block space ratios sum to at most 1,
+// that only one ratio is unlimited (zero) and that there is no unused block space. The min
+// (reserved) ratios, which are optional, must sum to at most 1 and cannot exceed the max
+// ratio of their lane.
+func validateBlockSpaceRatios(ratios, minRatios []math.LegacyDec) error {
+	sum := math.LegacyZeroDec()
+	minSum := math.LegacyZeroDec()
+	seenZeroMaxBlockSpace := false
+
+	for i, ratio := range ratios {
+		if ratio.IsNil() {
+			return fmt.Errorf("max block space cannot be nil")
+		}
+
+		if seenZeroMaxBlockSpace && ratio.IsZero() {
 			return fmt.Errorf("only one lane can have unlimited max block space")
-		} else if maxBlockSpace.IsZero() {
+		} else if ratio.IsZero() {
 			seenZeroMaxBlockSpace = true
 		}
 
-		sum = sum.Add(lane.GetMaxBlockSpace())
-		seenLanes[name] = struct{}{}
+		sum = sum.Add(ratio)
+
+		if minRatio := minRatios[i]; !minRatio.IsNil() {
+			if !ratio.IsZero() && minRatio.GT(ratio) {
+				return fmt.Errorf("min block space %s cannot exceed max block space %s", minRatio, ratio)
+			}
+
+			minSum = minSum.Add(minRatio)
+		}
 	}
 
 	switch {
@@ -190,6 +577,9 @@ func (m *LanedMempool) ValidateBasic() error {
 	// Ensure that there is no unused block space.
 	case sum.LT(math.LegacyOneDec()) && !seenZeroMaxBlockSpace:
 		return fmt.Errorf("sum of total block space percentages will be less than 1")
+	// Ensure that the reserved block space does not exceed the block.
+	case minSum.GT(math.LegacyOneDec()):
+		return fmt.Errorf("sum of lane min block space percentages must be less than or equal to 1, got %s", minSum)
 	}
 
 	return nil
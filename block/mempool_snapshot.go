@@ -0,0 +1,134 @@
+package block
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+var _ sdkmempool.Iterator = (*snapshotIterator)(nil)
+
+type (
+	// MempoolSnapshot is a read-only copy of the transactions in the laned mempool (see
+	// LanedMempool.Snapshot). Since it does not reference the mempool's indexes, it can be
+	// read (e.g. by queries or metrics) while transactions continue to be inserted into and
+	// removed from the mempool.
+	MempoolSnapshot struct {
+		// Lanes are the snapshots of the lanes, in the order in which the lanes are
+		// registered.
+		Lanes []LaneSnapshot
+	}
+
+	// LaneSnapshot is a read-only copy of the transactions in a lane.
+	LaneSnapshot struct {
+		// Lane is the lane that the transactions belong to.
+		Lane Lane
+
+		// Txs are the (pending) transactions of the lane, in the lane's own order.
+		Txs []sdk.Tx
+
+		// NumQueued is the number of transactions in the queued pool of the lane (see
+		// QueuedPool). It is only set if HasQueuedPool is true.
+		NumQueued int
+
+		// HasQueuedPool is true if the lane has a queued pool.
+		HasQueuedPool bool
+	}
+
+	// snapshotIterator is an iterator over the transactions of a mempool snapshot.
+	snapshotIterator struct {
+		lanes     []LaneSnapshot
+		laneIndex int
+		txIndex   int
+	}
+)
+
+// Snapshot returns a read-only copy of the transactions in the mempool. The snapshot is
+// consistent with respect to the writes made through the mempool, i.e. no transaction is
+// inserted or removed through the mempool while the snapshot is taken. Transactions that
+// are inserted into or removed from the lanes directly while the snapshot is taken may or
+// may not be included.
+func (m *LanedMempool) Snapshot(ctx context.Context) *MempoolSnapshot {
+	m.mtx.RLock()
+	defer m.mtx.RUnlock()
+
+	snapshot := &MempoolSnapshot{
+		Lanes: make([]LaneSnapshot, len(m.registry)),
+	}
+
+	for i, lane := range m.registry {
+		laneSnapshot := LaneSnapshot{Lane: lane}
+		for iterator := lane.Select(ctx, nil); iterator != nil; iterator = iterator.Next() {
+			laneSnapshot.Txs = append(laneSnapshot.Txs, iterator.Tx())
+		}
+
+		if pool, ok := lane.(QueuedPool); ok {
+			_, laneSnapshot.NumQueued, laneSnapshot.HasQueuedPool = pool.CountPoolTxs()
+		}
+
+		snapshot.Lanes[i] = laneSnapshot
+	}
+
+	return snapshot
+}
+
+// CountTx returns the number of transactions in the snapshot, including the queued
+// transactions.
+func (s *MempoolSnapshot) CountTx() int {
+	var total int
+	for _, lane := range s.Lanes {
+		total += len(lane.Txs) + lane.NumQueued
+	}
+
+	return total
+}
+
+// GetTxDistribution returns the number of transactions in each lane of the snapshot, in the
+// same format as LanedMempool.GetTxDistribution.
+func (s *MempoolSnapshot) GetTxDistribution() map[string]uint64 {
+	counts := make(map[string]uint64, len(s.Lanes))
+	for _, lane := range s.Lanes {
+		counts[lane.Lane.Name()] = uint64(len(lane.Txs))
+
+		if lane.HasQueuedPool {
+			counts[lane.Lane.Name()+QueuedPoolSuffix] = uint64(lane.NumQueued)
+		} else {
+			counts[lane.Lane.Name()] += uint64(lane.NumQueued)
+		}
+	}
+
+	return counts
+}
+
+// Select returns an iterator over the (pending) transactions of the snapshot, in the same
+// order as LanedMempool.Select. Nil is returned if the snapshot does not have any
+// transactions.
+func (s *MempoolSnapshot) Select() sdkmempool.Iterator {
+	iterator := &snapshotIterator{lanes: s.Lanes, txIndex: -1}
+	return iterator.Next()
+}
+
+// Next returns the next transaction in the snapshot.
+func (i *snapshotIterator) Next() sdkmempool.Iterator {
+	i.txIndex++
+	for ; i.laneIndex < len(i.lanes); i.laneIndex++ {
+		if i.txIndex < len(i.lanes[i.laneIndex].Txs) {
+			return i
+		}
+
+		i.txIndex = 0
+	}
+
+	return nil
+}
+
+// Tx returns the current transaction.
+func (i *snapshotIterator) Tx() sdk.Tx {
+	return i.lanes[i.laneIndex].Txs[i.txIndex]
+}
+
+// Lane returns the lane that the current transaction belongs to.
+func (i *snapshotIterator) Lane() Lane {
+	return i.lanes[i.laneIndex].Lane
+}
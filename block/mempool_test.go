@@ -1,7 +1,10 @@
 package block_test
 
 import (
+	"context"
+	"fmt"
 	"math/rand"
+	"sync"
 	"testing"
 	"time"
 
@@ -10,18 +13,39 @@ import (
 	storetypes "cosmossdk.io/store/types"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+	"github.com/huandu/skiplist"
 	"github.com/stretchr/testify/suite"
 
 	signer_extraction "github.com/skip-mev/block-sdk/v2/adapters/signer_extraction_adapter"
 	"github.com/skip-mev/block-sdk/v2/block"
 	"github.com/skip-mev/block-sdk/v2/block/base"
+	metricsmocks "github.com/skip-mev/block-sdk/v2/block/metrics/mocks"
+	"github.com/skip-mev/block-sdk/v2/block/mocks"
+	"github.com/skip-mev/block-sdk/v2/block/proposals"
+	"github.com/skip-mev/block-sdk/v2/block/utils"
 	defaultlane "github.com/skip-mev/block-sdk/v2/lanes/base"
 	"github.com/skip-mev/block-sdk/v2/lanes/free"
 	"github.com/skip-mev/block-sdk/v2/lanes/mev"
 	testutils "github.com/skip-mev/block-sdk/v2/testutils"
 	auctiontypes "github.com/skip-mev/block-sdk/v2/x/auction/types"
+	blocksdktypes "github.com/skip-mev/block-sdk/v2/x/blocksdk/types"
 )
 
+// accountKeeper is a static base.AccountKeeper used to mock the sequence numbers of accounts.
+type accountKeeper map[string]uint64
+
+func (k accountKeeper) GetSequence(_ context.Context, addr sdk.AccAddress) (uint64, error) {
+	return k[addr.String()], nil
+}
+
+// laneFetcher is a static block.LaneFetcher used to mock the on-chain lane configurations.
+type laneFetcher []blocksdktypes.Lane
+
+func (f laneFetcher) GetLanes(_ sdk.Context) ([]blocksdktypes.Lane, error) {
+	return f, nil
+}
+
 type BlockBusterTestSuite struct {
 	suite.Suite
 	ctx sdk.Context
@@ -250,6 +274,84 @@ func (suite *BlockBusterTestSuite) TestNewMempool() {
 		suite.Require().Error(err)
 	})
 
+	suite.Run("works with independent byte and gas budgets", func() {
+		mevConfig := baseConfig
+		mevConfig.MaxBlockSpaceBytes = math.LegacyMustNewDecFromStr("0.5")
+		mevConfig.MaxBlockSpaceGas = math.LegacyMustNewDecFromStr("0.1")
+
+		freeConfig := baseConfig
+		freeConfig.MaxBlockSpaceBytes = math.LegacyMustNewDecFromStr("0.1")
+		freeConfig.MaxBlockSpaceGas = math.LegacyMustNewDecFromStr("0.6")
+
+		lanes := []block.Lane{
+			mev.NewMEVLane(mevConfig, factory, factory.MatchHandler()),
+			free.NewFreeLane(freeConfig, base.DefaultTxPriority(), free.DefaultMatchHandler()),
+			defaultLane,
+		}
+
+		_, err := block.NewLanedMempool(
+			log.NewNopLogger(),
+			lanes,
+		)
+		suite.Require().NoError(err)
+	})
+
+	suite.Run("invalid total gas space", func() {
+		mevConfig := baseConfig
+		mevConfig.MaxBlockSpaceGas = math.LegacyMustNewDecFromStr("0.6")
+
+		freeConfig := baseConfig
+		freeConfig.MaxBlockSpaceGas = math.LegacyMustNewDecFromStr("0.6")
+
+		lanes := []block.Lane{
+			mev.NewMEVLane(mevConfig, factory, factory.MatchHandler()),
+			free.NewFreeLane(freeConfig, base.DefaultTxPriority(), free.DefaultMatchHandler()),
+			defaultLane,
+		}
+
+		_, err := block.NewLanedMempool(
+			log.NewNopLogger(),
+			lanes,
+		)
+		suite.Require().Error(err)
+	})
+
+	suite.Run("min block space exceeds max block space", func() {
+		mevConfig := baseConfig
+		mevConfig.MinBlockSpace = math.LegacyMustNewDecFromStr("0.4")
+
+		lanes := []block.Lane{
+			mev.NewMEVLane(mevConfig, factory, factory.MatchHandler()),
+			defaultLane,
+		}
+
+		_, err := block.NewLanedMempool(
+			log.NewNopLogger(),
+			lanes,
+		)
+		suite.Require().Error(err)
+	})
+
+	suite.Run("works with reserved block space", func() {
+		mevConfig := baseConfig
+		mevConfig.MinBlockSpace = math.LegacyMustNewDecFromStr("0.2")
+
+		reservedDefaultConfig := defaultConfig
+		reservedDefaultConfig.MinBlockSpace = math.LegacyMustNewDecFromStr("0.5")
+
+		lanes := []block.Lane{
+			mev.NewMEVLane(mevConfig, factory, factory.MatchHandler()),
+			freeLane,
+			defaultlane.NewDefaultLane(reservedDefaultConfig, base.DefaultMatchHandler()),
+		}
+
+		_, err := block.NewLanedMempool(
+			log.NewNopLogger(),
+			lanes,
+		)
+		suite.Require().NoError(err)
+	})
+
 	suite.Run("duplicate lanes", func() {
 		lanes := []block.Lane{mevLane, defaultLane, mevLane}
 
@@ -467,7 +569,965 @@ func (suite *BlockBusterTestSuite) TestRemove() {
 	}
 }
 
+func (suite *BlockBusterTestSuite) TestSelect() {
+	suite.Run("returns nil with an empty mempool", func() {
+		suite.SetupTest() // reset
+
+		suite.Require().Nil(suite.mempool.Select(suite.ctx, nil))
+	})
+
+	suite.Run("iterates over lanes in registry order", func() {
+		suite.SetupTest() // reset
+
+		suite.fillBaseLane(10)
+		suite.fillTOBLane(10)
+		suite.fillFreeLane(10)
+
+		// Each lane's transactions must be returned in the lane's own order.
+		var expected []sdk.Tx
+		for _, lane := range suite.lanes {
+			for iterator := lane.Select(suite.ctx, nil); iterator != nil; iterator = iterator.Next() {
+				expected = append(expected, iterator.Tx())
+			}
+		}
+
+		var actual []sdk.Tx
+		for iterator := suite.mempool.Select(suite.ctx, nil); iterator != nil; iterator = iterator.Next() {
+			actual = append(actual, iterator.Tx())
+		}
+
+		suite.Require().Equal(30, len(actual))
+		suite.Require().Equal(expected, actual)
+	})
+
+	suite.Run("skips transactions rejected by the filter", func() {
+		suite.SetupTest() // reset
+
+		suite.fillBaseLane(10)
+		suite.fillTOBLane(10)
+		suite.fillFreeLane(10)
+
+		filter := func(lane block.Lane, _ sdk.Tx) bool {
+			return lane.Name() != suite.freeLane.Name()
+		}
+
+		count := 0
+		for iterator := suite.mempool.SelectWithFilter(suite.ctx, nil, filter); iterator != nil; iterator = iterator.Next() {
+			suite.Require().False(suite.freeLane.Contains(iterator.Tx()))
+			count++
+		}
+
+		suite.Require().Equal(20, count)
+	})
+
+	suite.Run("can remove transactions while iterating", func() {
+		suite.SetupTest() // reset
+
+		suite.fillBaseLane(10)
+		suite.fillTOBLane(10)
+
+		count := 0
+		for iterator := suite.mempool.Select(suite.ctx, nil); iterator != nil; iterator = iterator.Next() {
+			suite.Require().NoError(suite.mempool.Remove(iterator.Tx()))
+			count++
+		}
+
+		suite.Require().Equal(20, count)
+		suite.Require().Equal(0, suite.mempool.CountTx())
+	})
+
+	suite.Run("skips transactions removed after the lane was read", func() {
+		suite.SetupTest() // reset
+
+		suite.fillBaseLane(10)
+
+		var txs []sdk.Tx
+		for iterator := suite.baseLane.Select(suite.ctx, nil); iterator != nil; iterator = iterator.Next() {
+			txs = append(txs, iterator.Tx())
+		}
+
+		iterator := suite.mempool.Select(suite.ctx, nil)
+		suite.Require().NotNil(iterator)
+		suite.Require().Equal(txs[0], iterator.Tx())
+
+		// Remove every other transaction that has not been returned yet.
+		for i := 1; i < len(txs); i += 2 {
+			suite.Require().NoError(suite.mempool.Remove(txs[i]))
+		}
+
+		var actual []sdk.Tx
+		for iterator = iterator.Next(); iterator != nil; iterator = iterator.Next() {
+			actual = append(actual, iterator.Tx())
+		}
+
+		suite.Require().Equal([]sdk.Tx{txs[2], txs[4], txs[6], txs[8]}, actual)
+	})
+}
+
+func (suite *BlockBusterTestSuite) TestGetProposalLanes() {
+	suite.Run("uses the static registry without a lane fetcher", func() {
+		suite.SetupTest() // reset
+
+		lanes := suite.mempool.GetProposalLanes(suite.ctx)
+		suite.Require().Equal(suite.lanes, lanes.Lanes)
+		suite.Require().Nil(lanes.BlockSpaces)
+	})
+
+	suite.Run("uses the static registry when there are no lanes in state", func() {
+		suite.SetupTest() // reset
+
+		mempool, err := block.NewLanedMempoolWithLaneFetcher(log.NewNopLogger(), suite.lanes, laneFetcher{})
+		suite.Require().NoError(err)
+
+		lanes := mempool.GetProposalLanes(suite.ctx)
+		suite.Require().Equal(suite.lanes, lanes.Lanes)
+		suite.Require().Nil(lanes.BlockSpaces)
+	})
+
+	suite.Run("applies the order and max block space in state without modifying the lanes", func() {
+		suite.SetupTest() // reset
+
+		fetcher := laneFetcher{
+			blocksdktypes.NewLane(suite.freeLane.Name(), math.LegacyMustNewDecFromStr("0.1"), 0),
+			blocksdktypes.NewLane(suite.mevLane.Name(), math.LegacyMustNewDecFromStr("0.5"), 1),
+			blocksdktypes.NewLane(suite.baseLane.Name(), math.LegacyZeroDec(), 2),
+		}
+
+		mempool, err := block.NewLanedMempoolWithLaneFetcher(log.NewNopLogger(), suite.lanes, fetcher)
+		suite.Require().NoError(err)
+
+		lanes := mempool.GetProposalLanes(suite.ctx)
+		suite.Require().Equal([]block.Lane{suite.freeLane, suite.mevLane, suite.baseLane}, lanes.Lanes)
+		suite.Require().Equal(suite.lanes, mempool.Registry())
+
+		suite.Require().Equal(math.LegacyMustNewDecFromStr("0.1"), lanes.GetBlockSpace(suite.freeLane).MaxTxBytes)
+		suite.Require().Equal(math.LegacyMustNewDecFromStr("0.5"), lanes.GetBlockSpace(suite.mevLane).MaxGasLimit)
+		suite.Require().Equal(math.LegacyZeroDec(), lanes.GetBlockSpace(suite.baseLane).MaxTxBytes)
+		suite.Require().Equal(math.LegacyMustNewDecFromStr("0.3"), suite.freeLane.GetMaxBlockSpace())
+		suite.Require().Equal(math.LegacyMustNewDecFromStr("0.3"), suite.mevLane.GetMaxBlockSpace())
+
+		// Proposals use the block space in state.
+		proposal := lanes.NewProposal(log.NewNopLogger(), 1000, 1000)
+		suite.Require().Equal(int64(100), proposal.GetLaneLimitsForLane(suite.freeLane).MaxTxBytes)
+	})
+
+	suite.Run("falls back to the static registry when a lane in state is not in the mempool", func() {
+		suite.SetupTest() // reset
+
+		fetcher := laneFetcher{
+			blocksdktypes.NewLane("unknown", math.LegacyMustNewDecFromStr("0.1"), 0),
+			blocksdktypes.NewLane(suite.mevLane.Name(), math.LegacyMustNewDecFromStr("0.5"), 1),
+			blocksdktypes.NewLane(suite.baseLane.Name(), math.LegacyZeroDec(), 2),
+		}
+
+		mempool, err := block.NewLanedMempoolWithLaneFetcher(log.NewNopLogger(), suite.lanes, fetcher)
+		suite.Require().NoError(err)
+
+		lanes := mempool.GetProposalLanes(suite.ctx)
+		suite.Require().Equal(suite.lanes, lanes.Lanes)
+		suite.Require().Nil(lanes.BlockSpaces)
+	})
+
+	suite.Run("falls back to the static registry when the number of lanes does not match", func() {
+		suite.SetupTest() // reset
+
+		fetcher := laneFetcher{
+			blocksdktypes.NewLane(suite.baseLane.Name(), math.LegacyZeroDec(), 0),
+		}
+
+		mempool, err := block.NewLanedMempoolWithLaneFetcher(log.NewNopLogger(), suite.lanes, fetcher)
+		suite.Require().NoError(err)
+
+		lanes := mempool.GetProposalLanes(suite.ctx)
+		suite.Require().Equal(suite.lanes, lanes.Lanes)
+		suite.Require().Nil(lanes.BlockSpaces)
+	})
+
+	suite.Run("falls back to the static registry when the configuration in state is invalid", func() {
+		suite.SetupTest() // reset
+
+		fetcher := laneFetcher{
+			blocksdktypes.NewLane(suite.mevLane.Name(), math.LegacyMustNewDecFromStr("0.6"), 0),
+			blocksdktypes.NewLane(suite.freeLane.Name(), math.LegacyMustNewDecFromStr("0.6"), 1),
+			blocksdktypes.NewLane(suite.baseLane.Name(), math.LegacyZeroDec(), 2),
+		}
+
+		mempool, err := block.NewLanedMempoolWithLaneFetcher(log.NewNopLogger(), suite.lanes, fetcher)
+		suite.Require().NoError(err)
+
+		lanes := mempool.GetProposalLanes(suite.ctx)
+		suite.Require().Equal(suite.lanes, lanes.Lanes)
+		suite.Require().Nil(lanes.BlockSpaces)
+	})
+}
+
 // fillBaseLane fills the base lane with numTxs transactions that are randomly created.
+func (suite *BlockBusterTestSuite) TestMetrics() {
+	createTx := func(acc testutils.Account, nonce uint64) sdk.Tx {
+		tx, err := testutils.CreateRandomTx(
+			suite.encodingConfig.TxConfig,
+			acc,
+			nonce,
+			1,
+			0,
+			1,
+			sdk.NewCoin(suite.gasTokenDenom, math.NewInt(100)),
+		)
+		suite.Require().NoError(err)
+
+		return tx
+	}
+
+	suite.Run("reports inserted transactions and the size of the lane", func() {
+		m := metricsmocks.NewMetrics(suite.T())
+		mempool, err := block.NewLanedMempool(log.NewNopLogger(), suite.lanes, block.WithMetrics(m))
+		suite.Require().NoError(err)
+
+		tx1 := createTx(suite.accounts[0], 0)
+		tx2 := createTx(suite.accounts[0], 1)
+
+		m.On("AddTxsInserted", defaultlane.LaneName, 1).Twice()
+		m.On("SetLaneSize", defaultlane.LaneName, 1).Once()
+		m.On("SetLaneSize", defaultlane.LaneName, 2).Once()
+		suite.Require().NoError(mempool.Insert(suite.ctx, tx1))
+		suite.Require().NoError(mempool.Insert(suite.ctx, tx2))
+
+		m.On("SetLaneSize", defaultlane.LaneName, 1).Once()
+		suite.Require().NoError(mempool.Remove(tx1))
+	})
+
+	suite.Run("reports transactions rejected by a lane", func() {
+		cfg := base.LaneConfig{
+			Logger:          log.NewNopLogger(),
+			TxEncoder:       suite.encodingConfig.TxConfig.TxEncoder(),
+			TxDecoder:       suite.encodingConfig.TxConfig.TxDecoder(),
+			SignerExtractor: signer_extraction.NewDefaultAdapter(),
+			MaxBlockSpace:   math.LegacyZeroDec(),
+			MaxTxs:          1,
+		}
+		lane := defaultlane.NewDefaultLane(cfg, base.DefaultMatchHandler())
+
+		m := metricsmocks.NewMetrics(suite.T())
+		mempool, err := block.NewLanedMempool(log.NewNopLogger(), []block.Lane{lane}, block.WithMetrics(m))
+		suite.Require().NoError(err)
+
+		m.On("AddTxsInserted", defaultlane.LaneName, 1).Once()
+		m.On("SetLaneSize", defaultlane.LaneName, 1).Once()
+		suite.Require().NoError(mempool.Insert(suite.ctx, createTx(suite.accounts[0], 0)))
+
+		m.On("AddTxsRejected", defaultlane.LaneName, 1).Once()
+		suite.Require().Error(mempool.Insert(suite.ctx, createTx(suite.accounts[1], 0)))
+	})
+
+	suite.Run("reports transactions that do not match any lane", func() {
+		cfg := base.LaneConfig{
+			Logger:          log.NewNopLogger(),
+			TxEncoder:       suite.encodingConfig.TxConfig.TxEncoder(),
+			TxDecoder:       suite.encodingConfig.TxConfig.TxDecoder(),
+			SignerExtractor: signer_extraction.NewDefaultAdapter(),
+			MaxBlockSpace:   math.LegacyZeroDec(),
+		}
+		lane := free.NewFreeLane(cfg, base.DefaultTxPriority(), free.DefaultMatchHandler())
+
+		m := metricsmocks.NewMetrics(suite.T())
+		mempool, err := block.NewLanedMempool(log.NewNopLogger(), []block.Lane{lane}, block.WithMetrics(m))
+		suite.Require().NoError(err)
+
+		m.On("AddTxsRejected", "", 1).Once()
+		suite.Require().NoError(mempool.Insert(suite.ctx, createTx(suite.accounts[0], 0)))
+		suite.Require().Equal(0, mempool.CountTx())
+	})
+}
+
+func (suite *BlockBusterTestSuite) TestCapacity() {
+	createTx := func(acc testutils.Account, nonce uint64, fee int64) sdk.Tx {
+		tx, err := testutils.CreateRandomTx(
+			suite.encodingConfig.TxConfig,
+			acc,
+			nonce,
+			1,
+			0,
+			1,
+			sdk.NewCoin(suite.gasTokenDenom, math.NewInt(fee)),
+		)
+		suite.Require().NoError(err)
+
+		return tx
+	}
+
+	createFreeTx := func(acc testutils.Account, nonce uint64) sdk.Tx {
+		tx, err := testutils.CreateFreeTx(
+			suite.encodingConfig.TxConfig,
+			acc,
+			nonce,
+			0,
+			"val1",
+			sdk.NewCoin(suite.gasTokenDenom, math.NewInt(100)),
+			sdk.NewCoin(suite.gasTokenDenom, math.NewInt(1)),
+		)
+		suite.Require().NoError(err)
+
+		return tx
+	}
+
+	// setUpMempool returns a mempool with a free lane (30% of the block) and a default lane,
+	// whose configuration can be adjusted with configure (if set).
+	setUpMempool := func(
+		configure func(cfg *base.LaneConfig),
+		capacity block.MempoolCapacity,
+		opts ...block.LanedMempoolOption,
+	) (*block.LanedMempool, block.Lane, block.Lane) {
+		cfg := base.LaneConfig{
+			Logger:          log.NewNopLogger(),
+			TxEncoder:       testutils.UnorderedTxEncoder(suite.encodingConfig.TxConfig.TxEncoder()),
+			TxDecoder:       suite.encodingConfig.TxConfig.TxDecoder(),
+			SignerExtractor: signer_extraction.NewDefaultAdapter(),
+			MaxBlockSpace:   math.LegacyMustNewDecFromStr("0.3"),
+		}
+		if configure != nil {
+			configure(&cfg)
+		}
+		freeLane := free.NewFreeLane(cfg, base.DefaultTxPriority(), free.DefaultMatchHandler())
+
+		// The default lane orders txs by fee such that the eviction policies can compare them.
+		feePriority := base.TxPriority[int64]{
+			GetTxPriority: func(_ context.Context, tx sdk.Tx) int64 {
+				return tx.(sdk.FeeTx).GetFee().AmountOf(suite.gasTokenDenom).Int64()
+			},
+			Compare: func(a, b int64) int {
+				return skiplist.Int64.Compare(a, b)
+			},
+			MinValue: 0,
+		}
+
+		cfg.MaxBlockSpace = math.LegacyZeroDec()
+		defaultLane, err := base.NewBaseLane(
+			cfg,
+			defaultlane.LaneName,
+			base.WithMatchHandler(base.DefaultMatchHandler()),
+			base.WithMempoolConfigs(cfg, feePriority),
+		)
+		suite.Require().NoError(err)
+
+		capacity.SignerExtractor = signer_extraction.NewDefaultAdapter()
+		opts = append(opts, block.WithCapacity(capacity))
+
+		mempool, err := block.NewLanedMempool(log.NewNopLogger(), []block.Lane{freeLane, defaultLane}, opts...)
+		suite.Require().NoError(err)
+
+		return mempool, freeLane, defaultLane
+	}
+
+	suite.Run("rejects txs once the mempool is full", func() {
+		mempool, _, _ := setUpMempool(nil, block.MempoolCapacity{MaxTxs: 2})
+
+		suite.Require().NoError(mempool.Insert(suite.ctx, createTx(suite.accounts[0], 0, 1)))
+		suite.Require().NoError(mempool.Insert(suite.ctx, createTx(suite.accounts[1], 0, 1)))
+
+		err := mempool.Insert(suite.ctx, createTx(suite.accounts[2], 0, 10))
+		suite.Require().ErrorIs(err, sdkmempool.ErrMempoolTxMaxCapacity)
+		suite.Require().Equal(2, mempool.CountTx())
+	})
+
+	suite.Run("rejects txs once the mempool is out of bytes", func() {
+		tx1 := createTx(suite.accounts[0], 0, 1)
+		tx2 := createTx(suite.accounts[1], 0, 1)
+		txBz, err := suite.encodingConfig.TxConfig.TxEncoder()(tx1)
+		suite.Require().NoError(err)
+
+		mempool, _, defaultLane := setUpMempool(nil, block.MempoolCapacity{MaxBytes: int64(len(txBz)) + 1})
+		suite.Require().NoError(mempool.Insert(suite.ctx, tx1))
+
+		err = mempool.Insert(suite.ctx, tx2)
+		suite.Require().ErrorIs(err, sdkmempool.ErrMempoolTxMaxCapacity)
+
+		usage := mempool.Usage()
+		suite.Require().Equal(block.MempoolUsage{NumTxs: 1, Bytes: int64(len(txBz))}, usage[defaultLane.Name()])
+
+		// Space is freed up when txs are removed, including by the lanes themselves.
+		suite.Require().NoError(defaultLane.Remove(tx1))
+		suite.Require().NoError(mempool.Insert(suite.ctx, tx2))
+	})
+
+	suite.Run("tracks the size of txs removed and inserted by the lanes themselves", func() {
+		txs := make([]sdk.Tx, 5)
+		sizes := make([]int64, len(txs))
+		for i := range txs {
+			txs[i] = createTx(suite.accounts[i], 0, 1)
+			txBz, err := suite.encodingConfig.TxConfig.TxEncoder()(txs[i])
+			suite.Require().NoError(err)
+			sizes[i] = int64(len(txBz))
+		}
+
+		// The first two txs are invalid, so preparing a proposal removes them from the lane.
+		invalid := map[sdk.Tx]bool{txs[0]: true, txs[1]: true}
+		mempool, _, defaultLane := setUpMempool(
+			func(cfg *base.LaneConfig) {
+				cfg.AnteHandler = func(ctx sdk.Context, tx sdk.Tx, _ bool) (sdk.Context, error) {
+					if invalid[tx] {
+						return ctx, fmt.Errorf("invalid tx")
+					}
+
+					return ctx, nil
+				}
+			},
+			block.MempoolCapacity{MaxBytes: sizes[0] + sizes[1]},
+		)
+		suite.Require().NoError(mempool.Insert(suite.ctx, txs[0]))
+		suite.Require().NoError(mempool.Insert(suite.ctx, txs[1]))
+
+		proposal := proposals.NewProposal(log.NewNopLogger(), 1_000_000, 1_000_000)
+		_, err := defaultLane.PrepareLane(suite.ctx, proposal, block.NoOpPrepareLanesHandler())
+		suite.Require().NoError(err)
+		suite.Require().Equal(block.MempoolUsage{}, mempool.Usage()[defaultLane.Name()])
+
+		// Txs inserted into the lane without going through the mempool (e.g. by the MEV check
+		// tx handler) count towards the max bytes of the mempool.
+		suite.Require().NoError(defaultLane.Insert(suite.ctx, txs[2]))
+		suite.Require().NoError(defaultLane.Insert(suite.ctx, txs[3]))
+		suite.Require().Equal(
+			block.MempoolUsage{NumTxs: 2, Bytes: sizes[2] + sizes[3]},
+			mempool.Usage()[defaultLane.Name()],
+		)
+
+		err = mempool.Insert(suite.ctx, txs[4])
+		suite.Require().ErrorIs(err, sdkmempool.ErrMempoolTxMaxCapacity)
+	})
+
+	suite.Run("rejects a max bytes capacity if a lane cannot report its txs", func() {
+		lane := mocks.NewLane(suite.T())
+		lane.On("Name").Return("untracked")
+		lane.On("GetBlockSpace").Return(proposals.BlockSpace{
+			MaxTxBytes:  math.LegacyOneDec(),
+			MaxGasLimit: math.LegacyOneDec(),
+		})
+
+		_, err := block.NewLanedMempool(log.NewNopLogger(), []block.Lane{lane}, block.WithCapacity(block.MempoolCapacity{
+			MaxBytes:        1,
+			SignerExtractor: signer_extraction.NewDefaultAdapter(),
+		}))
+		suite.Require().Error(err)
+	})
+
+	suite.Run("replacing a tx does not count towards the capacity", func() {
+		mempool, _, _ := setUpMempool(nil, block.MempoolCapacity{MaxTxs: 1})
+
+		suite.Require().NoError(mempool.Insert(suite.ctx, createTx(suite.accounts[0], 0, 1)))
+		suite.Require().NoError(mempool.Insert(suite.ctx, createTx(suite.accounts[0], 0, 2)))
+		suite.Require().Equal(1, mempool.CountTx())
+	})
+
+	suite.Run("unordered txs are tracked by hash", func() {
+		createUnorderedTx := func(timeout int64) sdk.Tx {
+			tx, err := testutils.CreateUnorderedTx(
+				suite.encodingConfig.TxConfig,
+				suite.accounts[0],
+				time.Unix(timeout, 0),
+				1,
+				sdk.NewCoin(suite.gasTokenDenom, math.NewInt(1)),
+			)
+			suite.Require().NoError(err)
+
+			return tx
+		}
+
+		mempool, _, _ := setUpMempool(nil, block.MempoolCapacity{MaxTxs: 3})
+		err := mempool.Insert(suite.ctx, createUnorderedTx(1))
+		suite.Require().Error(err)
+
+		mempool, _, defaultLane := setUpMempool(nil, block.MempoolCapacity{
+			MaxTxs:    3,
+			TxEncoder: testutils.UnorderedTxEncoder(suite.encodingConfig.TxConfig.TxEncoder()),
+		})
+		suite.Require().NoError(mempool.Insert(suite.ctx, createTx(suite.accounts[0], 0, 1)))
+		suite.Require().NoError(mempool.Insert(suite.ctx, createUnorderedTx(1)))
+		suite.Require().NoError(mempool.Insert(suite.ctx, createUnorderedTx(2)))
+		suite.Require().Equal(3, mempool.Usage()[defaultLane.Name()].NumTxs)
+
+		err = mempool.Insert(suite.ctx, createUnorderedTx(3))
+		suite.Require().ErrorIs(err, sdkmempool.ErrMempoolTxMaxCapacity)
+	})
+
+	suite.Run("evicts the lowest priority txs of the lane", func() {
+		var evicted []sdk.Tx
+		mempool, _, defaultLane := setUpMempool(
+			nil,
+			block.MempoolCapacity{MaxTxs: 2, EvictionPolicy: block.NewLanePriorityEvictionPolicy()},
+			block.WithEvictionHandler(func(_ sdk.Context, evictions []block.Eviction) {
+				for _, eviction := range evictions {
+					evicted = append(evicted, eviction.Tx)
+				}
+			}),
+		)
+
+		tx1 := createTx(suite.accounts[0], 0, 1)
+		tx2 := createTx(suite.accounts[1], 0, 2)
+		tx3 := createTx(suite.accounts[2], 0, 3)
+		suite.Require().NoError(mempool.Insert(suite.ctx, tx1))
+		suite.Require().NoError(mempool.Insert(suite.ctx, tx2))
+		suite.Require().NoError(mempool.Insert(suite.ctx, tx3))
+
+		suite.Require().Equal([]sdk.Tx{tx1}, evicted)
+		suite.Require().False(defaultLane.Contains(tx1))
+		suite.Require().True(defaultLane.Contains(tx3))
+
+		// Txs with a lower priority than every tx in the lane are rejected.
+		err := mempool.Insert(suite.ctx, createTx(suite.accounts[3], 0, 1))
+		suite.Require().ErrorIs(err, sdkmempool.ErrMempoolTxMaxCapacity)
+		suite.Require().Len(evicted, 1)
+		suite.Require().Equal(2, mempool.CountTx())
+	})
+
+	suite.Run("evicts the later txs of the evicted tx's signer", func() {
+		var evicted []sdk.Tx
+		mempool, _, defaultLane := setUpMempool(
+			func(cfg *base.LaneConfig) { cfg.AccountKeeper = accountKeeper{} },
+			block.MempoolCapacity{MaxTxs: 3, EvictionPolicy: block.NewLanePriorityEvictionPolicy()},
+			block.WithEvictionHandler(func(_ sdk.Context, evictions []block.Eviction) {
+				for _, eviction := range evictions {
+					evicted = append(evicted, eviction.Tx)
+				}
+			}),
+		)
+
+		// tx2 is queued until tx1's successor is inserted.
+		tx1 := createTx(suite.accounts[0], 0, 1)
+		tx2 := createTx(suite.accounts[0], 2, 5)
+		tx3 := createTx(suite.accounts[1], 0, 3)
+		suite.Require().NoError(mempool.Insert(suite.ctx, tx1))
+		suite.Require().NoError(mempool.Insert(suite.ctx, tx2))
+		suite.Require().NoError(mempool.Insert(suite.ctx, tx3))
+
+		// Evicting tx1 would leave tx2 unexecutable, so both are evicted.
+		tx4 := createTx(suite.accounts[2], 0, 2)
+		suite.Require().NoError(mempool.Insert(suite.ctx, tx4))
+		suite.Require().ElementsMatch([]sdk.Tx{tx1, tx2}, evicted)
+		suite.Require().Equal(2, defaultLane.CountTx())
+		suite.Require().Equal(2, mempool.Usage()[defaultLane.Name()].NumTxs)
+
+		// A tx cannot evict the txs preceding it in the sequence of its signer.
+		suite.Require().NoError(mempool.Insert(suite.ctx, createTx(suite.accounts[2], 1, 10)))
+		err := mempool.Insert(suite.ctx, createTx(suite.accounts[2], 2, 10))
+		suite.Require().ErrorIs(err, sdkmempool.ErrMempoolTxMaxCapacity)
+		suite.Require().Len(evicted, 2)
+	})
+
+	suite.Run("nothing is evicted if the lane rejects the tx", func() {
+		var evicted []sdk.Tx
+		mempool, _, defaultLane := setUpMempool(
+			func(cfg *base.LaneConfig) { cfg.MaxTxs = 2 },
+			block.MempoolCapacity{MaxTxs: 2, EvictionPolicy: block.NewLanePriorityEvictionPolicy()},
+			block.WithEvictionHandler(func(_ sdk.Context, evictions []block.Eviction) {
+				for _, eviction := range evictions {
+					evicted = append(evicted, eviction.Tx)
+				}
+			}),
+		)
+
+		suite.Require().NoError(mempool.Insert(suite.ctx, createTx(suite.accounts[0], 0, 1)))
+		suite.Require().NoError(mempool.Insert(suite.ctx, createTx(suite.accounts[1], 0, 1)))
+
+		// The lane holds at most 2 txs, so it rejects the tx even though the policy selects a
+		// tx to evict.
+		err := mempool.Insert(suite.ctx, createTx(suite.accounts[2], 0, 10))
+		suite.Require().ErrorIs(err, sdkmempool.ErrMempoolTxMaxCapacity)
+		suite.Require().Empty(evicted)
+		suite.Require().Equal(2, defaultLane.CountTx())
+		suite.Require().Equal(2, mempool.Usage()[defaultLane.Name()].NumTxs)
+	})
+
+	suite.Run("evicts txs from the lanes over their share", func() {
+		mempool, freeLane, defaultLane := setUpMempool(
+			nil,
+			block.MempoolCapacity{MaxTxs: 4, EvictionPolicy: block.NewLaneShareEvictionPolicy()},
+		)
+
+		// The free lane's share is 30% of the capacity i.e. 1.2 txs.
+		for i := 0; i < 3; i++ {
+			suite.Require().NoError(mempool.Insert(suite.ctx, createFreeTx(suite.accounts[i], 0)))
+		}
+		suite.Require().NoError(mempool.Insert(suite.ctx, createTx(suite.accounts[3], 0, 1)))
+
+		suite.Require().NoError(mempool.Insert(suite.ctx, createTx(suite.accounts[4], 0, 1)))
+		suite.Require().Equal(2, freeLane.CountTx())
+		suite.Require().Equal(2, defaultLane.CountTx())
+
+		suite.Require().NoError(mempool.Insert(suite.ctx, createTx(suite.accounts[5], 0, 1)))
+		suite.Require().Equal(1, freeLane.CountTx())
+		suite.Require().Equal(3, defaultLane.CountTx())
+
+		// The free lane is now within its share, so the default lane is over its share and
+		// only txs with a lower priority than the new tx can be evicted.
+		err := mempool.Insert(suite.ctx, createTx(suite.accounts[6], 0, 1))
+		suite.Require().ErrorIs(err, sdkmempool.ErrMempoolTxMaxCapacity)
+
+		suite.Require().NoError(mempool.Insert(suite.ctx, createTx(suite.accounts[6], 0, 2)))
+		suite.Require().Equal(1, freeLane.CountTx())
+		suite.Require().Equal(3, defaultLane.CountTx())
+	})
+}
+
+func (suite *BlockBusterTestSuite) TestLaneEviction() {
+	createTx := func(acc testutils.Account, fee int64) sdk.Tx {
+		tx, err := testutils.CreateRandomTx(
+			suite.encodingConfig.TxConfig,
+			acc,
+			0,
+			1,
+			0,
+			1,
+			sdk.NewCoin(suite.gasTokenDenom, math.NewInt(fee)),
+		)
+		suite.Require().NoError(err)
+
+		return tx
+	}
+
+	cfg := base.LaneConfig{
+		Logger:             log.NewNopLogger(),
+		TxEncoder:          suite.encodingConfig.TxConfig.TxEncoder(),
+		TxDecoder:          suite.encodingConfig.TxConfig.TxDecoder(),
+		SignerExtractor:    signer_extraction.NewDefaultAdapter(),
+		MaxBlockSpace:      math.LegacyZeroDec(),
+		MaxTxs:             2,
+		EvictLowerPriority: true,
+	}
+
+	// The lane orders txs by fee such that it can evict the lowest priority tx.
+	feePriority := base.TxPriority[int64]{
+		GetTxPriority: func(_ context.Context, tx sdk.Tx) int64 {
+			return tx.(sdk.FeeTx).GetFee().AmountOf(suite.gasTokenDenom).Int64()
+		},
+		Compare: func(a, b int64) int {
+			return skiplist.Int64.Compare(a, b)
+		},
+		MinValue: 0,
+	}
+
+	defaultLane, err := base.NewBaseLane(
+		cfg,
+		defaultlane.LaneName,
+		base.WithMatchHandler(base.DefaultMatchHandler()),
+		base.WithMempoolConfigs(cfg, feePriority),
+	)
+	suite.Require().NoError(err)
+
+	var evicted []sdk.Tx
+	mempool, err := block.NewLanedMempool(
+		log.NewNopLogger(),
+		[]block.Lane{defaultLane},
+		block.WithEvictionHandler(func(_ sdk.Context, evictions []block.Eviction) {
+			for _, eviction := range evictions {
+				evicted = append(evicted, eviction.Tx)
+			}
+		}),
+	)
+	suite.Require().NoError(err)
+
+	tx1 := createTx(suite.accounts[0], 1)
+	tx2 := createTx(suite.accounts[1], 2)
+	suite.Require().NoError(mempool.Insert(suite.ctx, tx1))
+	suite.Require().NoError(mempool.Insert(suite.ctx, tx2))
+
+	hash1, err := utils.GetTxHash(suite.encodingConfig.TxConfig.TxEncoder(), tx1)
+	suite.Require().NoError(err)
+	suite.Require().True(mempool.ContainsHash(hash1))
+
+	// The full lane evicts its lowest priority tx and the mempool learns about it.
+	tx3 := createTx(suite.accounts[2], 3)
+	suite.Require().NoError(mempool.Insert(suite.ctx, tx3))
+	suite.Require().Equal([]sdk.Tx{tx1}, evicted)
+	suite.Require().False(mempool.ContainsHash(hash1))
+	suite.Require().Equal(2, mempool.CountTx())
+
+	// Txs that do not outrank the lowest priority tx are still rejected.
+	err = mempool.Insert(suite.ctx, createTx(suite.accounts[3], 1))
+	suite.Require().ErrorIs(err, sdkmempool.ErrMempoolTxMaxCapacity)
+	suite.Require().Len(evicted, 1)
+}
+
+func (suite *BlockBusterTestSuite) TestQueuedPoolDistribution() {
+	createTx := func(acc testutils.Account, nonce uint64) sdk.Tx {
+		tx, err := testutils.CreateRandomTx(
+			suite.encodingConfig.TxConfig,
+			acc,
+			nonce,
+			1,
+			0,
+			1,
+			sdk.NewCoin(suite.gasTokenDenom, math.NewInt(1)),
+		)
+		suite.Require().NoError(err)
+
+		return tx
+	}
+
+	cfg := base.LaneConfig{
+		Logger:          log.NewNopLogger(),
+		TxEncoder:       suite.encodingConfig.TxConfig.TxEncoder(),
+		TxDecoder:       suite.encodingConfig.TxConfig.TxDecoder(),
+		SignerExtractor: signer_extraction.NewDefaultAdapter(),
+		MaxBlockSpace:   math.LegacyZeroDec(),
+		AccountKeeper:   accountKeeper{},
+	}
+	defaultLane := defaultlane.NewDefaultLane(cfg, base.DefaultMatchHandler())
+
+	mempool, err := block.NewLanedMempool(log.NewNopLogger(), []block.Lane{defaultLane})
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(mempool.Insert(suite.ctx, createTx(suite.accounts[0], 0)))
+	suite.Require().NoError(mempool.Insert(suite.ctx, createTx(suite.accounts[0], 2)))
+	suite.Require().NoError(mempool.Insert(suite.ctx, createTx(suite.accounts[1], 1)))
+
+	suite.Require().Equal(
+		map[string]uint64{
+			defaultlane.LaneName:                          1,
+			defaultlane.LaneName + block.QueuedPoolSuffix: 2,
+		},
+		mempool.GetTxDistribution(),
+	)
+	suite.Require().Equal(3, mempool.CountTx())
+}
+
+func (suite *BlockBusterTestSuite) TestHashIndex() {
+	createTx := func(nonce uint64, fee int64) (sdk.Tx, string) {
+		tx, err := testutils.CreateRandomTx(
+			suite.encodingConfig.TxConfig,
+			suite.accounts[0],
+			nonce,
+			1,
+			0,
+			1,
+			sdk.NewCoin(suite.gasTokenDenom, math.NewInt(fee)),
+		)
+		suite.Require().NoError(err)
+
+		hash, err := utils.GetTxHash(suite.encodingConfig.TxConfig.TxEncoder(), tx)
+		suite.Require().NoError(err)
+
+		return tx, hash
+	}
+
+	suite.Run("can look up a tx by hash", func() {
+		suite.SetupTest()
+
+		tx, hash := createTx(0, 1)
+		suite.Require().NoError(suite.mempool.Insert(suite.ctx, tx))
+
+		suite.Require().True(suite.mempool.ContainsHash(hash))
+
+		found, err := suite.mempool.GetTx(hash)
+		suite.Require().NoError(err)
+		suite.Require().Equal(tx, found)
+	})
+
+	suite.Run("does not match a different tx with the same nonce", func() {
+		suite.SetupTest()
+
+		tx, _ := createTx(0, 1)
+		suite.Require().NoError(suite.mempool.Insert(suite.ctx, tx))
+
+		// The txs share their signer and sequence but have different bytes.
+		other, otherHash := createTx(0, 2)
+		suite.Require().False(suite.mempool.Contains(other))
+		suite.Require().False(suite.mempool.ContainsHash(otherHash))
+
+		_, err := suite.mempool.GetTx(otherHash)
+		suite.Require().ErrorIs(err, sdkmempool.ErrTxNotFound)
+		suite.Require().ErrorIs(suite.mempool.RemoveByHash(otherHash), sdkmempool.ErrTxNotFound)
+		suite.Require().NoError(suite.mempool.Remove(other))
+		suite.Require().Equal(1, suite.mempool.CountTx())
+		suite.Require().True(suite.mempool.Contains(tx))
+
+		// A decoded copy of the same tx matches.
+		bz, err := suite.encodingConfig.TxConfig.TxEncoder()(tx)
+		suite.Require().NoError(err)
+		copied, err := suite.encodingConfig.TxConfig.TxDecoder()(bz)
+		suite.Require().NoError(err)
+		suite.Require().True(suite.mempool.Contains(copied))
+		suite.Require().NoError(suite.mempool.Remove(copied))
+		suite.Require().Equal(0, suite.mempool.CountTx())
+	})
+
+	suite.Run("can remove a tx by hash", func() {
+		suite.SetupTest()
+
+		tx, hash := createTx(0, 1)
+		suite.Require().NoError(suite.mempool.Insert(suite.ctx, tx))
+
+		suite.Require().NoError(suite.mempool.RemoveByHash(hash))
+		suite.Require().False(suite.mempool.ContainsHash(hash))
+		suite.Require().False(suite.mempool.Contains(tx))
+		suite.Require().Equal(0, suite.mempool.CountTx())
+	})
+
+	suite.Run("does not find a tx removed through its lane", func() {
+		suite.SetupTest()
+
+		tx, hash := createTx(0, 1)
+		suite.Require().NoError(suite.mempool.Insert(suite.ctx, tx))
+		suite.Require().NoError(suite.baseLane.Remove(tx))
+
+		suite.Require().False(suite.mempool.ContainsHash(hash))
+	})
+
+	suite.Run("finds a tx inserted through its lane", func() {
+		suite.SetupTest()
+
+		tx, hash := createTx(0, 1)
+		suite.Require().NoError(suite.baseLane.Insert(suite.ctx, tx))
+
+		suite.Require().True(suite.mempool.ContainsHash(hash))
+		suite.Require().NoError(suite.mempool.RemoveByHash(hash))
+		suite.Require().Equal(0, suite.baseLane.CountTx())
+	})
+
+	suite.Run("does not encode txs to check or remove them", func() {
+		var encoded int
+		txEncoder := func(tx sdk.Tx) ([]byte, error) {
+			encoded++
+			return suite.encodingConfig.TxConfig.TxEncoder()(tx)
+		}
+
+		lane := defaultlane.NewDefaultLane(
+			base.LaneConfig{
+				Logger:          log.NewNopLogger(),
+				TxEncoder:       txEncoder,
+				TxDecoder:       suite.encodingConfig.TxConfig.TxDecoder(),
+				SignerExtractor: signer_extraction.NewDefaultAdapter(),
+				MaxBlockSpace:   math.LegacyOneDec(),
+			},
+			base.DefaultMatchHandler(),
+		)
+
+		mempool, err := block.NewLanedMempool(log.NewNopLogger(), []block.Lane{lane})
+		suite.Require().NoError(err)
+
+		tx, _ := createTx(0, 1)
+		suite.Require().NoError(mempool.Insert(suite.ctx, tx))
+
+		encoded = 0
+		suite.Require().True(mempool.Contains(tx))
+		suite.Require().NoError(mempool.Remove(tx))
+		suite.Require().False(mempool.Contains(tx))
+		suite.Require().Equal(0, encoded)
+	})
+}
+
+func (suite *BlockBusterTestSuite) TestSnapshot() {
+	suite.fillBaseLane(10)
+	suite.fillTOBLane(5)
+	suite.fillFreeLane(3)
+
+	snapshot := suite.mempool.Snapshot(suite.ctx)
+	suite.Require().Equal(suite.mempool.CountTx(), snapshot.CountTx())
+	suite.Require().Equal(suite.mempool.GetTxDistribution(), snapshot.GetTxDistribution())
+
+	var expected, actual []sdk.Tx
+	for iterator := suite.mempool.Select(suite.ctx, nil); iterator != nil; iterator = iterator.Next() {
+		expected = append(expected, iterator.Tx())
+	}
+	for iterator := snapshot.Select(); iterator != nil; iterator = iterator.Next() {
+		actual = append(actual, iterator.Tx())
+	}
+	suite.Require().Equal(expected, actual)
+
+	// The snapshot is not affected by writes to the mempool.
+	for _, tx := range expected {
+		suite.Require().NoError(suite.mempool.Remove(tx))
+	}
+	suite.Require().Zero(suite.mempool.CountTx())
+	suite.Require().Equal(len(expected), snapshot.CountTx())
+}
+
+func (suite *BlockBusterTestSuite) TestConcurrentAccess() {
+	// Each writer inserts and then removes the txs of its own account.
+	numTxs := 50
+	txs := make([][]sdk.Tx, len(suite.accounts))
+	for i, acc := range suite.accounts {
+		for nonce := 0; nonce < numTxs; nonce++ {
+			tx, err := testutils.CreateRandomTx(
+				suite.encodingConfig.TxConfig,
+				acc,
+				uint64(nonce),
+				1,
+				0,
+				1,
+				sdk.NewCoin(suite.gasTokenDenom, math.NewInt(int64(nonce+1))),
+			)
+			suite.Require().NoError(err)
+			txs[i] = append(txs[i], tx)
+		}
+	}
+
+	var (
+		writers sync.WaitGroup
+		readers sync.WaitGroup
+		done    = make(chan struct{})
+	)
+	for i := range suite.accounts {
+		writers.Add(1)
+		go func(txs []sdk.Tx) {
+			defer writers.Done()
+
+			for _, tx := range txs {
+				suite.Require().NoError(suite.mempool.Insert(suite.ctx, tx))
+			}
+
+			for j, tx := range txs {
+				if j%2 == 0 {
+					suite.Require().NoError(suite.mempool.Remove(tx))
+					continue
+				}
+
+				hash, err := utils.GetTxHash(suite.encodingConfig.TxConfig.TxEncoder(), tx)
+				suite.Require().NoError(err)
+				suite.Require().NoError(suite.mempool.RemoveByHash(hash))
+			}
+		}(txs[i])
+	}
+
+	read := func(read func()) {
+		readers.Add(1)
+		go func() {
+			defer readers.Done()
+
+			for {
+				select {
+				case <-done:
+					return
+				default:
+					read()
+				}
+			}
+		}()
+	}
+
+	read(func() {
+		suite.mempool.GetTxDistribution()
+		suite.mempool.CountTx()
+	})
+	read(func() {
+		snapshot := suite.mempool.Snapshot(suite.ctx)
+		for iterator := snapshot.Select(); iterator != nil; iterator = iterator.Next() {
+			suite.mempool.Contains(iterator.Tx())
+		}
+	})
+	read(func() {
+		for iterator := suite.mempool.Select(suite.ctx, nil); iterator != nil; iterator = iterator.Next() {
+			hash, err := utils.GetTxHash(suite.encodingConfig.TxConfig.TxEncoder(), iterator.Tx())
+			suite.Require().NoError(err)
+			suite.mempool.ContainsHash(hash)
+		}
+	})
+
+	writers.Wait()
+	close(done)
+	readers.Wait()
+
+	suite.Require().Zero(suite.mempool.CountTx())
+}
+
 func (suite *BlockBusterTestSuite) fillBaseLane(numTxs uint64) {
 	for i := uint64(0); i < numTxs; i++ {
 		// randomly select an account to create the tx
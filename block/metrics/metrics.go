@@ -0,0 +1,68 @@
+package metrics
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Metrics defines the metrics reported by the Block SDK mempool, lanes, proposal handlers
+// and check tx handlers. All lane specific metrics are labeled with the name of the lane.
+//
+//go:generate mockery --name Metrics --output ./mocks --outpkg mocks --case underscore
+type Metrics interface {
+	// SetLaneSize records the number of transactions in the mempool of the lane.
+	SetLaneSize(lane string, numTxs int)
+
+	// ObservePartialProposal records the number of transactions, bytes and gas included by
+	// the lane in the last proposal it prepared.
+	ObservePartialProposal(lane string, numTxs int, size int64, gas uint64)
+
+	// AddTxsInserted records transactions that were inserted into the mempool of the lane.
+	AddTxsInserted(lane string, numTxs int)
+
+	// AddTxsEvicted records transactions that were removed from the mempool of the lane
+	// without being included in a block (e.g. because they failed verification).
+	AddTxsEvicted(lane string, numTxs int)
+
+	// AddTxsRejected records transactions that were not admitted into the mempool of the
+	// lane. The lane is empty if the transactions did not match any lane.
+	AddTxsRejected(lane string, numTxs int)
+
+	// ObservePrepareLaneLatency records the time it took the lane to prepare its partial
+	// proposal.
+	ObservePrepareLaneLatency(lane string, latency time.Duration)
+
+	// ObserveProcessLaneLatency records the time it took the lane to verify its partial
+	// proposal.
+	ObserveProcessLaneLatency(lane string, latency time.Duration)
+
+	// ObservePrepareProposalLatency records the time it took to prepare a proposal.
+	ObservePrepareProposalLatency(latency time.Duration)
+
+	// ObserveProcessProposalLatency records the time it took to verify a proposal.
+	ObserveProcessProposalLatency(latency time.Duration)
+
+	// AddLaneFailure records a lane that failed to prepare its partial proposal and was
+	// skipped.
+	AddLaneFailure(lane string)
+
+	// AddTxPanic records a transaction that panicked while the lane was preparing its
+	// partial proposal and was removed from the lane's mempool.
+	AddTxPanic(lane string)
+
+	// ObserveBid records the value of a valid auction bid submitted to the lane.
+	ObserveBid(lane string, bid sdk.Coin)
+
+	// ObserveWinningBid records the value of the auction bid the lane included in the
+	// last proposal it prepared.
+	ObserveWinningBid(lane string, bid sdk.Coin)
+
+	// AddShadowProposalAccepted records a proposal that was verified in shadow mode and
+	// would have been accepted.
+	AddShadowProposalAccepted()
+
+	// AddShadowProposalRejected records a proposal that was verified in shadow mode and
+	// would have been rejected by the given lane because the invariant was violated.
+	AddShadowProposalRejected(lane string, invariant string)
+}
@@ -0,0 +1,106 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	types "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Metrics is an autogenerated mock type for the Metrics type
+type Metrics struct {
+	mock.Mock
+}
+
+// AddLaneFailure provides a mock function with given fields: lane
+func (_m *Metrics) AddLaneFailure(lane string) {
+	_m.Called(lane)
+}
+
+// AddShadowProposalAccepted provides a mock function with given fields:
+func (_m *Metrics) AddShadowProposalAccepted() {
+	_m.Called()
+}
+
+// AddShadowProposalRejected provides a mock function with given fields: lane, invariant
+func (_m *Metrics) AddShadowProposalRejected(lane string, invariant string) {
+	_m.Called(lane, invariant)
+}
+
+// AddTxPanic provides a mock function with given fields: lane
+func (_m *Metrics) AddTxPanic(lane string) {
+	_m.Called(lane)
+}
+
+// AddTxsEvicted provides a mock function with given fields: lane, numTxs
+func (_m *Metrics) AddTxsEvicted(lane string, numTxs int) {
+	_m.Called(lane, numTxs)
+}
+
+// AddTxsInserted provides a mock function with given fields: lane, numTxs
+func (_m *Metrics) AddTxsInserted(lane string, numTxs int) {
+	_m.Called(lane, numTxs)
+}
+
+// AddTxsRejected provides a mock function with given fields: lane, numTxs
+func (_m *Metrics) AddTxsRejected(lane string, numTxs int) {
+	_m.Called(lane, numTxs)
+}
+
+// ObserveBid provides a mock function with given fields: lane, bid
+func (_m *Metrics) ObserveBid(lane string, bid types.Coin) {
+	_m.Called(lane, bid)
+}
+
+// ObservePartialProposal provides a mock function with given fields: lane, numTxs, size, gas
+func (_m *Metrics) ObservePartialProposal(lane string, numTxs int, size int64, gas uint64) {
+	_m.Called(lane, numTxs, size, gas)
+}
+
+// ObservePrepareLaneLatency provides a mock function with given fields: lane, latency
+func (_m *Metrics) ObservePrepareLaneLatency(lane string, latency time.Duration) {
+	_m.Called(lane, latency)
+}
+
+// ObservePrepareProposalLatency provides a mock function with given fields: latency
+func (_m *Metrics) ObservePrepareProposalLatency(latency time.Duration) {
+	_m.Called(latency)
+}
+
+// ObserveProcessLaneLatency provides a mock function with given fields: lane, latency
+func (_m *Metrics) ObserveProcessLaneLatency(lane string, latency time.Duration) {
+	_m.Called(lane, latency)
+}
+
+// ObserveProcessProposalLatency provides a mock function with given fields: latency
+func (_m *Metrics) ObserveProcessProposalLatency(latency time.Duration) {
+	_m.Called(latency)
+}
+
+// ObserveWinningBid provides a mock function with given fields: lane, bid
+func (_m *Metrics) ObserveWinningBid(lane string, bid types.Coin) {
+	_m.Called(lane, bid)
+}
+
+// SetLaneSize provides a mock function with given fields: lane, numTxs
+func (_m *Metrics) SetLaneSize(lane string, numTxs int) {
+	_m.Called(lane, numTxs)
+}
+
+// NewMetrics creates a new instance of Metrics. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMetrics(t interface {
+	mock.TestingT
+	Cleanup(func())
+},
+) *Metrics {
+	mock := &Metrics{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,62 @@
+package metrics
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ Metrics = NoOpMetrics{}
+
+// NoOpMetrics is a Metrics implementation that does not record anything.
+type NoOpMetrics struct{}
+
+// NewNoOpMetrics returns a Metrics implementation that does not record anything.
+func NewNoOpMetrics() Metrics {
+	return NoOpMetrics{}
+}
+
+// SetLaneSize implements Metrics.
+func (NoOpMetrics) SetLaneSize(string, int) {}
+
+// ObservePartialProposal implements Metrics.
+func (NoOpMetrics) ObservePartialProposal(string, int, int64, uint64) {}
+
+// AddTxsInserted implements Metrics.
+func (NoOpMetrics) AddTxsInserted(string, int) {}
+
+// AddTxsEvicted implements Metrics.
+func (NoOpMetrics) AddTxsEvicted(string, int) {}
+
+// AddTxsRejected implements Metrics.
+func (NoOpMetrics) AddTxsRejected(string, int) {}
+
+// ObservePrepareLaneLatency implements Metrics.
+func (NoOpMetrics) ObservePrepareLaneLatency(string, time.Duration) {}
+
+// ObserveProcessLaneLatency implements Metrics.
+func (NoOpMetrics) ObserveProcessLaneLatency(string, time.Duration) {}
+
+// ObservePrepareProposalLatency implements Metrics.
+func (NoOpMetrics) ObservePrepareProposalLatency(time.Duration) {}
+
+// ObserveProcessProposalLatency implements Metrics.
+func (NoOpMetrics) ObserveProcessProposalLatency(time.Duration) {}
+
+// AddLaneFailure implements Metrics.
+func (NoOpMetrics) AddLaneFailure(string) {}
+
+// AddTxPanic implements Metrics.
+func (NoOpMetrics) AddTxPanic(string) {}
+
+// ObserveBid implements Metrics.
+func (NoOpMetrics) ObserveBid(string, sdk.Coin) {}
+
+// ObserveWinningBid implements Metrics.
+func (NoOpMetrics) ObserveWinningBid(string, sdk.Coin) {}
+
+// AddShadowProposalAccepted implements Metrics.
+func (NoOpMetrics) AddShadowProposalAccepted() {}
+
+// AddShadowProposalRejected implements Metrics.
+func (NoOpMetrics) AddShadowProposalRejected(string, string) {}
@@ -0,0 +1,188 @@
+package metrics
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gometrics "github.com/hashicorp/go-metrics"
+)
+
+const (
+	// LaneLabel is the label used to identify the lane of a metric.
+	LaneLabel = "lane"
+	// DenomLabel is the label used to identify the denom of an auction bid.
+	DenomLabel = "denom"
+	// InvariantLabel is the label used to identify the invariant violated by a proposal.
+	InvariantLabel = "invariant"
+)
+
+var (
+	// LaneSizeKey is the key of the gauge of the number of transactions in a lane's mempool.
+	LaneSizeKey = []string{"blocksdk", "lane", "size"}
+	// PartialProposalTxsKey is the key of the gauge of the number of transactions included by
+	// a lane in the last proposal it prepared.
+	PartialProposalTxsKey = []string{"blocksdk", "lane", "proposal", "txs"}
+	// PartialProposalBytesKey is the key of the gauge of the number of bytes included by a lane
+	// in the last proposal it prepared.
+	PartialProposalBytesKey = []string{"blocksdk", "lane", "proposal", "bytes"}
+	// PartialProposalGasKey is the key of the gauge of the gas included by a lane in the last
+	// proposal it prepared.
+	PartialProposalGasKey = []string{"blocksdk", "lane", "proposal", "gas"}
+	// TxsInsertedKey is the key of the counter of transactions inserted into a lane's mempool.
+	TxsInsertedKey = []string{"blocksdk", "lane", "txs", "inserted"}
+	// TxsEvictedKey is the key of the counter of transactions evicted from a lane's mempool.
+	TxsEvictedKey = []string{"blocksdk", "lane", "txs", "evicted"}
+	// TxsRejectedKey is the key of the counter of transactions rejected by a lane's mempool.
+	TxsRejectedKey = []string{"blocksdk", "lane", "txs", "rejected"}
+	// PrepareLaneLatencyKey is the key of the latency (in milliseconds) of preparing a lane's
+	// partial proposal.
+	PrepareLaneLatencyKey = []string{"blocksdk", "lane", "prepare", "latency"}
+	// ProcessLaneLatencyKey is the key of the latency (in milliseconds) of verifying a lane's
+	// partial proposal.
+	ProcessLaneLatencyKey = []string{"blocksdk", "lane", "process", "latency"}
+	// PrepareProposalLatencyKey is the key of the latency (in milliseconds) of preparing a
+	// proposal.
+	PrepareProposalLatencyKey = []string{"blocksdk", "prepare_proposal", "latency"}
+	// ProcessProposalLatencyKey is the key of the latency (in milliseconds) of verifying a
+	// proposal.
+	ProcessProposalLatencyKey = []string{"blocksdk", "process_proposal", "latency"}
+	// LaneFailuresKey is the key of the counter of lanes that failed to prepare their partial
+	// proposal and were skipped.
+	LaneFailuresKey = []string{"blocksdk", "lane", "prepare", "failures"}
+	// TxPanicsKey is the key of the counter of transactions that panicked while a lane was
+	// preparing its partial proposal.
+	TxPanicsKey = []string{"blocksdk", "lane", "prepare", "tx_panics"}
+	// BidKey is the key of the values of the valid auction bids submitted to a lane.
+	BidKey = []string{"blocksdk", "auction", "bid"}
+	// WinningBidKey is the key of the values of the auction bids included in proposals.
+	WinningBidKey = []string{"blocksdk", "auction", "winning_bid"}
+	// ShadowProposalAcceptedKey is the key of the counter of proposals that were verified in
+	// shadow mode and would have been accepted.
+	ShadowProposalAcceptedKey = []string{"blocksdk", "shadow_process_proposal", "accepted"}
+	// ShadowProposalRejectedKey is the key of the counter of proposals that were verified in
+	// shadow mode and would have been rejected.
+	ShadowProposalRejectedKey = []string{"blocksdk", "shadow_process_proposal", "rejected"}
+)
+
+var _ Metrics = TelemetryMetrics{}
+
+// TelemetryMetrics is a Metrics implementation that reports to the Cosmos SDK telemetry.
+// The metrics are exported with the rest of the application's metrics (e.g. to Prometheus)
+// when telemetry is enabled in the application's configuration.
+type TelemetryMetrics struct{}
+
+// NewTelemetryMetrics returns a Metrics implementation that reports to the Cosmos SDK
+// telemetry.
+func NewTelemetryMetrics() Metrics {
+	return TelemetryMetrics{}
+}
+
+// SetLaneSize implements Metrics.
+func (TelemetryMetrics) SetLaneSize(lane string, numTxs int) {
+	telemetry.SetGaugeWithLabels(LaneSizeKey, float32(numTxs), laneLabels(lane))
+}
+
+// ObservePartialProposal implements Metrics.
+func (TelemetryMetrics) ObservePartialProposal(lane string, numTxs int, size int64, gas uint64) {
+	telemetry.SetGaugeWithLabels(PartialProposalTxsKey, float32(numTxs), laneLabels(lane))
+	telemetry.SetGaugeWithLabels(PartialProposalBytesKey, float32(size), laneLabels(lane))
+	telemetry.SetGaugeWithLabels(PartialProposalGasKey, float32(gas), laneLabels(lane))
+}
+
+// AddTxsInserted implements Metrics.
+func (TelemetryMetrics) AddTxsInserted(lane string, numTxs int) {
+	telemetry.IncrCounterWithLabels(TxsInsertedKey, float32(numTxs), laneLabels(lane))
+}
+
+// AddTxsEvicted implements Metrics.
+func (TelemetryMetrics) AddTxsEvicted(lane string, numTxs int) {
+	telemetry.IncrCounterWithLabels(TxsEvictedKey, float32(numTxs), laneLabels(lane))
+}
+
+// AddTxsRejected implements Metrics.
+func (TelemetryMetrics) AddTxsRejected(lane string, numTxs int) {
+	telemetry.IncrCounterWithLabels(TxsRejectedKey, float32(numTxs), laneLabels(lane))
+}
+
+// ObservePrepareLaneLatency implements Metrics.
+func (TelemetryMetrics) ObservePrepareLaneLatency(lane string, latency time.Duration) {
+	gometrics.AddSampleWithLabels(PrepareLaneLatencyKey, milliseconds(latency), laneLabels(lane))
+}
+
+// ObserveProcessLaneLatency implements Metrics.
+func (TelemetryMetrics) ObserveProcessLaneLatency(lane string, latency time.Duration) {
+	gometrics.AddSampleWithLabels(ProcessLaneLatencyKey, milliseconds(latency), laneLabels(lane))
+}
+
+// ObservePrepareProposalLatency implements Metrics.
+func (TelemetryMetrics) ObservePrepareProposalLatency(latency time.Duration) {
+	gometrics.AddSample(PrepareProposalLatencyKey, milliseconds(latency))
+}
+
+// ObserveProcessProposalLatency implements Metrics.
+func (TelemetryMetrics) ObserveProcessProposalLatency(latency time.Duration) {
+	gometrics.AddSample(ProcessProposalLatencyKey, milliseconds(latency))
+}
+
+// AddLaneFailure implements Metrics.
+func (TelemetryMetrics) AddLaneFailure(lane string) {
+	telemetry.IncrCounterWithLabels(LaneFailuresKey, 1, laneLabels(lane))
+}
+
+// AddTxPanic implements Metrics.
+func (TelemetryMetrics) AddTxPanic(lane string) {
+	telemetry.IncrCounterWithLabels(TxPanicsKey, 1, laneLabels(lane))
+}
+
+// ObserveBid implements Metrics.
+func (TelemetryMetrics) ObserveBid(lane string, bid sdk.Coin) {
+	gometrics.AddSampleWithLabels(BidKey, coinAmount(bid), bidLabels(lane, bid))
+}
+
+// ObserveWinningBid implements Metrics.
+func (TelemetryMetrics) ObserveWinningBid(lane string, bid sdk.Coin) {
+	gometrics.AddSampleWithLabels(WinningBidKey, coinAmount(bid), bidLabels(lane, bid))
+}
+
+// AddShadowProposalAccepted implements Metrics.
+func (TelemetryMetrics) AddShadowProposalAccepted() {
+	telemetry.IncrCounter(1, ShadowProposalAcceptedKey...)
+}
+
+// AddShadowProposalRejected implements Metrics. The index of the offending transaction is
+// not used as a label to keep the cardinality of the metric low.
+func (TelemetryMetrics) AddShadowProposalRejected(lane string, invariant string) {
+	telemetry.IncrCounterWithLabels(
+		ShadowProposalRejectedKey,
+		1,
+		append(laneLabels(lane), telemetry.NewLabel(InvariantLabel, invariant)),
+	)
+}
+
+// laneLabels returns the labels identifying the given lane.
+func laneLabels(lane string) []gometrics.Label {
+	return []gometrics.Label{telemetry.NewLabel(LaneLabel, lane)}
+}
+
+// bidLabels returns the labels identifying the lane and denom of an auction bid.
+func bidLabels(lane string, bid sdk.Coin) []gometrics.Label {
+	return append(laneLabels(lane), telemetry.NewLabel(DenomLabel, bid.Denom))
+}
+
+// milliseconds returns the given duration in milliseconds.
+func milliseconds(d time.Duration) float32 {
+	return float32(d) / float32(time.Millisecond)
+}
+
+// coinAmount returns the amount of the given coin as a float. Precision may be lost for
+// large amounts.
+func coinAmount(coin sdk.Coin) float32 {
+	if coin.Amount.IsNil() {
+		return 0
+	}
+
+	amount, _ := new(big.Float).SetInt(coin.Amount.BigInt()).Float32()
+	return amount
+}
@@ -89,6 +89,24 @@ func (_m *Lane) CountTx() int {
 	return r0
 }
 
+// GetBlockSpace provides a mock function with given fields:
+func (_m *Lane) GetBlockSpace() proposals.BlockSpace {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBlockSpace")
+	}
+
+	var r0 proposals.BlockSpace
+	if rf, ok := ret.Get(0).(func() proposals.BlockSpace); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(proposals.BlockSpace)
+	}
+
+	return r0
+}
+
 // GetMaxBlockSpace provides a mock function with given fields:
 func (_m *Lane) GetMaxBlockSpace() math.LegacyDec {
 	ret := _m.Called()
@@ -0,0 +1,212 @@
+package proposals
+
+import (
+	"cosmossdk.io/math"
+)
+
+type (
+	// Allocation tracks the limits of the lane that is currently building (or verifying)
+	// its partial proposal along with the block space that has been left unused by the
+	// lanes that came before it. Allocations are a deterministic function of the lanes'
+	// block space configurations and the transactions each lane included, so that the
+	// same limits are computed when preparing and when processing a proposal.
+	Allocation struct {
+		// Lane is the name of the lane the limits were computed for.
+		Lane string
+		// Limits are the limits of the lane.
+		Limits LaneLimits
+
+		// UnusedTxBytes is the number of bytes that were left unused by previous lanes and
+		// that can be redistributed to the remaining lanes.
+		UnusedTxBytes int64
+		// UnusedGasLimit is the gas limit that was left unused by previous lanes and that
+		// can be redistributed to the remaining lanes.
+		UnusedGasLimit uint64
+
+		// capTxBytes and capGasLimit are the byte and gas ceilings of the lane as
+		// defined by its max block space. They are zero if the lane is unlimited.
+		capTxBytes  int64
+		capGasLimit uint64
+
+		// startTxBytes and startGasLimit are the size and gas limit of the proposal
+		// before the lane added any transactions.
+		startTxBytes  int64
+		startGasLimit uint64
+	}
+)
+
+// AllocateLane computes the limits of the given lane and records them on the proposal so
+// that they are used when the lane builds or verifies its partial proposal. Remaining are
+// the lanes that come after the given lane. AllocateLane works as follows:
+//
+//  1. The capacity left unused by the previous lane is added to the unused capacity of
+//     the proposal. Only lanes with a max block space ceiling contribute unused capacity.
+//  2. The block space reserved by the remaining lanes (MinTxBytes, MinGasLimit) cannot be
+//     consumed by the lane.
+//  3. A lane with a ceiling receives a share of the unused capacity proportional to its
+//     weight relative to the weights of itself and the remaining lanes.
+//  4. A lane without a ceiling (zero ratio) may consume everything that is not reserved.
+//
+// AllocateLane must be called for each lane in order, whether or not the lane ends up
+// including transactions in the proposal.
+func (p *Proposal) AllocateLane(lane Lane, remaining []Lane) {
+	// Account for the capacity left unused by the previous lane.
+	if p.Allocation.Lane != "" {
+		if p.Allocation.capTxBytes > 0 {
+			used := p.Info.BlockSize - p.Allocation.startTxBytes
+			p.Allocation.UnusedTxBytes += p.Allocation.capTxBytes - used
+			p.Allocation.UnusedTxBytes = clampInt64(p.Allocation.UnusedTxBytes, 0, p.Info.MaxBlockSize)
+		}
+
+		if p.Allocation.capGasLimit > 0 {
+			used := p.Info.GasLimit - p.Allocation.startGasLimit
+			unused := addUint64(p.Allocation.UnusedGasLimit, p.Allocation.capGasLimit)
+			if unused > used {
+				unused -= used
+			} else {
+				unused = 0
+			}
+
+			p.Allocation.UnusedGasLimit = minUint64(unused, p.Info.MaxGasLimit)
+		}
+	}
+
+	space := p.GetBlockSpace(lane)
+
+	// Determine the block space reserved by the remaining lanes as well as the total weight
+	// of the lanes that are eligible for redistributed capacity.
+	reservedTxBytes, reservedGasLimit := int64(0), uint64(0)
+	txBytesWeight := weightOf(space, space.MaxTxBytes)
+	gasLimitWeight := weightOf(space, space.MaxGasLimit)
+	for _, next := range remaining {
+		nextSpace := p.GetBlockSpace(next)
+
+		reservedTxBytes += p.ratioOfTxBytes(nextSpace.MinTxBytes)
+		reservedGasLimit = addUint64(reservedGasLimit, p.ratioOfGasLimit(nextSpace.MinGasLimit))
+		txBytesWeight += weightOf(nextSpace, nextSpace.MaxTxBytes)
+		gasLimitWeight += weightOf(nextSpace, nextSpace.MaxGasLimit)
+	}
+
+	// Determine the limits of the lane.
+	availableTxBytes := clampInt64(p.Info.MaxBlockSize-p.Info.BlockSize-reservedTxBytes, 0, p.Info.MaxBlockSize)
+	maxTxBytes, capTxBytes := availableTxBytes, int64(0)
+	if !isUnlimited(space.MaxTxBytes) {
+		capTxBytes = p.ratioOfTxBytes(space.MaxTxBytes)
+
+		bonus := int64(0)
+		if txBytesWeight > 0 {
+			bonus = math.NewInt(p.Allocation.UnusedTxBytes).
+				Mul(math.NewIntFromUint64(weightOf(space, space.MaxTxBytes))).
+				Quo(math.NewIntFromUint64(txBytesWeight)).
+				Int64()
+		}
+
+		maxTxBytes = clampInt64(capTxBytes+bonus, 0, availableTxBytes)
+	}
+
+	availableGasLimit := uint64(0)
+	if consumed := addUint64(p.Info.GasLimit, reservedGasLimit); consumed < p.Info.MaxGasLimit {
+		availableGasLimit = p.Info.MaxGasLimit - consumed
+	}
+
+	maxGasLimit, capGasLimit := availableGasLimit, uint64(0)
+	if !isUnlimited(space.MaxGasLimit) {
+		capGasLimit = p.ratioOfGasLimit(space.MaxGasLimit)
+
+		bonus := uint64(0)
+		if gasLimitWeight > 0 {
+			bonus = math.NewIntFromUint64(p.Allocation.UnusedGasLimit).
+				Mul(math.NewIntFromUint64(weightOf(space, space.MaxGasLimit))).
+				Quo(math.NewIntFromUint64(gasLimitWeight)).
+				Uint64()
+		}
+
+		maxGasLimit = minUint64(addUint64(capGasLimit, bonus), availableGasLimit)
+	}
+
+	p.Allocation.Lane = lane.Name()
+	p.Allocation.Limits = LaneLimits{
+		MaxTxBytes:  maxTxBytes,
+		MaxGasLimit: maxGasLimit,
+		MaxTxs:      space.MaxTxs,
+	}
+	p.Allocation.capTxBytes = capTxBytes
+	p.Allocation.capGasLimit = capGasLimit
+	p.Allocation.startTxBytes = p.Info.BlockSize
+	p.Allocation.startGasLimit = p.Info.GasLimit
+}
+
+// GetLaneLimitsForLane returns the limits of the given lane. If the lane has been
+// allocated block space via AllocateLane, the allocated limits are returned. Otherwise,
+// the limits are computed from the lane's block space (see GetBlockSpace) alone.
+func (p *Proposal) GetLaneLimitsForLane(lane Lane) LaneLimits {
+	if p.Allocation.Lane != "" && p.Allocation.Lane == lane.Name() {
+		return p.Allocation.Limits
+	}
+
+	return p.GetLaneLimitsFromBlockSpace(p.GetBlockSpace(lane))
+}
+
+// ratioOfTxBytes returns the number of bytes that correspond to the given ratio of the
+// proposal's max block size.
+func (p *Proposal) ratioOfTxBytes(ratio math.LegacyDec) int64 {
+	if isUnlimited(ratio) {
+		return 0
+	}
+
+	return ratio.MulInt64(p.Info.MaxBlockSize).TruncateInt().Int64()
+}
+
+// ratioOfGasLimit returns the gas limit that corresponds to the given ratio of the
+// proposal's max gas limit.
+func (p *Proposal) ratioOfGasLimit(ratio math.LegacyDec) uint64 {
+	if isUnlimited(ratio) {
+		return 0
+	}
+
+	return ratio.MulInt(math.NewIntFromUint64(p.Info.MaxGasLimit)).TruncateInt().Uint64()
+}
+
+// isUnlimited returns true if the ratio is unset or zero.
+func isUnlimited(ratio math.LegacyDec) bool {
+	return ratio.IsNil() || ratio.IsZero()
+}
+
+// weightOf returns the redistribution weight of a lane for the resource with the given
+// ratio. Lanes without a ceiling already consume all available capacity, so they do not
+// take part in the redistribution.
+func weightOf(space BlockSpace, ratio math.LegacyDec) uint64 {
+	if isUnlimited(ratio) {
+		return 0
+	}
+
+	return space.Weight
+}
+
+func clampInt64(value, lower, upper int64) int64 {
+	switch {
+	case value < lower:
+		return lower
+	case value > upper:
+		return upper
+	default:
+		return value
+	}
+}
+
+func minUint64(a, b uint64) uint64 {
+	if a < b {
+		return a
+	}
+
+	return b
+}
+
+// addUint64 adds two uint64 values, saturating at the max uint64 value.
+func addUint64(a, b uint64) uint64 {
+	if a > MaxUint64-b {
+		return MaxUint64
+	}
+
+	return a + b
+}
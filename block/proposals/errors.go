@@ -0,0 +1,103 @@
+package proposals
+
+import (
+	"errors"
+)
+
+// Invariant identifies a rule that a proposal must respect for it to be accepted.
+type Invariant string
+
+const (
+	// InvariantDecode is violated by transactions that cannot be decoded.
+	InvariantDecode Invariant = "decode"
+	// InvariantProposalInfo is violated by proposals whose proposal info is missing or does
+	// not match the proposal.
+	InvariantProposalInfo Invariant = "proposal_info"
+	// InvariantMatch is violated by transactions that are not contiguous with the other
+	// transactions of their lane or that do not belong to any lane.
+	InvariantMatch Invariant = "match"
+	// InvariantOrdering is violated by transactions that are not ordered respecting the
+	// priority defined by their lane.
+	InvariantOrdering Invariant = "ordering"
+	// InvariantBundle is violated by bid transactions whose bundled transactions are not
+	// included in the proposal as defined by the bid.
+	InvariantBundle Invariant = "bundle"
+	// InvariantVerifyTx is violated by transactions that fail the verification logic of
+	// their lane (e.g. the ante handler).
+	InvariantVerifyTx Invariant = "verify_tx"
+	// InvariantDuplicateTx is violated by transactions that are included more than once.
+	InvariantDuplicateTx Invariant = "duplicate_tx"
+	// InvariantLaneLimit is violated by partial proposals that exceed the limits of their lane.
+	InvariantLaneLimit Invariant = "lane_limit"
+	// InvariantBlockLimit is violated by proposals that exceed the limits of the block.
+	InvariantBlockLimit Invariant = "block_limit"
+	// InvariantUnknown is used for errors that do not identify the violated invariant.
+	InvariantUnknown Invariant = "unknown"
+)
+
+// InvariantError is returned when a proposal violates one of its invariants. It identifies
+// the lane that rejected the proposal, the index of the offending transaction and the
+// invariant that was violated.
+type InvariantError struct {
+	// Lane is the name of the lane that rejected the proposal. It is empty if the
+	// invariant is not specific to a lane.
+	Lane string
+	// TxIndex is the index of the offending transaction. Lanes report the index relative to
+	// the transactions they are given; the index is relative to the proposal once the error
+	// is returned from ProcessLane. It is -1 if the invariant is not specific to a transaction.
+	TxIndex int
+	// Invariant is the invariant that was violated.
+	Invariant Invariant
+	// Err is the underlying error.
+	Err error
+}
+
+// NewInvariantError returns a new invariant error for the transaction at the given index.
+func NewInvariantError(lane string, txIndex int, invariant Invariant, err error) *InvariantError {
+	return &InvariantError{
+		Lane:      lane,
+		TxIndex:   txIndex,
+		Invariant: invariant,
+		Err:       err,
+	}
+}
+
+// Error implements the error interface.
+func (e *InvariantError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap returns the underlying error.
+func (e *InvariantError) Unwrap() error {
+	return e.Err
+}
+
+// GetInvariantError returns the invariant error wrapped by the given error. Errors that are
+// not invariant errors are reported as violating an unknown invariant of the given lane.
+func GetInvariantError(lane string, err error) *InvariantError {
+	var invariantErr *InvariantError
+	if errors.As(err, &invariantErr) {
+		return invariantErr
+	}
+
+	return NewInvariantError(lane, -1, InvariantUnknown, err)
+}
+
+// OffsetInvariantError offsets the transaction index of the invariant error wrapped by the
+// given error. This is used to convert an index relative to the transactions a lane was given
+// into an index relative to the proposal. Errors that are not invariant errors are reported
+// as violating an unknown invariant of the given lane.
+func OffsetInvariantError(lane string, offset int, err error) error {
+	invariantErr := GetInvariantError(lane, err)
+
+	offsetErr := NewInvariantError(invariantErr.Lane, invariantErr.TxIndex, invariantErr.Invariant, err)
+	if error(invariantErr) == err {
+		offsetErr.Err = invariantErr.Err
+	}
+
+	if offsetErr.TxIndex >= 0 {
+		offsetErr.TxIndex += offset
+	}
+
+	return offsetErr
+}
@@ -0,0 +1,115 @@
+package proposals
+
+import (
+	"fmt"
+	"math"
+
+	comettypes "github.com/cometbft/cometbft/types"
+
+	"github.com/skip-mev/block-sdk/v2/block/proposals/types"
+)
+
+// GetProposalInfo extracts the proposal info from the first slot of a proposal that was
+// built with GetProposalWithInfo. It returns the proposal info along with the remaining
+// transactions in the proposal.
+func GetProposalInfo(proposal [][]byte) (types.ProposalInfo, [][]byte, error) {
+	if len(proposal) == 0 {
+		return types.ProposalInfo{}, nil, NewInvariantError(
+			"",
+			-1,
+			InvariantProposalInfo,
+			fmt.Errorf("proposal does not contain proposal info"),
+		)
+	}
+
+	var info types.ProposalInfo
+	if err := info.Unmarshal(proposal[0]); err != nil {
+		return types.ProposalInfo{}, nil, NewInvariantError(
+			"",
+			0,
+			InvariantProposalInfo,
+			fmt.Errorf("failed to unmarshal proposal info: %w", err),
+		)
+	}
+
+	if info.TxsByLane == nil {
+		info.TxsByLane = make(map[string]uint64)
+	}
+
+	return info, proposal[1:], nil
+}
+
+// GetMaxProposalInfoSize returns an upper bound on the number of bytes the proposal info
+// of a proposal built by the given lanes can take up in a block. This space must be
+// reserved when the proposal is built so that the proposal (including its info) does not
+// exceed the block size.
+func GetMaxProposalInfoSize(laneNames []string) (int64, error) {
+	info := types.ProposalInfo{
+		TxsByLane:          make(map[string]uint64, len(laneNames)),
+		MaxBlockSize:       math.MaxInt64,
+		MaxGasLimit:        MaxUint64,
+		BlockSize:          math.MaxInt64,
+		GasLimit:           MaxUint64,
+		MempoolDepthByLane: make(map[string]uint64, len(laneNames)),
+	}
+	for _, name := range laneNames {
+		info.TxsByLane[name] = MaxUint64
+		info.MempoolDepthByLane[name] = MaxUint64
+	}
+
+	infoBz, err := info.Marshal()
+	if err != nil {
+		return 0, err
+	}
+
+	return comettypes.ComputeProtoSizeForTxs([]comettypes.Tx{infoBz}), nil
+}
+
+// VerifyProposalInfo verifies that the proposal matches the given proposal info. In
+// particular, the number of transactions included by each lane, the size of the block
+// and the gas limit of the block must all match. The mempool depth reported by the
+// proposer is local to the proposer and cannot be verified.
+func (p *Proposal) VerifyProposalInfo(info types.ProposalInfo) error {
+	if info.BlockSize != p.Info.BlockSize {
+		return NewInvariantError("", -1, InvariantProposalInfo, fmt.Errorf(
+			"block size does not match proposal info: expected %d, got %d",
+			info.BlockSize,
+			p.Info.BlockSize,
+		))
+	}
+
+	if info.GasLimit != p.Info.GasLimit {
+		return NewInvariantError("", -1, InvariantProposalInfo, fmt.Errorf(
+			"gas limit does not match proposal info: expected %d, got %d",
+			info.GasLimit,
+			p.Info.GasLimit,
+		))
+	}
+
+	// Lanes that did not include any transactions may or may not be present in either the
+	// proposal or the proposal info, so only non-zero counts are compared (a missing lane
+	// counts as zero transactions).
+	for lane, numTxs := range info.TxsByLane {
+		if numTxs != 0 && numTxs != p.Info.TxsByLane[lane] {
+			return NewInvariantError(lane, -1, InvariantProposalInfo, fmt.Errorf(
+				"number of transactions in lane %s does not match proposal info: expected %d, got %d",
+				lane,
+				numTxs,
+				p.Info.TxsByLane[lane],
+			))
+		}
+	}
+
+	for lane, numTxs := range p.Info.TxsByLane {
+		if numTxs != 0 && numTxs != info.TxsByLane[lane] {
+			return NewInvariantError(lane, -1, InvariantProposalInfo, fmt.Errorf(
+				"number of transactions in lane %s does not match proposal info: expected %d, got %d",
+				lane,
+				info.TxsByLane[lane],
+				numTxs,
+			))
+		}
+	}
+
+	return nil
+}
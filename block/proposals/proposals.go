@@ -19,6 +19,13 @@ type (
 		Cache map[string]struct{}
 		// Info contains information about the state of the proposal.
 		Info types.ProposalInfo
+		// Allocation contains the limits of the lane currently updating the proposal
+		// and the block space left unused by the previous lanes.
+		Allocation Allocation
+		// BlockSpaces maps the name of a lane to the block space it uses in this proposal,
+		// overriding the block space the lane is configured with (e.g. with the lane
+		// configurations stored on-chain). Lanes that are not listed use their own.
+		BlockSpaces map[string]BlockSpace
 	}
 )
 
@@ -44,10 +51,8 @@ func NewProposal(logger log.Logger, maxBlockSize int64, maxGasLimit uint64) Prop
 }
 
 // GetProposalWithInfo returns all of the transactions in the proposal along with information
-// about the lanes that built the proposal.
-//
-// NOTE: This is currently not used in production but likely will be once
-// ABCI 3.0 is released.
+// about the lanes that built the proposal. The proposal info is placed in the first slot of
+// the proposal and can be extracted with GetProposalInfo.
 func (p *Proposal) GetProposalWithInfo() ([][]byte, error) {
 	// Marshall the proposal info into the first slot of the proposal.
 	infoBz, err := p.Info.Marshal()
@@ -67,6 +72,14 @@ func (p *Proposal) GetProposalWithInfo() ([][]byte, error) {
 // gas limit they can include in the proposal before constructing a partial
 // proposal.
 func (p *Proposal) GetLaneLimits(ratio math.LegacyDec) LaneLimits {
+	return p.GetLaneLimitsFromBlockSpace(NewBlockSpace(ratio))
+}
+
+// GetLaneLimitsFromBlockSpace returns the maximum number of bytes, gas limit and
+// number of transactions that can be included/consumed in the proposal for the
+// given block space. The byte and gas budgets are computed independently of each
+// other using their respective ratios.
+func (p *Proposal) GetLaneLimitsFromBlockSpace(space BlockSpace) LaneLimits {
 	var (
 		txBytes  int64
 		gasLimit uint64
@@ -74,12 +87,18 @@ func (p *Proposal) GetLaneLimits(ratio math.LegacyDec) LaneLimits {
 
 	// In the case where the ratio is zero, we return the max tx bytes remaining.
 	// Note, the only lane that should have a ratio of zero is the default lane.
-	if ratio.IsZero() {
+	if space.MaxTxBytes.IsZero() {
 		txBytes = p.Info.MaxBlockSize - p.Info.BlockSize
 		if txBytes < 0 {
 			txBytes = 0
 		}
+	} else {
+		// Otherwise, we calculate the max tx bytes for the lane based on the ratio.
+		txBytes = space.MaxTxBytes.MulInt64(p.Info.MaxBlockSize).TruncateInt().Int64()
+	}
 
+	// The same applies to the gas limit.
+	if space.MaxGasLimit.IsZero() {
 		// Unsigned subtraction needs an additional check
 		if p.Info.GasLimit >= p.Info.MaxGasLimit {
 			gasLimit = 0
@@ -87,17 +106,26 @@ func (p *Proposal) GetLaneLimits(ratio math.LegacyDec) LaneLimits {
 			gasLimit = p.Info.MaxGasLimit - p.Info.GasLimit
 		}
 	} else {
-		// Otherwise, we calculate the max tx bytes / gas limit for the lane based on the ratio.
-		txBytes = ratio.MulInt64(p.Info.MaxBlockSize).TruncateInt().Int64()
-		gasLimit = ratio.MulInt(math.NewIntFromUint64(p.Info.MaxGasLimit)).TruncateInt().Uint64()
+		gasLimit = space.MaxGasLimit.MulInt(math.NewIntFromUint64(p.Info.MaxGasLimit)).TruncateInt().Uint64()
 	}
 
 	return LaneLimits{
 		MaxTxBytes:  txBytes,
 		MaxGasLimit: gasLimit,
+		MaxTxs:      space.MaxTxs,
 	}
 }
 
+// GetBlockSpace returns the block space the given lane uses in the proposal (see
+// BlockSpaces).
+func (p *Proposal) GetBlockSpace(lane Lane) BlockSpace {
+	if space, ok := p.BlockSpaces[lane.Name()]; ok {
+		return space
+	}
+
+	return lane.GetBlockSpace()
+}
+
 // Contains returns true if the proposal contains the given transaction.
 func (p *Proposal) Contains(txHash string) bool {
 	_, ok := p.Cache[txHash]
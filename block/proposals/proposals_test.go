@@ -1,6 +1,7 @@
 package proposals_test
 
 import (
+	"fmt"
 	"math/rand"
 	"testing"
 
@@ -29,7 +30,7 @@ func TestUpdateProposal(t *testing.T) {
 	lane := mocks.NewLane(t)
 
 	lane.On("Name").Return("test").Maybe()
-	lane.On("GetMaxBlockSpace").Return(math.LegacyNewDec(1)).Maybe()
+	lane.On("GetBlockSpace").Return(proposals.NewBlockSpace(math.LegacyNewDec(1))).Maybe()
 
 	t.Run("can update with no transactions", func(t *testing.T) {
 		proposal := proposals.NewProposal(log.NewNopLogger(), 100, 100)
@@ -171,7 +172,7 @@ func TestUpdateProposal(t *testing.T) {
 		otherlane := mocks.NewLane(t)
 
 		otherlane.On("Name").Return("test").Maybe()
-		otherlane.On("GetMaxBlockSpace").Return(math.LegacyNewDec(1)).Maybe()
+		otherlane.On("GetBlockSpace").Return(proposals.NewBlockSpace(math.LegacyNewDec(1))).Maybe()
 
 		txsWithInfo, err = getTxsWithInfo([]sdk.Tx{tx})
 		require.NoError(t, err)
@@ -268,7 +269,7 @@ func TestUpdateProposal(t *testing.T) {
 		lane := mocks.NewLane(t)
 
 		lane.On("Name").Return("test").Maybe()
-		lane.On("GetMaxBlockSpace").Return(math.LegacyMustNewDecFromStr("0.5")).Maybe()
+		lane.On("GetBlockSpace").Return(proposals.NewBlockSpace(math.LegacyMustNewDecFromStr("0.5"))).Maybe()
 
 		txsWithInfo, err := getTxsWithInfo([]sdk.Tx{tx})
 		require.NoError(t, err)
@@ -309,7 +310,7 @@ func TestUpdateProposal(t *testing.T) {
 		lane := mocks.NewLane(t)
 
 		lane.On("Name").Return("test").Maybe()
-		lane.On("GetMaxBlockSpace").Return(math.LegacyMustNewDecFromStr("0.5")).Maybe()
+		lane.On("GetBlockSpace").Return(proposals.NewBlockSpace(math.LegacyMustNewDecFromStr("0.5"))).Maybe()
 
 		txsWithInfo, err := getTxsWithInfo([]sdk.Tx{tx})
 		require.NoError(t, err)
@@ -329,6 +330,51 @@ func TestUpdateProposal(t *testing.T) {
 		require.Equal(t, 1, len(block))
 	})
 
+	t.Run("rejects an update where the lane limit is smaller (txs)", func(t *testing.T) {
+		txs := make([]sdk.Tx, 0)
+
+		for i := 0; i < 3; i++ {
+			tx, err := testutils.CreateRandomTx(
+				encodingConfig.TxConfig,
+				accounts[0],
+				0,
+				uint64(i),
+				0,
+				100,
+			)
+			require.NoError(t, err)
+
+			txs = append(txs, tx)
+		}
+
+		proposal := proposals.NewProposal(log.NewNopLogger(), 1000000, 1000000)
+
+		lane := mocks.NewLane(t)
+
+		space := proposals.NewBlockSpace(math.LegacyOneDec())
+		space.MaxTxs = 2
+
+		lane.On("Name").Return("test").Maybe()
+		lane.On("GetBlockSpace").Return(space).Maybe()
+
+		txsWithInfo, err := getTxsWithInfo(txs)
+		require.NoError(t, err)
+
+		err = proposal.UpdateProposal(lane, txsWithInfo)
+		require.Error(t, err)
+
+		// Ensure that the proposal is empty.
+		require.Equal(t, 0, len(proposal.Txs))
+		require.Equal(t, int64(0), proposal.Info.BlockSize)
+		require.Equal(t, 0, len(proposal.Info.TxsByLane))
+		require.Equal(t, uint64(0), proposal.Info.GasLimit)
+
+		// The same transactions fit once the limit is large enough.
+		err = proposal.UpdateProposal(lane, txsWithInfo[:2])
+		require.NoError(t, err)
+		require.Equal(t, 2, len(proposal.Txs))
+	})
+
 	t.Run("rejects an update where the proposal exceeds max block size", func(t *testing.T) {
 		tx, err := testutils.CreateRandomTx(
 			encodingConfig.TxConfig,
@@ -435,7 +481,7 @@ func TestUpdateProposal(t *testing.T) {
 
 		otherlane := mocks.NewLane(t)
 		otherlane.On("Name").Return("test2")
-		otherlane.On("GetMaxBlockSpace").Return(math.LegacyMustNewDecFromStr("1.0"))
+		otherlane.On("GetBlockSpace").Return(proposals.NewBlockSpace(math.LegacyMustNewDecFromStr("1.0")))
 
 		txsWithInfo, err = getTxsWithInfo([]sdk.Tx{tx2})
 		require.NoError(t, err)
@@ -580,6 +626,295 @@ func TestGetLaneLimits(t *testing.T) {
 	}
 }
 
+func TestGetLaneLimitsFromBlockSpace(t *testing.T) {
+	testCases := []struct {
+		name              string
+		maxTxBytes        int64
+		totalTxBytesUsed  int64
+		maxGasLimit       uint64
+		totalGasLimitUsed uint64
+		space             proposals.BlockSpace
+		expectedTxBytes   int64
+		expectedGasLimit  uint64
+		expectedTxs       uint64
+	}{
+		{
+			"same ratio for bytes and gas",
+			100,
+			0,
+			80,
+			0,
+			proposals.NewBlockSpace(math.LegacyMustNewDecFromStr("0.25")),
+			25,
+			20,
+			0,
+		},
+		{
+			"independent ratios for bytes and gas",
+			100,
+			0,
+			80,
+			0,
+			proposals.BlockSpace{
+				MaxTxBytes:  math.LegacyMustNewDecFromStr("0.1"),
+				MaxGasLimit: math.LegacyMustNewDecFromStr("0.5"),
+			},
+			10,
+			40,
+			0,
+		},
+		{
+			"zero byte ratio uses the remaining bytes",
+			100,
+			30,
+			80,
+			30,
+			proposals.BlockSpace{
+				MaxTxBytes:  math.LegacyZeroDec(),
+				MaxGasLimit: math.LegacyMustNewDecFromStr("0.5"),
+			},
+			70,
+			40,
+			0,
+		},
+		{
+			"zero gas ratio uses the remaining gas",
+			100,
+			30,
+			80,
+			30,
+			proposals.BlockSpace{
+				MaxTxBytes:  math.LegacyMustNewDecFromStr("0.5"),
+				MaxGasLimit: math.LegacyZeroDec(),
+			},
+			50,
+			50,
+			0,
+		},
+		{
+			"max txs is passed through",
+			100,
+			0,
+			80,
+			0,
+			proposals.BlockSpace{
+				MaxTxBytes:  math.LegacyOneDec(),
+				MaxGasLimit: math.LegacyOneDec(),
+				MaxTxs:      5,
+			},
+			100,
+			80,
+			5,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			proposal := proposals.Proposal{
+				Info: types.ProposalInfo{
+					MaxBlockSize: tc.maxTxBytes,
+					BlockSize:    tc.totalTxBytesUsed,
+					MaxGasLimit:  tc.maxGasLimit,
+					GasLimit:     tc.totalGasLimitUsed,
+				},
+			}
+
+			res := proposal.GetLaneLimitsFromBlockSpace(tc.space)
+			require.Equal(t, tc.expectedTxBytes, res.MaxTxBytes)
+			require.Equal(t, tc.expectedGasLimit, res.MaxGasLimit)
+			require.Equal(t, tc.expectedTxs, res.MaxTxs)
+		})
+	}
+}
+
+func TestAllocateLane(t *testing.T) {
+	newLane := func(name string, space proposals.BlockSpace) *mocks.Lane {
+		lane := mocks.NewLane(t)
+		lane.On("Name").Return(name).Maybe()
+		lane.On("GetBlockSpace").Return(space).Maybe()
+		return lane
+	}
+
+	withWeight := func(space proposals.BlockSpace, weight uint64) proposals.BlockSpace {
+		space.Weight = weight
+		return space
+	}
+
+	withMin := func(space proposals.BlockSpace, ratio math.LegacyDec) proposals.BlockSpace {
+		space.MinTxBytes = ratio
+		space.MinGasLimit = ratio
+		return space
+	}
+
+	t.Run("limits match the lane's block space without reservations or weights", func(t *testing.T) {
+		proposal := proposals.NewProposal(log.NewNopLogger(), 100, 200)
+
+		lane := newLane("a", proposals.NewBlockSpace(math.LegacyMustNewDecFromStr("0.25")))
+		proposal.AllocateLane(lane, nil)
+
+		require.Equal(t, proposals.LaneLimits{MaxTxBytes: 25, MaxGasLimit: 50}, proposal.GetLaneLimitsForLane(lane))
+	})
+
+	t.Run("reserved block space of later lanes is excluded", func(t *testing.T) {
+		proposal := proposals.NewProposal(log.NewNopLogger(), 100, 200)
+
+		lane := newLane("a", proposals.NewBlockSpace(math.LegacyMustNewDecFromStr("0.8")))
+		next := newLane("b", withMin(proposals.NewBlockSpace(math.LegacyMustNewDecFromStr("0.2")), math.LegacyMustNewDecFromStr("0.3")))
+		last := newLane("default", proposals.NewBlockSpace(math.LegacyZeroDec()))
+
+		proposal.AllocateLane(lane, []proposals.Lane{next, last})
+		require.Equal(t, proposals.LaneLimits{MaxTxBytes: 70, MaxGasLimit: 140}, proposal.GetLaneLimitsForLane(lane))
+
+		// The unlimited lane receives everything that is left.
+		proposal.AllocateLane(next, []proposals.Lane{last})
+		proposal.AllocateLane(last, nil)
+		require.Equal(t, proposals.LaneLimits{MaxTxBytes: 100, MaxGasLimit: 200}, proposal.GetLaneLimitsForLane(last))
+	})
+
+	t.Run("unused block space is redistributed by weight", func(t *testing.T) {
+		proposal := proposals.NewProposal(log.NewNopLogger(), 100, 100)
+
+		a := newLane("a", proposals.NewBlockSpace(math.LegacyMustNewDecFromStr("0.4")))
+		b := newLane("b", withWeight(proposals.NewBlockSpace(math.LegacyMustNewDecFromStr("0.2")), 1))
+		c := newLane("c", withWeight(proposals.NewBlockSpace(math.LegacyMustNewDecFromStr("0.2")), 3))
+		d := newLane("default", proposals.NewBlockSpace(math.LegacyZeroDec()))
+
+		proposal.AllocateLane(a, []proposals.Lane{b, c, d})
+		require.Equal(t, int64(40), proposal.GetLaneLimitsForLane(a).MaxTxBytes)
+
+		// Lane a only uses 10 of its 40 bytes and gas.
+		proposal.Info.BlockSize += 10
+		proposal.Info.GasLimit += 10
+
+		// Lane b receives a quarter of the 30 unused bytes.
+		proposal.AllocateLane(b, []proposals.Lane{c, d})
+		require.Equal(t, int64(30), proposal.Allocation.UnusedTxBytes)
+		require.Equal(t, proposals.LaneLimits{MaxTxBytes: 27, MaxGasLimit: 27}, proposal.GetLaneLimitsForLane(b))
+
+		// Lane b does not use anything so lane c receives all of the unused bytes.
+		proposal.AllocateLane(c, []proposals.Lane{d})
+		require.Equal(t, int64(50), proposal.Allocation.UnusedTxBytes)
+		require.Equal(t, proposals.LaneLimits{MaxTxBytes: 70, MaxGasLimit: 70}, proposal.GetLaneLimitsForLane(c))
+
+		// Lanes that were not allocated fall back to their own block space.
+		require.Equal(t, proposals.LaneLimits{MaxTxBytes: 40, MaxGasLimit: 40}, proposal.GetLaneLimitsForLane(a))
+	})
+}
+
+func TestProposalInfo(t *testing.T) {
+	proposal := proposals.NewProposal(log.NewNopLogger(), 100, 100)
+	proposal.Txs = [][]byte{{0x01}, {0x02}, {0x03}}
+	proposal.Info.BlockSize = 3
+	proposal.Info.GasLimit = 30
+	proposal.Info.TxsByLane = map[string]uint64{"a": 1, "b": 2}
+
+	t.Run("can extract the proposal info", func(t *testing.T) {
+		block, err := proposal.GetProposalWithInfo()
+		require.NoError(t, err)
+
+		info, txs, err := proposals.GetProposalInfo(block)
+		require.NoError(t, err)
+		require.Equal(t, proposal.Info, info)
+		require.Equal(t, proposal.Txs, txs)
+	})
+
+	t.Run("cannot extract the proposal info from an empty proposal", func(t *testing.T) {
+		_, _, err := proposals.GetProposalInfo(nil)
+		require.Error(t, err)
+	})
+
+	t.Run("max proposal info size is an upper bound", func(t *testing.T) {
+		maxSize, err := proposals.GetMaxProposalInfoSize([]string{"a", "b"})
+		require.NoError(t, err)
+
+		infoBz, err := proposal.Info.Marshal()
+		require.NoError(t, err)
+		require.Greater(t, maxSize, int64(len(infoBz)))
+	})
+
+	cases := []struct {
+		name     string
+		malleate func(info *types.ProposalInfo)
+		pass     bool
+	}{
+		{
+			"matching info",
+			func(_ *types.ProposalInfo) {},
+			true,
+		},
+		{
+			"matching info with an empty lane",
+			func(info *types.ProposalInfo) {
+				info.TxsByLane["c"] = 0
+			},
+			true,
+		},
+		{
+			"mismatched block size",
+			func(info *types.ProposalInfo) {
+				info.BlockSize = 4
+			},
+			false,
+		},
+		{
+			"mismatched gas limit",
+			func(info *types.ProposalInfo) {
+				info.GasLimit = 20
+			},
+			false,
+		},
+		{
+			"mismatched number of txs",
+			func(info *types.ProposalInfo) {
+				info.TxsByLane["a"] = 2
+			},
+			false,
+		},
+		{
+			"missing lane",
+			func(info *types.ProposalInfo) {
+				delete(info.TxsByLane, "b")
+			},
+			false,
+		},
+		{
+			"unknown lane",
+			func(info *types.ProposalInfo) {
+				info.TxsByLane["c"] = 1
+			},
+			false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			info := proposal.Info
+			info.TxsByLane = map[string]uint64{"a": 1, "b": 2}
+			tc.malleate(&info)
+
+			err := proposal.VerifyProposalInfo(info)
+			if tc.pass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+
+	t.Run("matching info that omits an empty lane of the proposal", func(t *testing.T) {
+		withEmptyLane := proposals.NewProposal(log.NewNopLogger(), 100, 100)
+		withEmptyLane.Info = proposal.Info
+		withEmptyLane.Info.TxsByLane = map[string]uint64{"a": 1, "b": 2, "c": 0}
+
+		info := proposal.Info
+		info.TxsByLane = map[string]uint64{"a": 1, "b": 2}
+		require.NoError(t, withEmptyLane.VerifyProposalInfo(info))
+
+		info.TxsByLane = map[string]uint64{"a": 1}
+		require.Error(t, withEmptyLane.VerifyProposalInfo(info))
+	})
+}
+
 func getTxsWithInfo(txs []sdk.Tx) ([]utils.TxWithInfo, error) {
 	encoding := testutils.CreateTestEncodingConfig()
 
@@ -605,3 +940,54 @@ func getTxsWithInfo(txs []sdk.Tx) ([]utils.TxWithInfo, error) {
 
 	return txsWithInfo, nil
 }
+
+func TestInvariantError(t *testing.T) {
+	t.Run("invariant error can be retrieved from a wrapped error", func(t *testing.T) {
+		err := fmt.Errorf("wrapped: %w", proposals.NewInvariantError("a", 2, proposals.InvariantOrdering, fmt.Errorf("out of order")))
+
+		invariantErr := proposals.GetInvariantError("b", err)
+		require.Equal(t, "a", invariantErr.Lane)
+		require.Equal(t, 2, invariantErr.TxIndex)
+		require.Equal(t, proposals.InvariantOrdering, invariantErr.Invariant)
+		require.Equal(t, "out of order", invariantErr.Error())
+	})
+
+	t.Run("errors that are not invariant errors are unknown", func(t *testing.T) {
+		invariantErr := proposals.GetInvariantError("b", fmt.Errorf("unknown"))
+		require.Equal(t, "b", invariantErr.Lane)
+		require.Equal(t, -1, invariantErr.TxIndex)
+		require.Equal(t, proposals.InvariantUnknown, invariantErr.Invariant)
+	})
+
+	t.Run("can offset the index of an invariant error", func(t *testing.T) {
+		err := proposals.NewInvariantError("a", 2, proposals.InvariantVerifyTx, fmt.Errorf("invalid tx"))
+
+		offsetErr := proposals.OffsetInvariantError("b", 3, err)
+		require.Equal(t, "invalid tx", offsetErr.Error())
+
+		invariantErr := proposals.GetInvariantError("", offsetErr)
+		require.Equal(t, "a", invariantErr.Lane)
+		require.Equal(t, 5, invariantErr.TxIndex)
+		require.Equal(t, proposals.InvariantVerifyTx, invariantErr.Invariant)
+
+		// The original error is not modified.
+		require.Equal(t, 2, err.TxIndex)
+	})
+
+	t.Run("offsetting a wrapped invariant error preserves the message", func(t *testing.T) {
+		err := fmt.Errorf("wrapped: %w", proposals.NewInvariantError("a", 0, proposals.InvariantMatch, fmt.Errorf("no match")))
+
+		offsetErr := proposals.OffsetInvariantError("b", 3, err)
+		require.Equal(t, "wrapped: no match", offsetErr.Error())
+		require.Equal(t, 3, proposals.GetInvariantError("", offsetErr).TxIndex)
+	})
+
+	t.Run("errors that are not specific to a transaction are not offset", func(t *testing.T) {
+		offsetErr := proposals.OffsetInvariantError("b", 3, fmt.Errorf("unknown"))
+
+		invariantErr := proposals.GetInvariantError("", offsetErr)
+		require.Equal(t, "b", invariantErr.Lane)
+		require.Equal(t, -1, invariantErr.TxIndex)
+		require.Equal(t, proposals.InvariantUnknown, invariantErr.Invariant)
+	})
+}
@@ -39,6 +39,9 @@ type ProposalInfo struct {
 	BlockSize int64 `protobuf:"varint,4,opt,name=block_size,json=blockSize,proto3" json:"block_size,omitempty"`
 	// GasLimit corresponds to the gas limit of this block proposal.
 	GasLimit uint64 `protobuf:"varint,5,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	// MempoolDepthByLane contains the number of transactions in the mempool of
+	// each lane when this block proposal was built, as reported by the proposer.
+	MempoolDepthByLane map[string]uint64 `protobuf:"bytes,6,rep,name=mempool_depth_by_lane,json=mempoolDepthByLane,proto3" json:"mempool_depth_by_lane,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
 }
 
 func (m *ProposalInfo) Reset()         { *m = ProposalInfo{} }
@@ -109,36 +112,46 @@ func (m *ProposalInfo) GetGasLimit() uint64 {
 	return 0
 }
 
+func (m *ProposalInfo) GetMempoolDepthByLane() map[string]uint64 {
+	if m != nil {
+		return m.MempoolDepthByLane
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ProposalInfo)(nil), "sdk.proposals.v1.ProposalInfo")
+	proto.RegisterMapType((map[string]uint64)(nil), "sdk.proposals.v1.ProposalInfo.MempoolDepthByLaneEntry")
 	proto.RegisterMapType((map[string]uint64)(nil), "sdk.proposals.v1.ProposalInfo.TxsByLaneEntry")
 }
 
 func init() { proto.RegisterFile("sdk/proposals/v1/types.proto", fileDescriptor_b5d6b8540ee6bc1e) }
 
 var fileDescriptor_b5d6b8540ee6bc1e = []byte{
-	// 325 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x91, 0xcf, 0x4b, 0xc3, 0x30,
-	0x1c, 0xc5, 0x97, 0x75, 0x13, 0x9b, 0xcd, 0x31, 0x82, 0x87, 0xe2, 0x8f, 0x52, 0x86, 0x87, 0x5e,
-	0x96, 0x32, 0x77, 0x11, 0xf1, 0x34, 0x10, 0x11, 0x36, 0x90, 0xea, 0xc9, 0x4b, 0x49, 0xb7, 0x38,
-	0x43, 0x9b, 0xa6, 0x2c, 0x59, 0x69, 0xf7, 0x57, 0xf8, 0x2f, 0xf8, 0xdf, 0x78, 0xdc, 0xd1, 0xa3,
-	0x6c, 0xff, 0x88, 0x34, 0x9b, 0x73, 0x7a, 0xfb, 0xbe, 0x97, 0x7c, 0x1e, 0x0f, 0x1e, 0x3c, 0x93,
-	0x93, 0xc8, 0x4b, 0x67, 0x22, 0x15, 0x92, 0xc4, 0xd2, 0xcb, 0x7a, 0x9e, 0x2a, 0x52, 0x2a, 0x71,
-	0x3a, 0x13, 0x4a, 0xa0, 0xb6, 0x9c, 0x44, 0x78, 0xf7, 0x8a, 0xb3, 0x5e, 0xe7, 0xbd, 0x0a, 0x9b,
-	0x0f, 0x5b, 0xe3, 0x3e, 0x79, 0x11, 0x68, 0x04, 0x1b, 0x2a, 0x97, 0x41, 0x58, 0x04, 0x31, 0x49,
-	0xa8, 0x05, 0x1c, 0xc3, 0x6d, 0x5c, 0x76, 0xf1, 0x7f, 0x10, 0xef, 0x43, 0xf8, 0x29, 0x97, 0x83,
-	0x62, 0x48, 0x12, 0x7a, 0x9b, 0xa8, 0x59, 0xe1, 0x9b, 0xea, 0x47, 0xa3, 0x0b, 0xd8, 0xe2, 0x24,
-	0x0f, 0xc2, 0x58, 0x8c, 0xa3, 0x40, 0xb2, 0x05, 0xb5, 0xaa, 0x0e, 0x70, 0x0d, 0xbf, 0xc9, 0x49,
-	0x3e, 0x28, 0xcd, 0x47, 0xb6, 0xa0, 0xa8, 0x03, 0x8f, 0xca, 0x5f, 0x53, 0x22, 0x83, 0x98, 0x71,
-	0xa6, 0x2c, 0xc3, 0x01, 0x6e, 0xcd, 0x6f, 0x70, 0x92, 0xdf, 0x11, 0x39, 0x2c, 0x2d, 0x74, 0x0e,
-	0xe1, 0x5e, 0x4a, 0x4d, 0xa7, 0x98, 0xe1, 0x2e, 0xe2, 0x14, 0x9a, 0xbf, 0x78, 0x5d, 0xe3, 0x87,
-	0xd3, 0x2d, 0x7b, 0x72, 0x03, 0x5b, 0x7f, 0x2b, 0xa2, 0x36, 0x34, 0x22, 0x5a, 0x58, 0xc0, 0x01,
-	0xae, 0xe9, 0x97, 0x27, 0x3a, 0x86, 0xf5, 0x8c, 0xc4, 0xf3, 0x4d, 0xc1, 0x9a, 0xbf, 0x11, 0xd7,
-	0xd5, 0x2b, 0x30, 0x18, 0x7d, 0xac, 0x6c, 0xb0, 0x5c, 0xd9, 0xe0, 0x6b, 0x65, 0x83, 0xb7, 0xb5,
-	0x5d, 0x59, 0xae, 0xed, 0xca, 0xe7, 0xda, 0xae, 0x3c, 0xf7, 0xa7, 0x4c, 0xbd, 0xce, 0x43, 0x3c,
-	0x16, 0xdc, 0x93, 0x11, 0x4b, 0xbb, 0x9c, 0x66, 0x9e, 0xee, 0xd4, 0x2d, 0x77, 0xd0, 0xd7, 0xde,
-	0x1a, 0x7a, 0x8a, 0xf0, 0x40, 0x6f, 0xd1, 0xff, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x7a, 0xfb, 0x40,
-	0x73, 0xab, 0x01, 0x00, 0x00,
+	// 367 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xcf, 0x4a, 0xeb, 0x40,
+	0x18, 0xc5, 0x3b, 0x4d, 0x5b, 0x6e, 0xa6, 0xbd, 0xa5, 0x0c, 0xf7, 0x62, 0xa8, 0x1a, 0x42, 0x71,
+	0x91, 0x4d, 0x13, 0x6a, 0x41, 0x44, 0x5c, 0x15, 0x8b, 0x08, 0x2d, 0x48, 0x74, 0xe5, 0x26, 0x4c,
+	0xda, 0xb1, 0x0d, 0xc9, 0x64, 0x42, 0x67, 0x1a, 0x92, 0x3e, 0x85, 0x8f, 0xe5, 0xb2, 0xe0, 0xc6,
+	0xa5, 0xb4, 0x2f, 0x22, 0x49, 0x6d, 0x8d, 0x8a, 0x88, 0xbb, 0xef, 0xcf, 0xfc, 0xce, 0x70, 0x3e,
+	0x0e, 0x3c, 0xe0, 0x63, 0xcf, 0x0c, 0x67, 0x2c, 0x64, 0x1c, 0xfb, 0xdc, 0x8c, 0x3a, 0xa6, 0x48,
+	0x42, 0xc2, 0x8d, 0x70, 0xc6, 0x04, 0x43, 0x0d, 0x3e, 0xf6, 0x8c, 0xdd, 0xd6, 0x88, 0x3a, 0xad,
+	0x27, 0x09, 0xd6, 0xae, 0xdf, 0x06, 0x57, 0xc1, 0x3d, 0x43, 0x43, 0x58, 0x15, 0x31, 0xb7, 0x9d,
+	0xc4, 0xf6, 0x71, 0x40, 0x14, 0xa0, 0x49, 0x7a, 0xf5, 0xb8, 0x6d, 0x7c, 0x06, 0x8d, 0x3c, 0x64,
+	0xdc, 0xc6, 0xbc, 0x97, 0x0c, 0x70, 0x40, 0xfa, 0x81, 0x98, 0x25, 0x96, 0x2c, 0xb6, 0x3d, 0x3a,
+	0x82, 0x75, 0x8a, 0x63, 0xdb, 0xf1, 0xd9, 0xc8, 0xb3, 0xb9, 0xbb, 0x20, 0x4a, 0x51, 0x03, 0xba,
+	0x64, 0xd5, 0x28, 0x8e, 0x7b, 0xe9, 0xf0, 0xc6, 0x5d, 0x10, 0xd4, 0x82, 0x7f, 0xd3, 0x57, 0x13,
+	0xcc, 0x6d, 0xdf, 0xa5, 0xae, 0x50, 0x24, 0x0d, 0xe8, 0x25, 0xab, 0x4a, 0x71, 0x7c, 0x89, 0xf9,
+	0x20, 0x1d, 0xa1, 0x43, 0x08, 0x73, 0x2a, 0xa5, 0x4c, 0x45, 0x76, 0x76, 0x12, 0xfb, 0x50, 0x7e,
+	0xc7, 0xcb, 0x19, 0xfe, 0x67, 0xb2, 0x65, 0x5d, 0xf8, 0x9f, 0x12, 0x1a, 0x32, 0xe6, 0xdb, 0x63,
+	0x12, 0x8a, 0xe9, 0xce, 0x5e, 0x25, 0xb3, 0x77, 0xf2, 0x83, 0xbd, 0xe1, 0x86, 0xbd, 0x48, 0xd1,
+	0xbc, 0x4f, 0x44, 0xbf, 0x2c, 0x9a, 0xe7, 0xb0, 0xfe, 0xf1, 0x1a, 0xa8, 0x01, 0x25, 0x8f, 0x24,
+	0x0a, 0xd0, 0x80, 0x2e, 0x5b, 0x69, 0x89, 0xfe, 0xc1, 0x72, 0x84, 0xfd, 0xf9, 0xe6, 0x16, 0x25,
+	0x6b, 0xd3, 0x9c, 0x15, 0x4f, 0x41, 0xb3, 0x0f, 0xf7, 0xbe, 0xf9, 0xec, 0x37, 0x32, 0xbd, 0xe1,
+	0xe3, 0x4a, 0x05, 0xcb, 0x95, 0x0a, 0x5e, 0x56, 0x2a, 0x78, 0x58, 0xab, 0x85, 0xe5, 0x5a, 0x2d,
+	0x3c, 0xaf, 0xd5, 0xc2, 0x5d, 0x77, 0xe2, 0x8a, 0xe9, 0xdc, 0x31, 0x46, 0x8c, 0x9a, 0xdc, 0x73,
+	0xc3, 0x36, 0x25, 0x91, 0x99, 0x5d, 0xb1, 0x9d, 0x26, 0x27, 0xab, 0x72, 0xf9, 0xc9, 0xc2, 0xe3,
+	0x54, 0xb2, 0xf4, 0x74, 0x5f, 0x07, 0x00, 0xb3, 0x23, 0x95, 0xc4, 0x5d, 0x02, 0x00, 0x00,
 }
 
 func (m *ProposalInfo) Marshal() (dAtA []byte, err error) {
@@ -161,6 +174,23 @@ func (m *ProposalInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MempoolDepthByLane) > 0 {
+		for k := range m.MempoolDepthByLane {
+			v := m.MempoolDepthByLane[k]
+			baseI := i
+			i = encodeVarintTypes(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintTypes(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintTypes(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if m.GasLimit != 0 {
 		i = encodeVarintTypes(dAtA, i, uint64(m.GasLimit))
 		i--
@@ -238,6 +268,14 @@ func (m *ProposalInfo) Size() (n int) {
 	if m.GasLimit != 0 {
 		n += 1 + sovTypes(uint64(m.GasLimit))
 	}
+	if len(m.MempoolDepthByLane) > 0 {
+		for k, v := range m.MempoolDepthByLane {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovTypes(uint64(len(k))) + 1 + sovTypes(uint64(v))
+			n += mapEntrySize + 1 + sovTypes(uint64(mapEntrySize))
+		}
+	}
 	return n
 }
 
@@ -465,6 +503,119 @@ func (m *ProposalInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MempoolDepthByLane", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MempoolDepthByLane == nil {
+				m.MempoolDepthByLane = make(map[string]uint64)
+			}
+			var mapkey string
+			var mapvalue uint64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTypes
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthTypes
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthTypes
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTypes
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTypes(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTypes
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.MempoolDepthByLane[mapkey] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -3,15 +3,13 @@ package proposals
 import (
 	"fmt"
 
-	"cosmossdk.io/math"
-
 	"github.com/skip-mev/block-sdk/v2/block/utils"
 )
 
 // Lane defines the contract interface for a lane.
 type Lane interface {
 	Name() string
-	GetMaxBlockSpace() math.LegacyDec
+	GetBlockSpace() BlockSpace
 }
 
 // UpdateProposal updates the proposal with the given transactions and lane limits. There are a
@@ -22,8 +20,10 @@ type Lane interface {
 //  3. The total gas limit of the proposal must be less than the maximum gas limit allowed.
 //  4. The total gas limit of the partial proposal must be less than the maximum gas limit allowed for
 //     the lane.
-//  5. The lane must not have already prepared a partial proposal.
-//  6. The transaction must not already be in the proposal.
+//  5. The number of transactions in the partial proposal must be less than the maximum number
+//     of transactions allowed for the lane (if any).
+//  6. The lane must not have already prepared a partial proposal.
+//  7. The transaction must not already be in the proposal.
 func (p *Proposal) UpdateProposal(lane Lane, partialProposal []utils.TxWithInfo) error {
 	if len(partialProposal) == 0 {
 		return nil
@@ -31,7 +31,12 @@ func (p *Proposal) UpdateProposal(lane Lane, partialProposal []utils.TxWithInfo)
 
 	// invariant check: Ensure we have not already prepared a partial proposal for this lane.
 	if _, ok := p.Info.TxsByLane[lane.Name()]; ok {
-		return fmt.Errorf("lane %s already prepared a partial proposal", lane)
+		return NewInvariantError(
+			lane.Name(),
+			-1,
+			InvariantMatch,
+			fmt.Errorf("lane %s already prepared a partial proposal", lane),
+		)
 	}
 
 	// Aggregate info from the transactions.
@@ -54,7 +59,12 @@ func (p *Proposal) UpdateProposal(lane Lane, partialProposal []utils.TxWithInfo)
 
 		// invariant check: Ensure that the transaction is not already in the proposal.
 		if _, ok := p.Cache[tx.Hash]; ok {
-			return fmt.Errorf("transaction %s is already in the proposal", tx.Hash)
+			return NewInvariantError(
+				lane.Name(),
+				index,
+				InvariantDuplicateTx,
+				fmt.Errorf("transaction %s is already in the proposal", tx.Hash),
+			)
 		}
 
 		hashes[tx.Hash] = struct{}{}
@@ -64,41 +74,75 @@ func (p *Proposal) UpdateProposal(lane Lane, partialProposal []utils.TxWithInfo)
 	}
 
 	// invariant check: Ensure that the partial proposal is not too large.
-	limit := p.GetLaneLimits(lane.GetMaxBlockSpace())
+	limit := p.GetLaneLimitsForLane(lane)
 	if partialProposalSize > limit.MaxTxBytes {
-		return fmt.Errorf(
-			"partial proposal is too large: %d > %d",
-			partialProposalSize,
-			limit.MaxTxBytes,
+		return NewInvariantError(
+			lane.Name(),
+			-1,
+			InvariantLaneLimit,
+			fmt.Errorf(
+				"partial proposal is too large: %d > %d",
+				partialProposalSize,
+				limit.MaxTxBytes,
+			),
 		)
 	}
 
 	// invariant check: Ensure that the partial proposal does not consume too much gas.
 	if partialProposalGasLimit > limit.MaxGasLimit {
-		return fmt.Errorf(
-			"partial proposal consumes too much gas: %d > %d",
-			partialProposalGasLimit,
-			limit.MaxGasLimit,
+		return NewInvariantError(
+			lane.Name(),
+			-1,
+			InvariantLaneLimit,
+			fmt.Errorf(
+				"partial proposal consumes too much gas: %d > %d",
+				partialProposalGasLimit,
+				limit.MaxGasLimit,
+			),
+		)
+	}
+
+	// invariant check: Ensure that the partial proposal does not include too many transactions.
+	if limit.MaxTxs > 0 && uint64(len(partialProposal)) > limit.MaxTxs {
+		return NewInvariantError(
+			lane.Name(),
+			-1,
+			InvariantLaneLimit,
+			fmt.Errorf(
+				"partial proposal includes too many transactions: %d > %d",
+				len(partialProposal),
+				limit.MaxTxs,
+			),
 		)
 	}
 
 	// invariant check: Ensure that the lane did not prepare a block proposal that is too large.
 	updatedSize := p.Info.BlockSize + partialProposalSize
 	if updatedSize > p.Info.MaxBlockSize {
-		return fmt.Errorf(
-			"block proposal is too large: %d > %d",
-			updatedSize,
-			p.Info.MaxBlockSize,
+		return NewInvariantError(
+			lane.Name(),
+			-1,
+			InvariantBlockLimit,
+			fmt.Errorf(
+				"block proposal is too large: %d > %d",
+				updatedSize,
+				p.Info.MaxBlockSize,
+			),
 		)
 	}
 
 	// invariant check: Ensure that the lane did not prepare a block proposal that consumes too much gas.
 	updatedGasLimit := p.Info.GasLimit + partialProposalGasLimit
 	if updatedGasLimit > p.Info.MaxGasLimit {
-		return fmt.Errorf(
-			"block proposal consumes too much gas: %d > %d",
-			updatedGasLimit,
-			p.Info.MaxGasLimit,
+		return NewInvariantError(
+			lane.Name(),
+			-1,
+			InvariantBlockLimit,
+			fmt.Errorf(
+				"block proposal consumes too much gas: %d > %d",
+				updatedGasLimit,
+				p.Info.MaxGasLimit,
+			),
 		)
 	}
 
@@ -1,6 +1,7 @@
 package proposals
 
 import (
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -18,9 +19,48 @@ type (
 		MaxTxBytes int64
 		// MaxGasLimit is the maximum gas limit allowed in the partial proposal.
 		MaxGasLimit uint64
+		// MaxTxs is the maximum number of transactions allowed in the partial proposal.
+		// If set to zero, there is no limit on the number of transactions.
+		MaxTxs uint64
+	}
+
+	// BlockSpace defines the budgets a lane is allowed to consume in a block. The byte
+	// and gas budgets are relative percentages of the block's max bytes and max gas limit
+	// respectively. NOTE: If a ratio is set to zero, then the lane may consume whatever
+	// is remaining of that resource in the block. This is useful for the default lane.
+	BlockSpace struct {
+		// MaxTxBytes is the relative percentage of the block's max bytes that can be
+		// used by the lane.
+		MaxTxBytes math.LegacyDec
+		// MaxGasLimit is the relative percentage of the block's max gas limit that can
+		// be used by the lane.
+		MaxGasLimit math.LegacyDec
+		// MaxTxs is the maximum number of transactions the lane can include in a block.
+		// If set to zero, there is no limit on the number of transactions.
+		MaxTxs uint64
+		// MinTxBytes is the relative percentage of the block's max bytes that is reserved
+		// for the lane. Lanes that come before this lane cannot consume this space. If
+		// unset, nothing is reserved.
+		MinTxBytes math.LegacyDec
+		// MinGasLimit is the relative percentage of the block's max gas limit that is
+		// reserved for the lane. If unset, nothing is reserved.
+		MinGasLimit math.LegacyDec
+		// Weight is the relative weight the lane has when capacity left unused by previous
+		// lanes is redistributed. If set to zero, the lane does not receive any of the
+		// unused capacity.
+		Weight uint64
 	}
 )
 
+// NewBlockSpace returns a block space that applies the same ratio to both the byte and
+// gas budgets of a lane with no limit on the number of transactions.
+func NewBlockSpace(ratio math.LegacyDec) BlockSpace {
+	return BlockSpace{
+		MaxTxBytes:  ratio,
+		MaxGasLimit: ratio,
+	}
+}
+
 // GetBlockLimits retrieves the maximum number of bytes and gas limit allowed in a block.
 func GetBlockLimits(ctx sdk.Context) (int64, uint64) {
 	blockParams := ctx.ConsensusParams().Block
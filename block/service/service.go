@@ -2,9 +2,14 @@ package service
 
 import (
 	"context"
+	"errors"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
 	gogogrpc "github.com/cosmos/gogoproto/grpc"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/skip-mev/block-sdk/v2/block"
 	"github.com/skip-mev/block-sdk/v2/block/service/types"
@@ -19,12 +24,16 @@ type QueryService struct {
 
 	// mempool is the mempool instance to query.
 	mempool block.Mempool
+
+	// txEncoder is used to encode the transactions returned by the service.
+	txEncoder sdk.TxEncoder
 }
 
 // NewQueryService creates a new QueryService instance.
-func NewQueryService(mempool block.Mempool) *QueryService {
+func NewQueryService(mempool block.Mempool, txEncoder sdk.TxEncoder) *QueryService {
 	return &QueryService{
-		mempool: mempool,
+		mempool:   mempool,
+		txEncoder: txEncoder,
 	}
 }
 
@@ -38,13 +47,40 @@ func (s *QueryService) GetTxDistribution(
 	return &types.GetTxDistributionResponse{Distribution: distribution}, nil
 }
 
-// RegisterMempoolService registers the Block SDK mempool queries on the gRPC server.
+// GetTx returns the transaction in the mempool with the given hash, i.e. the hex-encoded
+// hash of the transaction's bytes as used by CometBFT. The transaction is looked up in the
+// hash index of the mempool.
+func (s *QueryService) GetTx(
+	_ context.Context,
+	req *types.GetTxRequest,
+) (*types.GetTxResponse, error) {
+	if req == nil || req.Hash == "" {
+		return nil, status.Error(codes.InvalidArgument, "tx hash cannot be empty")
+	}
+
+	lane, tx, err := s.mempool.LookupTx(req.Hash)
+	if errors.Is(err, sdkmempool.ErrTxNotFound) {
+		return nil, status.Errorf(codes.NotFound, "tx %s not found in mempool", req.Hash)
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	txBz, err := s.txEncoder(tx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to encode tx: %s", err)
+	}
 
+	return &types.GetTxResponse{Lane: lane.Name(), Tx: txBz}, nil
+}
+
+// RegisterMempoolService registers the Block SDK mempool queries on the gRPC server.
 func RegisterMempoolService(
 	server gogogrpc.Server,
 	mempool block.Mempool,
+	txEncoder sdk.TxEncoder,
 ) {
-	types.RegisterServiceServer(server, NewQueryService(mempool))
+	types.RegisterServiceServer(server, NewQueryService(mempool, txEncoder))
 }
 
 // RegisterGRPCGatewayRoutes mounts the Block SDK mempool service's GRPC-gateway routes on the
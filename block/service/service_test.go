@@ -9,10 +9,13 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/skip-mev/block-sdk/v2/block"
 	"github.com/skip-mev/block-sdk/v2/block/service"
 	"github.com/skip-mev/block-sdk/v2/block/service/types"
+	"github.com/skip-mev/block-sdk/v2/block/utils"
 	"github.com/skip-mev/block-sdk/v2/lanes/base"
 	"github.com/skip-mev/block-sdk/v2/lanes/free"
 	"github.com/skip-mev/block-sdk/v2/lanes/mev"
@@ -183,7 +186,7 @@ func TestGetTxDistribution(t *testing.T) {
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			mempool := tc.mempool()
-			queryService := service.NewQueryService(mempool)
+			queryService := service.NewQueryService(mempool, config.TxConfig.TxEncoder())
 			ctx := context.Background()
 
 			distributionResponse, err := queryService.GetTxDistribution(ctx, &types.GetTxDistributionRequest{})
@@ -192,3 +195,66 @@ func TestGetTxDistribution(t *testing.T) {
 		})
 	}
 }
+
+func TestGetTx(t *testing.T) {
+	config := testutils.CreateTestEncodingConfig()
+	accounts := testutils.RandomAccounts(rand.New(rand.NewSource(1)), 2)
+	ctx := testutils.CreateBaseSDKContext(t)
+
+	baseTx, err := testutils.CreateRandomTx(
+		config.TxConfig,
+		accounts[0],
+		0,
+		1,
+		0,
+		0,
+		sdk.NewCoin("skip", math.NewInt(1)),
+	)
+	require.NoError(t, err)
+
+	freeTx, err := testutils.CreateFreeTx(
+		config.TxConfig,
+		accounts[1],
+		0,
+		1,
+		"skip",
+		sdk.NewCoin("skip", math.NewInt(1)),
+	)
+	require.NoError(t, err)
+
+	mempool := mempool.CreateMempool()
+	require.NoError(t, mempool.Insert(ctx, baseTx))
+	require.NoError(t, mempool.Insert(ctx, freeTx))
+
+	baseTxBz, err := config.TxConfig.TxEncoder()(baseTx)
+	require.NoError(t, err)
+
+	freeTxBz, err := config.TxConfig.TxEncoder()(freeTx)
+	require.NoError(t, err)
+
+	queryService := service.NewQueryService(mempool, config.TxConfig.TxEncoder())
+
+	t.Run("returns the tx and its lane", func(t *testing.T) {
+		resp, err := queryService.GetTx(context.Background(), &types.GetTxRequest{Hash: utils.TxHash(baseTxBz)})
+		require.NoError(t, err)
+		require.Equal(t, base.LaneName, resp.Lane)
+		require.Equal(t, baseTxBz, resp.Tx)
+
+		resp, err = queryService.GetTx(context.Background(), &types.GetTxRequest{Hash: utils.TxHash(freeTxBz)})
+		require.NoError(t, err)
+		require.Equal(t, free.LaneName, resp.Lane)
+		require.Equal(t, freeTxBz, resp.Tx)
+	})
+
+	t.Run("returns not found once the tx is removed", func(t *testing.T) {
+		require.NoError(t, mempool.Remove(baseTx))
+
+		_, err := queryService.GetTx(context.Background(), &types.GetTxRequest{Hash: utils.TxHash(baseTxBz)})
+		require.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("rejects an empty hash", func(t *testing.T) {
+		_, err := queryService.GetTx(context.Background(), &types.GetTxRequest{})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
@@ -0,0 +1,176 @@
+package snapshot
+
+import (
+	"fmt"
+
+	"cosmossdk.io/log"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/block-sdk/v2/block"
+)
+
+// Snapshotter persists the transactions of the laned mempool such that they are not lost when
+// the node restarts. A snapshot contains the transactions of every lane, in the order in which
+// the lanes are registered and in each lane's own priority order.
+//
+// Snapshots are taken periodically, every Interval blocks, once the block has been committed
+// (see PrepareCheckStater). Since ABCI calls are serialized, snapshots never run concurrently
+// with CheckTx or proposal construction. Applications should also take a snapshot when they
+// shut down, e.g. by calling Snapshot in the application's Close method.
+//
+// NOTE: Restored transactions are only known to the application-side mempool. They are not
+// re-added to the CometBFT mempool and are therefore not re-gossiped, but they are included
+// in the proposals built by the node.
+type Snapshotter struct {
+	logger    log.Logger
+	txDecoder sdk.TxDecoder
+	mempool   block.Mempool
+	store     Store
+	interval  uint64
+}
+
+// NewSnapshotter returns a new Snapshotter that saves snapshots of the given mempool to the
+// given store every interval blocks. If the interval is zero, snapshots are only taken when
+// Snapshot is called.
+func NewSnapshotter(
+	logger log.Logger,
+	txDecoder sdk.TxDecoder,
+	mempool block.Mempool,
+	store Store,
+	interval uint64,
+) (*Snapshotter, error) {
+	if mempool == nil {
+		return nil, fmt.Errorf("mempool cannot be nil")
+	}
+
+	if store == nil {
+		return nil, fmt.Errorf("store cannot be nil")
+	}
+
+	return &Snapshotter{
+		logger:    logger,
+		txDecoder: txDecoder,
+		mempool:   mempool,
+		store:     store,
+		interval:  interval,
+	}, nil
+}
+
+// PrepareCheckStater returns a PrepareCheckStater that calls the given PrepareCheckStater (if
+// any) and then saves a snapshot of the mempool if the height of the committed block is a
+// multiple of the interval. Failing to save a snapshot is logged but does not halt the node.
+func (s *Snapshotter) PrepareCheckStater(next sdk.PrepareCheckStater) sdk.PrepareCheckStater {
+	return func(ctx sdk.Context) {
+		if next != nil {
+			next(ctx)
+		}
+
+		if s.interval == 0 || ctx.BlockHeight()%int64(s.interval) != 0 {
+			return
+		}
+
+		if err := s.Snapshot(ctx); err != nil {
+			s.logger.Error("failed to save mempool snapshot", "height", ctx.BlockHeight(), "err", err)
+		}
+	}
+}
+
+// Snapshot saves a snapshot of all of the transactions currently in the mempool, replacing the
+// previous snapshot.
+func (s *Snapshotter) Snapshot(ctx sdk.Context) error {
+	snapshot := s.mempool.Snapshot(ctx)
+
+	txs := make([][]byte, 0, snapshot.CountTx())
+	for _, lane := range snapshot.Lanes {
+		for _, tx := range lane.Txs {
+			txInfo, err := lane.Lane.GetTxInfo(ctx, tx)
+			if err != nil {
+				s.logger.Info("failed to encode tx for mempool snapshot", "lane", lane.Lane.Name(), "err", err)
+				continue
+			}
+
+			txs = append(txs, txInfo.TxBytes)
+		}
+	}
+
+	if err := s.store.Save(txs); err != nil {
+		return fmt.Errorf("failed to save mempool snapshot: %w", err)
+	}
+
+	s.logger.Info("saved mempool snapshot", "num_txs", len(txs), "height", ctx.BlockHeight())
+
+	return nil
+}
+
+// Restore re-inserts the transactions of the latest snapshot (if any) into the mempool and
+// returns the number of transactions that were restored. Each transaction is inserted into
+// the first lane that it matches, after being verified by the lane (e.g. with the ante
+// handler). Transactions that can no longer be decoded, that do not match any lane or that
+// fail verification are dropped.
+//
+// The context should be a context of the check state (e.g. app.NewContext(true)) since
+// transactions are verified as they would be by CheckTx. Transactions are verified in the
+// order of the snapshot on a branch of the state, such that the transactions of a sender
+// are verified in sequence. The branch is discarded once the snapshot is restored.
+func (s *Snapshotter) Restore(ctx sdk.Context) (int, error) {
+	txs, err := s.store.Load()
+	if err != nil {
+		return 0, fmt.Errorf("failed to load mempool snapshot: %w", err)
+	}
+
+	cacheCtx, _ := ctx.CacheContext()
+
+	var restored int
+	for _, txBz := range txs {
+		tx, err := s.txDecoder(txBz)
+		if err != nil {
+			s.logger.Info("dropping tx from mempool snapshot; failed to decode tx", "err", err)
+			continue
+		}
+
+		lane, found := s.matchLane(cacheCtx, tx)
+		if !found {
+			s.logger.Info("dropping tx from mempool snapshot; tx does not match any lane")
+			continue
+		}
+
+		// Transactions are only restored if the lane can verify them against the latest state.
+		verifier, ok := lane.(block.TxVerifier)
+		if !ok {
+			s.logger.Info("dropping tx from mempool snapshot; lane cannot verify txs", "lane", lane.Name())
+			continue
+		}
+
+		if err := verifier.VerifyTx(cacheCtx, tx, false); err != nil {
+			s.logger.Info("dropping tx from mempool snapshot; failed to verify tx", "lane", lane.Name(), "err", err)
+			continue
+		}
+
+		if err := s.mempool.Insert(cacheCtx, tx); err != nil {
+			s.logger.Info("dropping tx from mempool snapshot; failed to insert tx", "lane", lane.Name(), "err", err)
+			continue
+		}
+
+		restored++
+	}
+
+	s.logger.Info(
+		"restored mempool snapshot",
+		"num_txs", restored,
+		"num_dropped_txs", len(txs)-restored,
+		"distribution", s.mempool.GetTxDistribution(),
+	)
+
+	return restored, nil
+}
+
+// matchLane returns the first lane in the registry that the transaction matches.
+func (s *Snapshotter) matchLane(ctx sdk.Context, tx sdk.Tx) (block.Lane, bool) {
+	for _, lane := range s.mempool.Registry() {
+		if lane.Match(ctx, tx) {
+			return lane, true
+		}
+	}
+
+	return nil, false
+}
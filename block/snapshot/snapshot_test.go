@@ -0,0 +1,312 @@
+package snapshot_test
+
+import (
+	"fmt"
+	"math/rand"
+	"path/filepath"
+	"testing"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	signeradaptors "github.com/skip-mev/block-sdk/v2/adapters/signer_extraction_adapter"
+	"github.com/skip-mev/block-sdk/v2/block"
+	"github.com/skip-mev/block-sdk/v2/block/base"
+	"github.com/skip-mev/block-sdk/v2/block/snapshot"
+	defaultlane "github.com/skip-mev/block-sdk/v2/lanes/base"
+	testutils "github.com/skip-mev/block-sdk/v2/testutils"
+)
+
+type SnapshotTestSuite struct {
+	suite.Suite
+
+	ctx            sdk.Context
+	encodingConfig testutils.EncodingConfig
+	accounts       []testutils.Account
+	gasTokenDenom  string
+
+	// invalidTxs contains the transactions that fail the ante handler.
+	invalidTxs map[string]bool
+}
+
+func TestSnapshotTestSuite(t *testing.T) {
+	suite.Run(t, new(SnapshotTestSuite))
+}
+
+func (s *SnapshotTestSuite) SetupTest() {
+	s.encodingConfig = testutils.CreateTestEncodingConfig()
+	s.accounts = testutils.RandomAccounts(rand.New(rand.NewSource(1)), 3)
+	s.gasTokenDenom = "stake"
+	s.invalidTxs = make(map[string]bool)
+
+	key := storetypes.NewKVStoreKey("test")
+	testCtx := testutil.DefaultContextWithDB(s.T(), key, storetypes.NewTransientStoreKey("transient_test"))
+	s.ctx = testCtx.Ctx.WithIsCheckTx(true).WithBlockHeight(10)
+}
+
+// setUpMempool returns a new mempool with a lane that only matches transactions signed by the
+// first account and a default lane.
+func (s *SnapshotTestSuite) setUpMempool() *block.LanedMempool {
+	cfg := base.LaneConfig{
+		Logger:          log.NewNopLogger(),
+		TxEncoder:       s.encodingConfig.TxConfig.TxEncoder(),
+		TxDecoder:       s.encodingConfig.TxConfig.TxDecoder(),
+		AnteHandler:     s.anteHandler,
+		MaxBlockSpace:   math.LegacyMustNewDecFromStr("0.5"),
+		SignerExtractor: signeradaptors.NewDefaultAdapter(),
+	}
+	mh := func(_ sdk.Context, tx sdk.Tx) bool {
+		signers, err := cfg.SignerExtractor.GetSigners(tx)
+		return err == nil && len(signers) > 0 && signers[0].Signer.Equals(s.accounts[0].Address)
+	}
+
+	lane, err := base.NewBaseLane(
+		cfg,
+		"first",
+		base.WithMatchHandler(mh),
+		base.WithMempoolConfigs(cfg, base.DefaultTxPriority()),
+	)
+	s.Require().NoError(err)
+
+	defaultCfg := cfg
+	defaultCfg.MaxBlockSpace = math.LegacyZeroDec()
+	defaultLane := defaultlane.NewDefaultLane(defaultCfg, base.DefaultMatchHandler())
+
+	mempool, err := block.NewLanedMempool(log.NewNopLogger(), []block.Lane{lane, defaultLane})
+	s.Require().NoError(err)
+
+	return mempool
+}
+
+func (s *SnapshotTestSuite) anteHandler(ctx sdk.Context, tx sdk.Tx, _ bool) (sdk.Context, error) {
+	bz, err := s.encodingConfig.TxConfig.TxEncoder()(tx)
+	s.Require().NoError(err)
+
+	if s.invalidTxs[string(bz)] {
+		return ctx, fmt.Errorf("tx failed")
+	}
+
+	return ctx, nil
+}
+
+func (s *SnapshotTestSuite) createTx(account testutils.Account, nonce uint64, fee int64) sdk.Tx {
+	tx, err := testutils.CreateRandomTx(
+		s.encodingConfig.TxConfig,
+		account,
+		nonce,
+		1,
+		0,
+		1,
+		sdk.NewCoin(s.gasTokenDenom, math.NewInt(fee)),
+	)
+	s.Require().NoError(err)
+
+	return tx
+}
+
+func (s *SnapshotTestSuite) getTxBytes(txs ...sdk.Tx) [][]byte {
+	txBzs := make([][]byte, len(txs))
+	for i, tx := range txs {
+		bz, err := s.encodingConfig.TxConfig.TxEncoder()(tx)
+		s.Require().NoError(err)
+
+		txBzs[i] = bz
+	}
+
+	return txBzs
+}
+
+func (s *SnapshotTestSuite) TestStores() {
+	fileStore := snapshot.NewFileStore(filepath.Join(s.T().TempDir(), "data", "mempool.snapshot"))
+	dbStore := snapshot.NewDBStore(dbm.NewMemDB())
+
+	for name, store := range map[string]snapshot.Store{"file": fileStore, "db": dbStore} {
+		s.Run(fmt.Sprintf("%s store returns nil if there is no snapshot", name), func() {
+			txs, err := store.Load()
+			s.Require().NoError(err)
+			s.Require().Nil(txs)
+		})
+
+		s.Run(fmt.Sprintf("%s store returns the latest snapshot", name), func() {
+			s.Require().NoError(store.Save([][]byte{{0x01}, {0x02, 0x03}}))
+			s.Require().NoError(store.Save([][]byte{{0x04}, {}, {0x05, 0x06, 0x07}}))
+
+			txs, err := store.Load()
+			s.Require().NoError(err)
+			s.Require().Equal([][]byte{{0x04}, {}, {0x05, 0x06, 0x07}}, txs)
+		})
+
+		s.Run(fmt.Sprintf("%s store can save an empty snapshot", name), func() {
+			s.Require().NoError(store.Save(nil))
+
+			txs, err := store.Load()
+			s.Require().NoError(err)
+			s.Require().Empty(txs)
+		})
+	}
+
+	s.Run("can open a db store in a directory", func() {
+		dir := s.T().TempDir()
+
+		store, err := snapshot.OpenDBStore(dir, dbm.GoLevelDBBackend)
+		s.Require().NoError(err)
+		s.Require().NoError(store.Save([][]byte{{0x01}}))
+		s.Require().NoError(store.Close())
+
+		store, err = snapshot.OpenDBStore(dir, dbm.GoLevelDBBackend)
+		s.Require().NoError(err)
+		defer store.Close()
+
+		txs, err := store.Load()
+		s.Require().NoError(err)
+		s.Require().Equal([][]byte{{0x01}}, txs)
+	})
+
+	s.Run("returns an error if the snapshot is corrupted", func() {
+		db := dbm.NewMemDB()
+		s.Require().NoError(db.Set([]byte("snapshot"), []byte{0x01, 0x02, 0x01}))
+
+		_, err := snapshot.NewDBStore(db).Load()
+		s.Require().Error(err)
+	})
+}
+
+func (s *SnapshotTestSuite) TestSnapshotAndRestore() {
+	// The txs of the first account belong to the first lane, the others to the default lane.
+	tx1 := s.createTx(s.accounts[0], 0, 1)
+	tx2 := s.createTx(s.accounts[0], 1, 1)
+	tx3 := s.createTx(s.accounts[1], 0, 2)
+	tx4 := s.createTx(s.accounts[2], 0, 1)
+
+	mempool := s.setUpMempool()
+	for _, tx := range []sdk.Tx{tx1, tx2, tx3, tx4} {
+		s.Require().NoError(mempool.Insert(s.ctx, tx))
+	}
+
+	store := snapshot.NewDBStore(dbm.NewMemDB())
+	snapshotter, err := snapshot.NewSnapshotter(
+		log.NewNopLogger(),
+		s.encodingConfig.TxConfig.TxDecoder(),
+		mempool,
+		store,
+		0,
+	)
+	s.Require().NoError(err)
+	s.Require().NoError(snapshotter.Snapshot(s.ctx))
+
+	s.Run("snapshot contains the txs of each lane in order", func() {
+		txs, err := store.Load()
+		s.Require().NoError(err)
+		s.Require().Equal(s.getTxBytes(tx1, tx2, tx3, tx4), txs)
+	})
+
+	s.Run("restores the txs into a new mempool", func() {
+		restoredMempool := s.setUpMempool()
+		snapshotter, err := snapshot.NewSnapshotter(
+			log.NewNopLogger(),
+			s.encodingConfig.TxConfig.TxDecoder(),
+			restoredMempool,
+			store,
+			0,
+		)
+		s.Require().NoError(err)
+
+		restored, err := snapshotter.Restore(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(4, restored)
+		s.Require().Equal(map[string]uint64{"first": 2, defaultlane.LaneName: 2}, restoredMempool.GetTxDistribution())
+	})
+
+	s.Run("drops txs that fail verification", func() {
+		s.invalidTxs[string(s.getTxBytes(tx3)[0])] = true
+		defer delete(s.invalidTxs, string(s.getTxBytes(tx3)[0]))
+
+		restoredMempool := s.setUpMempool()
+		snapshotter, err := snapshot.NewSnapshotter(
+			log.NewNopLogger(),
+			s.encodingConfig.TxConfig.TxDecoder(),
+			restoredMempool,
+			store,
+			0,
+		)
+		s.Require().NoError(err)
+
+		restored, err := snapshotter.Restore(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(3, restored)
+		s.Require().False(restoredMempool.Contains(tx3))
+		s.Require().True(restoredMempool.Contains(tx4))
+	})
+
+	s.Run("drops txs that cannot be decoded", func() {
+		s.Require().NoError(store.Save([][]byte{{0x01}, s.getTxBytes(tx4)[0]}))
+
+		restoredMempool := s.setUpMempool()
+		snapshotter, err := snapshot.NewSnapshotter(
+			log.NewNopLogger(),
+			s.encodingConfig.TxConfig.TxDecoder(),
+			restoredMempool,
+			store,
+			0,
+		)
+		s.Require().NoError(err)
+
+		restored, err := snapshotter.Restore(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(1, restored)
+		s.Require().True(restoredMempool.Contains(tx4))
+	})
+
+	s.Run("restores nothing if there is no snapshot", func() {
+		snapshotter, err := snapshot.NewSnapshotter(
+			log.NewNopLogger(),
+			s.encodingConfig.TxConfig.TxDecoder(),
+			s.setUpMempool(),
+			snapshot.NewDBStore(dbm.NewMemDB()),
+			0,
+		)
+		s.Require().NoError(err)
+
+		restored, err := snapshotter.Restore(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Zero(restored)
+	})
+}
+
+func (s *SnapshotTestSuite) TestPrepareCheckStater() {
+	tx := s.createTx(s.accounts[1], 0, 1)
+
+	mempool := s.setUpMempool()
+	s.Require().NoError(mempool.Insert(s.ctx, tx))
+
+	store := snapshot.NewDBStore(dbm.NewMemDB())
+	snapshotter, err := snapshot.NewSnapshotter(
+		log.NewNopLogger(),
+		s.encodingConfig.TxConfig.TxDecoder(),
+		mempool,
+		store,
+		5,
+	)
+	s.Require().NoError(err)
+
+	var called int
+	prepareCheckStater := snapshotter.PrepareCheckStater(func(sdk.Context) { called++ })
+
+	// No snapshot is taken at heights that are not a multiple of the interval.
+	prepareCheckStater(s.ctx.WithBlockHeight(4))
+	txs, err := store.Load()
+	s.Require().NoError(err)
+	s.Require().Nil(txs)
+
+	prepareCheckStater(s.ctx.WithBlockHeight(5))
+	txs, err = store.Load()
+	s.Require().NoError(err)
+	s.Require().Equal(s.getTxBytes(tx), txs)
+
+	s.Require().Equal(2, called)
+}
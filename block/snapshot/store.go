@@ -0,0 +1,183 @@
+package snapshot
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	dbm "github.com/cosmos/cosmos-db"
+)
+
+const (
+	// encodingVersion is the version of the encoding of the snapshots.
+	encodingVersion = 1
+
+	// dbName is the name of the database created by OpenDBStore.
+	dbName = "mempool_snapshot"
+)
+
+// dbKey is the key under which snapshots are stored by the DBStore.
+var dbKey = []byte("snapshot")
+
+var (
+	_ Store = (*FileStore)(nil)
+	_ Store = (*DBStore)(nil)
+)
+
+type (
+	// Store defines the interface used to persist snapshots of the mempool.
+	Store interface {
+		// Save persists the given (encoded) transactions, replacing the previous snapshot.
+		Save(txs [][]byte) error
+		// Load returns the (encoded) transactions of the latest snapshot. If no snapshot
+		// has been saved, nil is returned.
+		Load() ([][]byte, error)
+	}
+
+	// FileStore is a Store that persists snapshots to a local file. The file is replaced
+	// atomically, such that a crash while saving a snapshot leaves the previous snapshot
+	// intact.
+	FileStore struct {
+		path string
+	}
+
+	// DBStore is a Store that persists snapshots to a database.
+	DBStore struct {
+		db dbm.DB
+	}
+)
+
+// NewFileStore returns a new FileStore that persists snapshots to the file at the given path.
+func NewFileStore(path string) *FileStore {
+	return &FileStore{path: path}
+}
+
+// Save implements Store.
+func (s *FileStore) Save(txs [][]byte) error {
+	if err := os.MkdirAll(filepath.Dir(s.path), 0o755); err != nil {
+		return fmt.Errorf("failed to create snapshot directory: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to create snapshot file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(encodeTxs(txs)); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write snapshot: %w", err)
+	}
+
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to sync snapshot: %w", err)
+	}
+
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to close snapshot file: %w", err)
+	}
+
+	return os.Rename(tmp.Name(), s.path)
+}
+
+// Load implements Store.
+func (s *FileStore) Load() ([][]byte, error) {
+	bz, err := os.ReadFile(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to read snapshot: %w", err)
+	}
+
+	return decodeTxs(bz)
+}
+
+// NewDBStore returns a new DBStore that persists snapshots to the given database.
+func NewDBStore(db dbm.DB) *DBStore {
+	return &DBStore{db: db}
+}
+
+// OpenDBStore opens (or creates) a database of the given backend in the given directory and
+// returns a DBStore that persists snapshots to it. The database must be closed with Close.
+func OpenDBStore(dir string, backend dbm.BackendType) (*DBStore, error) {
+	db, err := dbm.NewDB(dbName, backend, dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open snapshot database: %w", err)
+	}
+
+	return NewDBStore(db), nil
+}
+
+// Save implements Store.
+func (s *DBStore) Save(txs [][]byte) error {
+	return s.db.SetSync(dbKey, encodeTxs(txs))
+}
+
+// Load implements Store.
+func (s *DBStore) Load() ([][]byte, error) {
+	bz, err := s.db.Get(dbKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read snapshot: %w", err)
+	}
+
+	if bz == nil {
+		return nil, nil
+	}
+
+	return decodeTxs(bz)
+}
+
+// Close closes the underlying database.
+func (s *DBStore) Close() error {
+	return s.db.Close()
+}
+
+// encodeTxs encodes the transactions of a snapshot as the encoding version followed by the
+// number of transactions and each length-prefixed transaction.
+func encodeTxs(txs [][]byte) []byte {
+	bz := []byte{encodingVersion}
+	bz = binary.AppendUvarint(bz, uint64(len(txs)))
+	for _, tx := range txs {
+		bz = binary.AppendUvarint(bz, uint64(len(tx)))
+		bz = append(bz, tx...)
+	}
+
+	return bz
+}
+
+// decodeTxs decodes the transactions of a snapshot encoded with encodeTxs.
+func decodeTxs(bz []byte) ([][]byte, error) {
+	if len(bz) == 0 || bz[0] != encodingVersion {
+		return nil, fmt.Errorf("unsupported snapshot encoding")
+	}
+	bz = bz[1:]
+
+	numTxs, n := binary.Uvarint(bz)
+	if n <= 0 || numTxs > uint64(len(bz)) {
+		return nil, fmt.Errorf("invalid number of transactions in snapshot")
+	}
+	bz = bz[n:]
+
+	txs := make([][]byte, 0, numTxs)
+	for i := uint64(0); i < numTxs; i++ {
+		size, n := binary.Uvarint(bz)
+		if n <= 0 || size > uint64(len(bz)-n) {
+			return nil, fmt.Errorf("invalid size of transaction %d in snapshot", i)
+		}
+		bz = bz[n:]
+
+		txs = append(txs, bz[:size:size])
+		bz = bz[size:]
+	}
+
+	if len(bz) != 0 {
+		return nil, fmt.Errorf("unexpected trailing bytes in snapshot")
+	}
+
+	return txs, nil
+}
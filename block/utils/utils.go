@@ -12,6 +12,10 @@ import (
 	signerextraction "github.com/skip-mev/block-sdk/v2/adapters/signer_extraction_adapter"
 )
 
+// UnorderedSenderPrefix is the prefix of the sender under which an unordered transaction,
+// which does not have a sequence number, is identified, followed by the transaction's hash.
+const UnorderedSenderPrefix = "unordered/"
+
 // TxWithInfo contains the information required for a transaction to be
 // included in a proposal.
 type TxWithInfo struct {
@@ -69,6 +73,39 @@ func TxHash(txBytes []byte) string {
 	return strings.ToUpper(hex.EncodeToString(comettypes.Tx(txBytes).Hash()))
 }
 
+// SenderKey returns the sender and sequence number that identify the transaction with the
+// given signers in a mempool, i.e. its first signer and sequence number or, for unordered
+// transactions, the UnorderedSenderPrefix followed by the transaction's hash. The encoded
+// transaction is only needed for unordered transactions; it is encoded with the given
+// encoder if txBytes is nil.
+func SenderKey(
+	txEncoder sdk.TxEncoder,
+	tx sdk.Tx,
+	signers []signerextraction.SignerData,
+	txBytes []byte,
+) (string, uint64, error) {
+	if len(signers) == 0 {
+		return "", 0, fmt.Errorf("tx must have at least one signer")
+	}
+
+	if !signers[0].Unordered {
+		return signers[0].Signer.String(), signers[0].Sequence, nil
+	}
+
+	if txEncoder == nil {
+		return "", 0, fmt.Errorf("unordered txs are not supported without a tx encoder")
+	}
+
+	if txBytes == nil {
+		var err error
+		if txBytes, err = txEncoder(tx); err != nil {
+			return "", 0, fmt.Errorf("failed to encode unordered tx: %w", err)
+		}
+	}
+
+	return UnorderedSenderPrefix + TxHash(txBytes), 0, nil
+}
+
 // GetDecodedTxs returns the decoded transactions from the given bytes.
 func GetDecodedTxs(txDecoder sdk.TxDecoder, txs [][]byte) ([]sdk.Tx, error) {
 	var decodedTxs []sdk.Tx
@@ -6,14 +6,18 @@ import (
 	"encoding/hex"
 	"fmt"
 	"math/rand"
+	"time"
 
 	"cosmossdk.io/log"
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/huandu/skiplist"
+	"github.com/stretchr/testify/mock"
 
 	signer_extraction "github.com/skip-mev/block-sdk/v2/adapters/signer_extraction_adapter"
 	"github.com/skip-mev/block-sdk/v2/block"
 	"github.com/skip-mev/block-sdk/v2/block/base"
+	metricsmocks "github.com/skip-mev/block-sdk/v2/block/metrics/mocks"
 	"github.com/skip-mev/block-sdk/v2/block/mocks"
 	"github.com/skip-mev/block-sdk/v2/block/proposals"
 	"github.com/skip-mev/block-sdk/v2/block/utils"
@@ -504,7 +508,7 @@ func (s *BaseTestSuite) TestPrepareLane() {
 		mockLane := mocks.NewLane(s.T())
 
 		mockLane.On("Name").Return("test")
-		mockLane.On("GetMaxBlockSpace").Return(math.LegacyOneDec())
+		mockLane.On("GetBlockSpace").Return(proposals.NewBlockSpace(math.LegacyOneDec()))
 
 		txWithInfo, err := lane.GetTxInfo(s.ctx, tx)
 		s.Require().NoError(err)
@@ -561,6 +565,221 @@ func (s *BaseTestSuite) TestPrepareLane() {
 		s.Require().NoError(err)
 		s.Require().Len(finalProposal.Txs, 0)
 	})
+
+	s.Run("should not include more transactions than the max txs configured for the lane", func() {
+		txs := make([]sdk.Tx, 3)
+		expectedExecution := make(map[sdk.Tx]bool)
+		for i := range txs {
+			tx, err := testutils.CreateRandomTx(
+				s.encodingConfig.TxConfig,
+				s.accounts[i],
+				0,
+				1,
+				0,
+				1,
+				sdk.NewCoin(s.gasTokenDenom, math.NewInt(int64(10-i))),
+			)
+			s.Require().NoError(err)
+
+			txs[i] = tx
+			expectedExecution[tx] = true
+		}
+
+		config := base.NewLaneConfig(
+			log.NewNopLogger(),
+			s.encodingConfig.TxConfig.TxEncoder(),
+			s.encodingConfig.TxConfig.TxDecoder(),
+			s.setUpAnteHandler(expectedExecution),
+			signer_extraction.NewDefaultAdapter(),
+			math.LegacyOneDec(),
+		)
+		config.MaxTxsPerBlock = 2
+		lane := defaultlane.NewDefaultLane(config, base.DefaultMatchHandler())
+
+		for _, tx := range txs {
+			s.Require().NoError(lane.Insert(s.ctx, tx))
+		}
+
+		emptyProposal := proposals.NewProposal(
+			log.NewNopLogger(),
+			1000000,
+			1000000,
+		)
+
+		finalProposal, err := lane.PrepareLane(s.ctx, emptyProposal, block.NoOpPrepareLanesHandler())
+		s.Require().NoError(err)
+
+		// Only the two highest priority transactions should be included.
+		txBzs, err := utils.GetEncodedTxs(s.encodingConfig.TxConfig.TxEncoder(), txs[:2])
+		s.Require().NoError(err)
+		s.Require().Equal(txBzs, finalProposal.Txs)
+		s.Require().Equal(uint64(2), finalProposal.Info.TxsByLane[lane.Name()])
+
+		// The remaining transaction should stay in the lane.
+		s.Require().True(lane.Contains(txs[2]))
+	})
+
+	s.Run("should respect separate byte and gas budgets for the lane", func() {
+		txs := make([]sdk.Tx, 2)
+		expectedExecution := make(map[sdk.Tx]bool)
+		for i := range txs {
+			tx, err := testutils.CreateRandomTx(
+				s.encodingConfig.TxConfig,
+				s.accounts[i],
+				0,
+				1,
+				0,
+				10,
+				sdk.NewCoin(s.gasTokenDenom, math.NewInt(int64(10-i))),
+			)
+			s.Require().NoError(err)
+
+			txs[i] = tx
+			expectedExecution[tx] = true
+		}
+
+		// The lane may use all of the bytes in the block but only half of the gas.
+		config := base.NewLaneConfig(
+			log.NewNopLogger(),
+			s.encodingConfig.TxConfig.TxEncoder(),
+			s.encodingConfig.TxConfig.TxDecoder(),
+			s.setUpAnteHandler(expectedExecution),
+			signer_extraction.NewDefaultAdapter(),
+			math.LegacyMustNewDecFromStr("0.5"),
+		)
+		config.MaxBlockSpaceBytes = math.LegacyOneDec()
+		lane := defaultlane.NewDefaultLane(config, base.DefaultMatchHandler())
+
+		for _, tx := range txs {
+			s.Require().NoError(lane.Insert(s.ctx, tx))
+		}
+
+		emptyProposal := proposals.NewProposal(
+			log.NewNopLogger(),
+			s.getTxSize(txs[0])+s.getTxSize(txs[1]),
+			20,
+		)
+
+		finalProposal, err := lane.PrepareLane(s.ctx, emptyProposal, block.NoOpPrepareLanesHandler())
+		s.Require().NoError(err)
+
+		// Only one transaction fits in the gas budget even though both fit in the byte budget.
+		txBzs, err := utils.GetEncodedTxs(s.encodingConfig.TxConfig.TxEncoder(), txs[:1])
+		s.Require().NoError(err)
+		s.Require().Equal(txBzs, finalProposal.Txs)
+		s.Require().Equal(uint64(10), finalProposal.Info.GasLimit)
+		s.Require().True(lane.Contains(txs[1]))
+	})
+
+	s.Run("should skip the remaining txs of a sender after a tx fails verification", func() {
+		senderTxs := make([]sdk.Tx, 3)
+		for nonce := range senderTxs {
+			tx, err := testutils.CreateRandomTx(
+				s.encodingConfig.TxConfig,
+				s.accounts[0],
+				uint64(nonce),
+				1,
+				0,
+				1,
+				sdk.NewCoin(s.gasTokenDenom, math.NewInt(1)),
+			)
+			s.Require().NoError(err)
+
+			senderTxs[nonce] = tx
+		}
+
+		otherTx, err := testutils.CreateRandomTx(
+			s.encodingConfig.TxConfig,
+			s.accounts[1],
+			0,
+			1,
+			0,
+			1,
+			sdk.NewCoin(s.gasTokenDenom, math.NewInt(1)),
+		)
+		s.Require().NoError(err)
+
+		// The first tx of the sender fails verification.
+		expectedExecution := map[sdk.Tx]bool{
+			senderTxs[0]: false,
+			senderTxs[1]: true,
+			senderTxs[2]: true,
+			otherTx:      true,
+		}
+		lane := s.initLane(math.LegacyOneDec(), expectedExecution)
+
+		for _, tx := range append([]sdk.Tx{otherTx}, senderTxs...) {
+			s.Require().NoError(lane.Insert(s.ctx, tx))
+		}
+
+		emptyProposal := proposals.NewProposal(
+			log.NewNopLogger(),
+			1000000,
+			1000000,
+		)
+
+		finalProposal, err := lane.PrepareLane(s.ctx, emptyProposal, block.NoOpPrepareLanesHandler())
+		s.Require().NoError(err)
+
+		txBzs, err := utils.GetEncodedTxs(s.encodingConfig.TxConfig.TxEncoder(), []sdk.Tx{otherTx})
+		s.Require().NoError(err)
+		s.Require().Equal(txBzs, finalProposal.Txs)
+
+		// Only the tx that failed is removed, the next txs of the sender stay in the lane.
+		s.Require().False(lane.Contains(senderTxs[0]))
+		s.Require().True(lane.Contains(senderTxs[1]))
+		s.Require().True(lane.Contains(senderTxs[2]))
+	})
+
+	s.Run("removes a tx whose match handler panics without failing the lane", func() {
+		tx1, err := testutils.CreateRandomTx(
+			s.encodingConfig.TxConfig,
+			s.accounts[0],
+			0,
+			1,
+			0,
+			1,
+			sdk.NewCoin(s.gasTokenDenom, math.NewInt(2)),
+		)
+		s.Require().NoError(err)
+
+		tx2, err := testutils.CreateRandomTx(
+			s.encodingConfig.TxConfig,
+			s.accounts[1],
+			0,
+			1,
+			0,
+			1,
+			sdk.NewCoin(s.gasTokenDenom, math.NewInt(1)),
+		)
+		s.Require().NoError(err)
+
+		lane := s.initLaneWithMatchHandlers(
+			math.LegacyOneDec(),
+			map[sdk.Tx]bool{tx1: true, tx2: true},
+			[]base.MatchHandler{
+				func(_ sdk.Context, tx sdk.Tx) bool {
+					if tx == tx2 {
+						panic("poisonous tx")
+					}
+
+					return false
+				},
+			},
+		)
+		s.Require().NoError(lane.Insert(s.ctx, tx1))
+		s.Require().NoError(lane.Insert(s.ctx, tx2))
+
+		proposal := proposals.NewProposal(log.NewNopLogger(), 1000000, 1000000)
+		finalProposal, err := lane.PrepareLane(s.ctx, proposal, block.NoOpPrepareLanesHandler())
+		s.Require().NoError(err)
+
+		txBzs, err := utils.GetEncodedTxs(s.encodingConfig.TxConfig.TxEncoder(), []sdk.Tx{tx1})
+		s.Require().NoError(err)
+		s.Require().Equal(txBzs, finalProposal.Txs)
+		s.Require().True(lane.Contains(tx1))
+		s.Require().False(lane.Contains(tx2))
+	})
 }
 
 func (s *BaseTestSuite) TestProcessLane() {
@@ -1497,6 +1716,162 @@ func (s *BaseTestSuite) TestProcessLane() {
 	})
 }
 
+func (s *BaseTestSuite) TestProcessLaneBasic() {
+	tx1, err := testutils.CreateRandomTx(
+		s.encodingConfig.TxConfig,
+		s.accounts[0],
+		0,
+		1,
+		0,
+		1,
+		sdk.NewCoin(s.gasTokenDenom, math.NewInt(2)),
+	)
+	s.Require().NoError(err)
+
+	tx2, err := testutils.CreateRandomTx(
+		s.encodingConfig.TxConfig,
+		s.accounts[1],
+		0,
+		1,
+		0,
+		1,
+		sdk.NewCoin(s.gasTokenDenom, math.NewInt(1)),
+	)
+	s.Require().NoError(err)
+
+	otherTx, err := testutils.CreateRandomTx(
+		s.encodingConfig.TxConfig,
+		s.accounts[2],
+		0,
+		1,
+		0,
+		1,
+		sdk.NewCoin(s.gasTokenDenom, math.NewInt(3)),
+	)
+	s.Require().NoError(err)
+
+	// otherTx belongs to a different lane.
+	mh := func(_ sdk.Context, tx sdk.Tx) bool {
+		return tx == otherTx
+	}
+
+	s.Run("does not verify transactions against state", func() {
+		// tx2 fails verification but stateless verification does not execute it.
+		lane := s.initLaneWithMatchHandlers(math.LegacyOneDec(), map[sdk.Tx]bool{tx1: true, tx2: false}, []base.MatchHandler{mh})
+
+		s.Require().NoError(lane.ProcessLaneBasic(s.ctx, []sdk.Tx{tx1, tx2}, []sdk.Tx{otherTx}))
+
+		_, err := lane.ProcessLane(
+			s.ctx,
+			proposals.NewProposal(log.NewNopLogger(), 100000, 100000),
+			[]sdk.Tx{tx1, tx2, otherTx},
+			block.NoOpProcessLanesHandler(),
+		)
+		s.Require().Error(err)
+	})
+
+	s.Run("rejects transactions that do not belong to the lane", func() {
+		lane := s.initLaneWithMatchHandlers(math.LegacyOneDec(), nil, []base.MatchHandler{mh})
+
+		s.Require().Error(lane.ProcessLaneBasic(s.ctx, []sdk.Tx{tx1, otherTx}, nil))
+	})
+
+	s.Run("rejects remaining transactions that belong to the lane", func() {
+		lane := s.initLaneWithMatchHandlers(math.LegacyOneDec(), nil, []base.MatchHandler{mh})
+
+		s.Require().Error(lane.ProcessLaneBasic(s.ctx, []sdk.Tx{tx1}, []sdk.Tx{otherTx, tx2}))
+	})
+
+	s.Run("rejects transactions that are not ordered by priority", func() {
+		nextTx, err := testutils.CreateRandomTx(
+			s.encodingConfig.TxConfig,
+			s.accounts[0],
+			1,
+			1,
+			0,
+			1,
+			sdk.NewCoin(s.gasTokenDenom, math.NewInt(2)),
+		)
+		s.Require().NoError(err)
+
+		lane := s.initLaneWithMatchHandlers(math.LegacyOneDec(), nil, []base.MatchHandler{mh})
+
+		s.Require().NoError(lane.ProcessLaneBasic(s.ctx, []sdk.Tx{tx1, nextTx}, nil))
+		s.Require().Error(lane.ProcessLaneBasic(s.ctx, []sdk.Tx{nextTx, tx1}, nil))
+	})
+
+	s.Run("is not supported by lanes with a custom process lane handler", func() {
+		lane := s.initLane(math.LegacyOneDec(), nil)
+		lane.WithOptions(base.WithProcessLaneHandler(base.NoOpProcessLaneHandler()))
+
+		err := lane.ProcessLaneBasic(s.ctx, []sdk.Tx{tx1}, nil)
+		s.Require().ErrorIs(err, block.ErrProcessLaneBasicNotSupported)
+	})
+}
+
+func (s *BaseTestSuite) TestMetrics() {
+	tx1, err := testutils.CreateRandomTx(
+		s.encodingConfig.TxConfig,
+		s.accounts[0],
+		0,
+		1,
+		0,
+		10,
+		sdk.NewCoin(s.gasTokenDenom, math.NewInt(2)),
+	)
+	s.Require().NoError(err)
+
+	tx2, err := testutils.CreateRandomTx(
+		s.encodingConfig.TxConfig,
+		s.accounts[1],
+		0,
+		1,
+		0,
+		10,
+		sdk.NewCoin(s.gasTokenDenom, math.NewInt(1)),
+	)
+	s.Require().NoError(err)
+
+	m := metricsmocks.NewMetrics(s.T())
+	config := base.NewLaneConfig(
+		log.NewNopLogger(),
+		s.encodingConfig.TxConfig.TxEncoder(),
+		s.encodingConfig.TxConfig.TxDecoder(),
+		s.setUpAnteHandler(map[sdk.Tx]bool{tx1: true, tx2: false}),
+		signer_extraction.NewDefaultAdapter(),
+		math.LegacyOneDec(),
+	)
+	config.Metrics = m
+	lane := defaultlane.NewDefaultLane(config, base.DefaultMatchHandler())
+	s.Require().Equal(m, lane.Metrics())
+
+	s.Require().NoError(lane.Insert(s.ctx, tx1))
+	s.Require().NoError(lane.Insert(s.ctx, tx2))
+
+	txBz, err := s.encodingConfig.TxConfig.TxEncoder()(tx1)
+	s.Require().NoError(err)
+
+	s.Run("reports the partial proposal and evicted transactions when preparing", func() {
+		m.On("AddTxsEvicted", lane.Name(), 1).Once()
+		m.On("SetLaneSize", lane.Name(), 1).Once()
+		m.On("ObservePrepareLaneLatency", lane.Name(), mock.Anything).Once()
+		m.On("ObservePartialProposal", lane.Name(), 1, int64(len(txBz)), uint64(10)).Once()
+
+		proposal := proposals.NewProposal(log.NewNopLogger(), 100000, 100000)
+		finalProposal, err := lane.PrepareLane(s.ctx, proposal, block.NoOpPrepareLanesHandler())
+		s.Require().NoError(err)
+		s.Require().Equal([][]byte{txBz}, finalProposal.Txs)
+	})
+
+	s.Run("reports the latency when processing", func() {
+		m.On("ObserveProcessLaneLatency", lane.Name(), mock.Anything).Once()
+
+		proposal := proposals.NewProposal(log.NewNopLogger(), 100000, 100000)
+		_, err := lane.ProcessLane(s.ctx, proposal, []sdk.Tx{tx1}, block.NoOpProcessLanesHandler())
+		s.Require().NoError(err)
+	})
+}
+
 func (s *BaseTestSuite) TestPrepareProcessParity() {
 	txsToInsert := []sdk.Tx{}
 	validationMap := make(map[sdk.Tx]bool)
@@ -1634,6 +2009,84 @@ func (s *BaseTestSuite) TestIterateMempoolAndProcessProposalParity() {
 	}
 }
 
+func (s *BaseTestSuite) TestUnorderedTxsPrepareProcessParity() {
+	txEncoder := testutils.UnorderedTxEncoder(s.encodingConfig.TxConfig.TxEncoder())
+	accounts := testutils.RandomAccounts(s.random, 10)
+
+	// Each account has both ordered and unordered txs with random fees.
+	txsToInsert := []sdk.Tx{}
+	for _, account := range accounts {
+		for i := 0; i < 10; i++ {
+			fee := sdk.NewCoin(s.gasTokenDenom, math.NewInt(int64(rand.Intn(100))))
+
+			tx, err := testutils.CreateRandomTx(s.encodingConfig.TxConfig, account, uint64(i), 1, 0, 1, fee)
+			s.Require().NoError(err)
+			txsToInsert = append(txsToInsert, tx)
+
+			unorderedTx, err := testutils.CreateUnorderedTx(s.encodingConfig.TxConfig, account, time.Unix(int64(i), 0), 1, fee)
+			s.Require().NoError(err)
+			txsToInsert = append(txsToInsert, unorderedTx)
+		}
+	}
+
+	cfg := base.NewLaneConfig(
+		log.NewNopLogger(),
+		txEncoder,
+		s.encodingConfig.TxConfig.TxDecoder(),
+		func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil },
+		signer_extraction.NewDefaultAdapter(),
+		math.LegacyOneDec(),
+	)
+
+	feePriority := base.TxPriority[int64]{
+		GetTxPriority: func(_ context.Context, tx sdk.Tx) int64 {
+			return tx.(sdk.FeeTx).GetFee().AmountOf(s.gasTokenDenom).Int64()
+		},
+		Compare: func(a, b int64) int {
+			return skiplist.Int64.Compare(a, b)
+		},
+		MinValue: -1,
+	}
+
+	lane, err := base.NewBaseLane(cfg, defaultlane.LaneName, base.WithMempoolConfigs(cfg, feePriority))
+	s.Require().NoError(err)
+
+	for _, tx := range txsToInsert {
+		s.Require().NoError(lane.Insert(s.ctx, tx))
+	}
+	s.Require().Equal(len(txsToInsert), lane.CountTx())
+
+	retrievedTxs := []sdk.Tx{}
+	for iterator := lane.Select(context.Background(), nil); iterator != nil; iterator = iterator.Next() {
+		retrievedTxs = append(retrievedTxs, iterator.Tx())
+	}
+	s.Require().Equal(len(txsToInsert), len(retrievedTxs))
+
+	proposal, err := lane.PrepareLane(
+		s.ctx,
+		proposals.NewProposal(log.NewNopLogger(), 1000000000000000, 1000000000000000),
+		block.NoOpPrepareLanesHandler(),
+	)
+	s.Require().NoError(err)
+	s.Require().Equal(len(retrievedTxs), len(proposal.Txs))
+
+	// The ordering check of the process handler accepts the mix of ordered and unordered txs.
+	proposal, err = lane.ProcessLane(
+		s.ctx,
+		proposals.NewProposal(log.NewNopLogger(), 1000000000000000, 1000000000000000),
+		retrievedTxs,
+		block.NoOpProcessLanesHandler(),
+	)
+	s.Require().NoError(err)
+	s.Require().Equal(len(retrievedTxs), len(proposal.Txs))
+
+	for i, tx := range retrievedTxs {
+		bz, err := txEncoder(tx)
+		s.Require().NoError(err)
+		s.Require().Equal(bz, proposal.Txs[i])
+	}
+}
+
 func (s *BaseTestSuite) initLane(
 	maxBlockSpace math.LegacyDec,
 	expectedExecution map[sdk.Tx]bool,
@@ -0,0 +1,142 @@
+package base_test
+
+import (
+	"fmt"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	signer_extraction "github.com/skip-mev/block-sdk/v2/adapters/signer_extraction_adapter"
+	"github.com/skip-mev/block-sdk/v2/block"
+	"github.com/skip-mev/block-sdk/v2/block/base"
+	"github.com/skip-mev/block-sdk/v2/block/proposals"
+	defaultlane "github.com/skip-mev/block-sdk/v2/lanes/base"
+)
+
+var _ base.MsgRouter = msgRouter{}
+
+// msgRouter is a base.MsgRouter that executes MsgSends, which revert if they are sent
+// by one of the reverting accounts and panic if they are sent by one of the panicking
+// accounts.
+type msgRouter struct {
+	reverting map[string]bool
+	panicking map[string]bool
+}
+
+func (r msgRouter) Handler(msg sdk.Msg) baseapp.MsgServiceHandler {
+	send, ok := msg.(*banktypes.MsgSend)
+	if !ok {
+		return nil
+	}
+
+	return func(_ sdk.Context, _ sdk.Msg) (*sdk.Result, error) {
+		if r.panicking[send.FromAddress] {
+			panic("out of gas")
+		}
+
+		if r.reverting[send.FromAddress] {
+			return nil, fmt.Errorf("insufficient funds")
+		}
+
+		return &sdk.Result{}, nil
+	}
+}
+
+func (s *BaseTestSuite) TestPrepareLaneWithExecution() {
+	router := msgRouter{
+		reverting: map[string]bool{s.accounts[1].Address.String(): true},
+		panicking: map[string]bool{s.accounts[2].Address.String(): true},
+	}
+
+	tx1 := s.createKnapsackTx(s.accounts[0], 0, 10, 30)
+	tx2 := s.createKnapsackTx(s.accounts[1], 0, 10, 20)
+	tx3 := s.createKnapsackTx(s.accounts[2], 0, 10, 10)
+	expectedExecution := map[sdk.Tx]bool{tx1: true, tx2: true, tx3: true}
+
+	s.Run("excludes reverting txs from the proposal", func() {
+		lane := s.initExecutionLane(expectedExecution, router, false)
+		for _, tx := range []sdk.Tx{tx1, tx2, tx3} {
+			s.Require().NoError(lane.Insert(s.ctx, tx))
+		}
+
+		proposal := proposals.NewProposal(log.NewNopLogger(), 1000000, 1000)
+		finalProposal, err := lane.PrepareLane(s.ctx, proposal, block.NoOpPrepareLanesHandler())
+		s.Require().NoError(err)
+		s.Require().Equal(s.encodeTxs(tx1), finalProposal.Txs)
+
+		// The reverting txs stay in the mempool.
+		s.Require().Equal(3, lane.CountTx())
+	})
+
+	s.Run("can remove reverting txs from the mempool", func() {
+		lane := s.initExecutionLane(expectedExecution, router, true)
+		for _, tx := range []sdk.Tx{tx1, tx2, tx3} {
+			s.Require().NoError(lane.Insert(s.ctx, tx))
+		}
+
+		proposal := proposals.NewProposal(log.NewNopLogger(), 1000000, 1000)
+		finalProposal, err := lane.PrepareLane(s.ctx, proposal, block.NoOpPrepareLanesHandler())
+		s.Require().NoError(err)
+		s.Require().Equal(s.encodeTxs(tx1), finalProposal.Txs)
+
+		s.Require().True(lane.Contains(tx1))
+		s.Require().False(lane.Contains(tx2))
+		s.Require().False(lane.Contains(tx3))
+	})
+
+	s.Run("removes a reverting tx once it reverted MaxTxReverts times", func() {
+		lane := s.initExecutionLane(expectedExecution, router, false)
+		for _, tx := range []sdk.Tx{tx1, tx2} {
+			s.Require().NoError(lane.Insert(s.ctx, tx))
+		}
+
+		for i := 0; i < base.DefaultMaxTxReverts; i++ {
+			s.Require().True(lane.Contains(tx2))
+
+			proposal := proposals.NewProposal(log.NewNopLogger(), 1000000, 1000)
+			finalProposal, err := lane.PrepareLane(s.ctx, proposal, block.NoOpPrepareLanesHandler())
+			s.Require().NoError(err)
+			s.Require().Equal(s.encodeTxs(tx1), finalProposal.Txs)
+		}
+
+		s.Require().True(lane.Contains(tx1))
+		s.Require().False(lane.Contains(tx2))
+	})
+
+	s.Run("does not execute txs when verifying a proposal", func() {
+		lane := s.initExecutionLane(expectedExecution, router, false)
+
+		// A tx that reverts is still valid in a block.
+		proposal := proposals.NewProposal(log.NewNopLogger(), 1000000, 1000)
+		_, err := lane.ProcessLane(s.ctx, proposal, []sdk.Tx{tx1, tx2}, block.NoOpProcessLanesHandler())
+		s.Require().NoError(err)
+	})
+
+	s.Run("only executes txs when asked to", func() {
+		lane := s.initExecutionLane(expectedExecution, router, false)
+		s.Require().NoError(lane.VerifyTx(s.ctx, tx2, false))
+		s.Require().ErrorIs(lane.VerifyAndExecuteTx(s.ctx, tx2), base.ErrTxReverted)
+	})
+}
+
+func (s *BaseTestSuite) initExecutionLane(
+	expectedExecution map[sdk.Tx]bool,
+	router base.MsgRouter,
+	removeRevertingTxs bool,
+) *base.BaseLane {
+	config := base.NewLaneConfig(
+		log.NewNopLogger(),
+		s.encodingConfig.TxConfig.TxEncoder(),
+		s.encodingConfig.TxConfig.TxDecoder(),
+		s.setUpAnteHandler(expectedExecution),
+		signer_extraction.NewDefaultAdapter(),
+		math.LegacyOneDec(),
+	)
+	config.MsgRouter = router
+	config.RemoveRevertingTxs = removeRevertingTxs
+
+	return defaultlane.NewDefaultLane(config, base.DefaultMatchHandler())
+}
@@ -0,0 +1,222 @@
+package base_test
+
+import (
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	signer_extraction "github.com/skip-mev/block-sdk/v2/adapters/signer_extraction_adapter"
+	"github.com/skip-mev/block-sdk/v2/block"
+	"github.com/skip-mev/block-sdk/v2/block/base"
+	"github.com/skip-mev/block-sdk/v2/block/proposals"
+	testutils "github.com/skip-mev/block-sdk/v2/testutils"
+)
+
+func (s *BaseTestSuite) TestKnapsackPrepareLane() {
+	s.Run("selects the most valuable set of txs instead of the highest priority tx", func() {
+		// gas price: 1
+		tx1 := s.createKnapsackTx(s.accounts[0], 0, 60, 60)
+		// gas price: 0.9
+		tx2 := s.createKnapsackTx(s.accounts[1], 0, 50, 45)
+		tx3 := s.createKnapsackTx(s.accounts[2], 0, 50, 45)
+
+		lane := s.initKnapsackLane(map[sdk.Tx]bool{tx1: true, tx2: true, tx3: true})
+		for _, tx := range []sdk.Tx{tx1, tx2, tx3} {
+			s.Require().NoError(lane.Insert(s.ctx, tx))
+		}
+
+		proposal := proposals.NewProposal(log.NewNopLogger(), 1000000, 100)
+		finalProposal, err := lane.PrepareLane(s.ctx, proposal, block.NoOpPrepareLanesHandler())
+		s.Require().NoError(err)
+
+		s.Require().Equal(s.encodeTxs(tx2, tx3), finalProposal.Txs)
+		s.Require().Equal(uint64(100), finalProposal.Info.GasLimit)
+		s.Require().Equal(3, lane.CountTx())
+	})
+
+	s.Run("respects the nonce order of senders", func() {
+		// gas price: 0.1, but it must be included for the next tx of the sender.
+		tx1 := s.createKnapsackTx(s.accounts[0], 0, 50, 5)
+		// gas price: 2
+		tx2 := s.createKnapsackTx(s.accounts[0], 1, 50, 100)
+		// gas price: 1
+		tx3 := s.createKnapsackTx(s.accounts[1], 0, 50, 50)
+
+		lane := s.initKnapsackLane(map[sdk.Tx]bool{tx1: true, tx2: true, tx3: true})
+		for _, tx := range []sdk.Tx{tx1, tx2, tx3} {
+			s.Require().NoError(lane.Insert(s.ctx, tx))
+		}
+
+		proposal := proposals.NewProposal(log.NewNopLogger(), 1000000, 100)
+		finalProposal, err := lane.PrepareLane(s.ctx, proposal, block.NoOpPrepareLanesHandler())
+		s.Require().NoError(err)
+		s.Require().Equal(s.encodeTxs(tx1, tx2), finalProposal.Txs)
+
+		// With enough gas for all txs, the txs are ordered by priority.
+		proposal = proposals.NewProposal(log.NewNopLogger(), 1000000, 150)
+		finalProposal, err = lane.PrepareLane(s.ctx, proposal, block.NoOpPrepareLanesHandler())
+		s.Require().NoError(err)
+		s.Require().Equal(s.encodeTxs(tx3, tx1, tx2), finalProposal.Txs)
+	})
+
+	s.Run("respects the max number of txs", func() {
+		tx1 := s.createKnapsackTx(s.accounts[0], 0, 10, 10)
+		tx2 := s.createKnapsackTx(s.accounts[1], 0, 10, 30)
+		tx3 := s.createKnapsackTx(s.accounts[2], 0, 10, 20)
+
+		lane := s.initKnapsackLane(map[sdk.Tx]bool{tx1: true, tx2: true, tx3: true})
+		for _, tx := range []sdk.Tx{tx1, tx2, tx3} {
+			s.Require().NoError(lane.Insert(s.ctx, tx))
+		}
+
+		limit := proposals.LaneLimits{MaxTxBytes: 1000000, MaxGasLimit: 100, MaxTxs: 2}
+		txsToInclude, txsToRemove, err := base.NewKnapsackProposalHandler(lane, s.knapsackTxValue()).
+			PrepareLaneHandler()(s.ctx, proposals.NewProposal(log.NewNopLogger(), 1000000, 100), limit)
+		s.Require().NoError(err)
+		s.Require().Empty(txsToRemove)
+		s.Require().Equal([]sdk.Tx{tx2, tx3}, txsToInclude)
+	})
+
+	s.Run("removes invalid txs and skips the next txs of their sender", func() {
+		tx1 := s.createKnapsackTx(s.accounts[0], 0, 10, 10)
+		tx2 := s.createKnapsackTx(s.accounts[0], 1, 10, 100)
+		tx3 := s.createKnapsackTx(s.accounts[1], 0, 10, 20)
+
+		lane := s.initKnapsackLane(map[sdk.Tx]bool{tx1: false, tx2: true, tx3: true})
+		for _, tx := range []sdk.Tx{tx1, tx2, tx3} {
+			s.Require().NoError(lane.Insert(s.ctx, tx))
+		}
+
+		proposal := proposals.NewProposal(log.NewNopLogger(), 1000000, 100)
+		finalProposal, err := lane.PrepareLane(s.ctx, proposal, block.NoOpPrepareLanesHandler())
+		s.Require().NoError(err)
+		s.Require().Equal(s.encodeTxs(tx3), finalProposal.Txs)
+
+		s.Require().False(lane.Contains(tx1))
+		s.Require().True(lane.Contains(tx2))
+	})
+
+	s.Run("removes a tx whose match handler panics without failing the lane", func() {
+		tx1 := s.createKnapsackTx(s.accounts[0], 0, 10, 10)
+		tx2 := s.createKnapsackTx(s.accounts[1], 0, 10, 20)
+
+		lane := s.initKnapsackLane(map[sdk.Tx]bool{tx1: true, tx2: true})
+		lane.WithOptions(base.WithMatchHandler(func(_ sdk.Context, tx sdk.Tx) bool {
+			if tx == tx2 {
+				panic("poisonous tx")
+			}
+
+			return true
+		}))
+		for _, tx := range []sdk.Tx{tx1, tx2} {
+			s.Require().NoError(lane.Insert(s.ctx, tx))
+		}
+
+		proposal := proposals.NewProposal(log.NewNopLogger(), 1000000, 100)
+		finalProposal, err := lane.PrepareLane(s.ctx, proposal, block.NoOpPrepareLanesHandler())
+		s.Require().NoError(err)
+		s.Require().Equal(s.encodeTxs(tx1), finalProposal.Txs)
+
+		s.Require().True(lane.Contains(tx1))
+		s.Require().False(lane.Contains(tx2))
+	})
+}
+
+func (s *BaseTestSuite) TestKnapsackProcessLane() {
+	// gas price: 0.1
+	tx1 := s.createKnapsackTx(s.accounts[0], 0, 50, 5)
+	// gas price: 2
+	tx2 := s.createKnapsackTx(s.accounts[0], 1, 50, 100)
+	// gas price: 1
+	tx3 := s.createKnapsackTx(s.accounts[1], 0, 50, 50)
+	// gas price: 0.5
+	tx4 := s.createKnapsackTx(s.accounts[2], 0, 50, 25)
+
+	lane := s.initKnapsackLane(map[sdk.Tx]bool{tx1: true, tx2: true, tx3: true, tx4: true})
+	handler := base.NewKnapsackProposalHandler(lane, s.knapsackTxValue())
+
+	s.Run("accepts the txs selected by the prepare lane handler", func() {
+		for _, tx := range []sdk.Tx{tx1, tx2, tx3, tx4} {
+			s.Require().NoError(lane.Insert(s.ctx, tx))
+		}
+
+		proposal := proposals.NewProposal(log.NewNopLogger(), 1000000, 1000)
+		limit := proposal.GetLaneLimits(lane.GetMaxBlockSpace())
+
+		txsToInclude, _, err := handler.PrepareLaneHandler()(s.ctx, proposal, limit)
+		s.Require().NoError(err)
+		s.Require().Equal([]sdk.Tx{tx3, tx4, tx1, tx2}, txsToInclude)
+
+		txsFromLane, remainingTxs, err := handler.ProcessLaneHandler()(s.ctx, txsToInclude)
+		s.Require().NoError(err)
+		s.Require().Equal(txsToInclude, txsFromLane)
+		s.Require().Empty(remainingTxs)
+
+		s.Require().NoError(handler.ProcessLaneBasicHandler()(s.ctx, txsToInclude, nil))
+	})
+
+	s.Run("rejects a tx with a lower priority than the next tx of another sender", func() {
+		// tx1 precedes tx4, although tx4 has a higher priority. The adjacent txs are in order.
+		partialProposal := []sdk.Tx{tx3, tx1, tx2, tx4}
+
+		_, _, err := handler.ProcessLaneHandler()(s.ctx, partialProposal)
+		s.Require().Error(err)
+
+		s.Require().Error(handler.ProcessLaneBasicHandler()(s.ctx, partialProposal, nil))
+	})
+}
+
+func (s *BaseTestSuite) initKnapsackLane(expectedExecution map[sdk.Tx]bool) *base.BaseLane {
+	config := base.NewLaneConfig(
+		log.NewNopLogger(),
+		s.encodingConfig.TxConfig.TxEncoder(),
+		s.encodingConfig.TxConfig.TxDecoder(),
+		s.setUpAnteHandler(expectedExecution),
+		signer_extraction.NewDefaultAdapter(),
+		math.LegacyOneDec(),
+	)
+
+	lane, err := base.NewBaseLane(
+		config,
+		"knapsack",
+		base.WithMempoolConfigs[string](config, base.GasPriceTxPriority(base.SingleDenomConverter(s.gasTokenDenom))),
+	)
+	s.Require().NoError(err)
+
+	handler := base.NewKnapsackProposalHandler(lane, s.knapsackTxValue())
+	return lane.WithOptions(
+		base.WithPrepareLaneHandler(handler.PrepareLaneHandler()),
+		base.WithProcessLaneHandler(handler.ProcessLaneHandler()),
+		base.WithProcessLaneBasicHandler(handler.ProcessLaneBasicHandler()),
+	)
+}
+
+func (s *BaseTestSuite) knapsackTxValue() base.TxValue {
+	return base.FeeTxValue(base.SingleDenomConverter(s.gasTokenDenom))
+}
+
+func (s *BaseTestSuite) createKnapsackTx(account testutils.Account, nonce, gasLimit uint64, fee int64) sdk.Tx {
+	tx, err := testutils.CreateRandomTx(
+		s.encodingConfig.TxConfig,
+		account,
+		nonce,
+		1,
+		0,
+		gasLimit,
+		sdk.NewCoin(s.gasTokenDenom, math.NewInt(fee)),
+	)
+	s.Require().NoError(err)
+
+	return tx
+}
+
+func (s *BaseTestSuite) encodeTxs(txs ...sdk.Tx) [][]byte {
+	bzs := make([][]byte, len(txs))
+	for i, tx := range txs {
+		bz, err := s.encodingConfig.TxConfig.TxEncoder()(tx)
+		s.Require().NoError(err)
+		bzs[i] = bz
+	}
+
+	return bzs
+}
@@ -6,6 +6,7 @@ import (
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/skip-mev/block-sdk/v2/block/base"
 	"github.com/skip-mev/block-sdk/v2/testutils"
 )
 
@@ -82,4 +83,36 @@ func (s *BaseTestSuite) TestGetTxInfo() {
 		s.Require().NoError(err)
 		s.Require().Equal(txBz, txInfo.TxBytes)
 	})
+
+	s.Run("reuses the information computed when the tx was inserted", func() {
+		tx, err := testutils.CreateRandomTx(
+			s.encodingConfig.TxConfig,
+			accounts[2],
+			0,
+			1,
+			0,
+			100,
+			sdk.NewCoin(s.gasTokenDenom, math.NewInt(100)),
+		)
+		s.Require().NoError(err)
+
+		expected, err := lane.GetTxInfo(s.ctx, tx)
+		s.Require().NoError(err)
+
+		s.Require().NoError(lane.Insert(s.ctx, tx))
+
+		cached, found := lane.LaneMempool.(base.TxInfoCache).TxInfo(tx)
+		s.Require().True(found)
+		s.Require().Equal(expected, cached)
+
+		txInfo, err := lane.GetTxInfo(s.ctx, tx)
+		s.Require().NoError(err)
+		s.Require().Equal(expected, txInfo)
+
+		// The information is no longer cached once the tx is removed.
+		s.Require().NoError(lane.Remove(tx))
+
+		_, found = lane.LaneMempool.(base.TxInfoCache).TxInfo(tx)
+		s.Require().False(found)
+	})
 }
@@ -8,12 +8,16 @@ import (
 
 	"github.com/skip-mev/block-sdk/v2/block/base"
 	"github.com/skip-mev/block-sdk/v2/block/proposals"
+	"github.com/skip-mev/block-sdk/v2/block/utils"
 )
 
 // Implements the MEV lane's PrepareLaneHandler and ProcessLaneHandler.
 type ProposalHandler struct {
 	lane    *base.BaseLane
 	factory Factory
+
+	// bundles caches the decoded bundled transactions of the bids in the lane.
+	bundles *BundleCache
 }
 
 // NewProposalHandler returns a new mev proposal handler.
@@ -21,13 +25,24 @@ func NewProposalHandler(lane *base.BaseLane, factory Factory) *ProposalHandler {
 	return &ProposalHandler{
 		lane:    lane,
 		factory: factory,
+		bundles: NewBundleCache(),
 	}
 }
 
+// TxInfoCache returns the cache of the bundled transactions decoded by the handler,
+// which should be set as the lane's tx info cache (see base.WithTxInfoCache) such that
+// the information of the bundled transactions is not computed again when they are
+// included in a proposal.
+func (h *ProposalHandler) TxInfoCache() base.TxInfoCache {
+	return h.bundles
+}
+
 // PrepareLaneHandler will attempt to select the highest bid transaction that is valid
 // and whose bundled transactions are valid and include them in the proposal. It
 // will return no transactions if no valid bids are found. If any of the bids are invalid,
-// it will return them and will only remove the bids and not the bundled transactions.
+// it will return them and will only remove the bids and not the bundled transactions. The
+// same applies to bids that panic while they are matched or verified (see
+// base.RecoverTxPanic).
 func (h *ProposalHandler) PrepareLaneHandler() base.PrepareLaneHandler {
 	return func(ctx sdk.Context, proposal proposals.Proposal, limit proposals.LaneLimits) ([]sdk.Tx, []sdk.Tx, error) {
 		// Define all of the info we need to select transactions for the partial proposal.
@@ -36,31 +51,48 @@ func (h *ProposalHandler) PrepareLaneHandler() base.PrepareLaneHandler {
 			txsToRemove  []sdk.Tx
 		)
 
+		// Drop the cached bundles of the bids that are no longer in the lane.
+		h.bundles.Prune(func(bidHash string) bool {
+			_, ok := h.lane.LookupHash(bidHash)
+			return ok
+		})
+
 		// Attempt to select the highest bid transaction that is valid and whose
 		// bundled transactions are valid.
 		for iterator := h.lane.Select(ctx, nil); iterator != nil; iterator = iterator.Next() {
 			bidTx := iterator.Tx()
 
-			if !h.lane.Match(ctx, bidTx) {
-				h.lane.Logger().Info("failed to select auction bid tx for lane; tx does not match lane")
+			var matches bool
+			err := h.lane.RecoverTxPanic(ctx, bidTx, func() error {
+				matches = h.lane.Match(ctx, bidTx)
+				return nil
+			})
+			if err != nil {
+				h.lane.Logger().Info("failed to select auction bid tx for lane; failed to match tx", "err", err)
 
 				txsToRemove = append(txsToRemove, bidTx)
 				continue
 			}
 
-			cacheCtx, write := ctx.CacheContext()
-			bundle, err := h.VerifyBidBasic(cacheCtx, bidTx, proposal, limit)
-			if err != nil {
-				h.lane.Logger().Info(
-					"failed to select auction bid tx for lane; tx is invalid",
-					"err", err,
-				)
+			if !matches {
+				h.lane.Logger().Info("failed to select auction bid tx for lane; tx does not match lane")
 
 				txsToRemove = append(txsToRemove, bidTx)
 				continue
 			}
 
-			if err := h.VerifyBidTx(cacheCtx, bidTx, bundle); err != nil {
+			// A bid that panics (or whose bundled transactions panic) is removed instead of
+			// failing the whole lane.
+			cacheCtx, write := ctx.CacheContext()
+			var bundle []sdk.Tx
+			err = h.lane.RecoverTxPanic(ctx, bidTx, func() (err error) {
+				if bundle, err = h.VerifyBidBasic(cacheCtx, bidTx, proposal, limit); err != nil {
+					return err
+				}
+
+				return h.VerifyBidTx(cacheCtx, bidTx, bundle)
+			})
+			if err != nil {
 				h.lane.Logger().Info(
 					"failed to select auction bid tx for lane; tx is invalid",
 					"err", err,
@@ -81,6 +113,10 @@ func (h *ProposalHandler) PrepareLaneHandler() base.PrepareLaneHandler {
 			// valid bundle.
 			write()
 
+			if bidInfo, err := h.factory.GetAuctionBidInfo(bidTx); err == nil && bidInfo != nil {
+				h.lane.Metrics().ObserveWinningBid(h.lane.Name(), bidInfo.Bid)
+			}
+
 			break
 		}
 
@@ -109,7 +145,11 @@ func (h *ProposalHandler) ProcessLaneHandler() base.ProcessLaneHandler {
 			// iff there are no matches in the remaining transactions after this index.
 			if len(partialProposal) > 1 {
 				if err := h.lane.VerifyNoMatches(ctx, partialProposal[1:]); err != nil {
-					return nil, nil, fmt.Errorf("failed to verify no matches: %w", err)
+					return nil, nil, proposals.OffsetInvariantError(
+						h.lane.Name(),
+						1,
+						fmt.Errorf("failed to verify no matches: %w", err),
+					)
 				}
 			}
 
@@ -118,21 +158,36 @@ func (h *ProposalHandler) ProcessLaneHandler() base.ProcessLaneHandler {
 
 		bidInfo, err := h.factory.GetAuctionBidInfo(bidTx)
 		if err != nil {
-			return nil, nil, fmt.Errorf("failed to get bid info from auction bid tx for lane %s: %w", h.lane.Name(), err)
+			return nil, nil, proposals.NewInvariantError(
+				h.lane.Name(),
+				0,
+				proposals.InvariantBundle,
+				fmt.Errorf("failed to get bid info from auction bid tx for lane %s: %w", h.lane.Name(), err),
+			)
 		}
 
 		if bidInfo == nil {
-			return nil, nil, fmt.Errorf("bid info is nil")
+			return nil, nil, proposals.NewInvariantError(
+				h.lane.Name(),
+				0,
+				proposals.InvariantBundle,
+				fmt.Errorf("bid info is nil"),
+			)
 		}
 
 		// Check that all bundled transactions were included.
 		bundleSize := len(bidInfo.Transactions) + 1
 		if bundleSize > len(partialProposal) {
-			return nil, nil, fmt.Errorf(
-				"expected %d transactions in lane %s but got %d",
-				bundleSize,
+			return nil, nil, proposals.NewInvariantError(
 				h.lane.Name(),
-				len(partialProposal),
+				0,
+				proposals.InvariantBundle,
+				fmt.Errorf(
+					"expected %d transactions in lane %s but got %d",
+					bundleSize,
+					h.lane.Name(),
+					len(partialProposal),
+				),
 			)
 		}
 
@@ -141,22 +196,42 @@ func (h *ProposalHandler) ProcessLaneHandler() base.ProcessLaneHandler {
 		for index, bundledTxBz := range bidInfo.Transactions {
 			bundledTx, err := h.factory.WrapBundleTransaction(bundledTxBz)
 			if err != nil {
-				return nil, nil, fmt.Errorf("invalid bid tx; failed to decode bundled tx: %w", err)
+				return nil, nil, proposals.NewInvariantError(
+					h.lane.Name(),
+					0,
+					proposals.InvariantBundle,
+					fmt.Errorf("invalid bid tx; failed to decode bundled tx: %w", err),
+				)
 			}
 
 			expectedTxBz, err := h.lane.TxEncoder()(bundledTx)
 			if err != nil {
-				return nil, nil, fmt.Errorf("invalid bid tx; failed to encode bundled tx: %w", err)
+				return nil, nil, proposals.NewInvariantError(
+					h.lane.Name(),
+					0,
+					proposals.InvariantBundle,
+					fmt.Errorf("invalid bid tx; failed to encode bundled tx: %w", err),
+				)
 			}
 
 			actualTxBz, err := h.lane.TxEncoder()(bundle[index])
 			if err != nil {
-				return nil, nil, fmt.Errorf("invalid bid tx; failed to encode tx: %w", err)
+				return nil, nil, proposals.NewInvariantError(
+					h.lane.Name(),
+					index+1,
+					proposals.InvariantBundle,
+					fmt.Errorf("invalid bid tx; failed to encode tx: %w", err),
+				)
 			}
 
 			// Verify that the bundled transaction matches the transaction in the block proposal.
 			if !bytes.Equal(actualTxBz, expectedTxBz) {
-				return nil, nil, fmt.Errorf("invalid bid tx; bundled tx does not match tx in block proposal")
+				return nil, nil, proposals.NewInvariantError(
+					h.lane.Name(),
+					index+1,
+					proposals.InvariantBundle,
+					fmt.Errorf("invalid bid tx; bundled tx does not match tx in block proposal"),
+				)
 			}
 		}
 
@@ -164,13 +239,136 @@ func (h *ProposalHandler) ProcessLaneHandler() base.ProcessLaneHandler {
 		//
 		// TODO: There is duplicate work being done in VerifyBidTx and here.
 		if err := h.VerifyBidTx(ctx, bidTx, bundle); err != nil {
-			return nil, nil, fmt.Errorf("invalid bid tx; failed to verify bid tx: %w", err)
+			return nil, nil, proposals.NewInvariantError(
+				h.lane.Name(),
+				0,
+				proposals.InvariantVerifyTx,
+				fmt.Errorf("invalid bid tx; failed to verify bid tx: %w", err),
+			)
 		}
 
 		return partialProposal[:bundleSize], partialProposal[bundleSize:], nil
 	}
 }
 
+// ProcessLaneBasicHandler will verify the same invariants as the ProcessLaneHandler except for
+// the verification logic of the lane (VerifyTx). In particular, the partial proposal must be
+// empty or contain exactly one bid transaction followed by its bundled transactions.
+func (h *ProposalHandler) ProcessLaneBasicHandler() base.ProcessLaneBasicHandler {
+	return func(ctx sdk.Context, partialProposal []sdk.Tx, remainingTxs []sdk.Tx) error {
+		if len(partialProposal) == 0 {
+			if err := h.lane.VerifyNoMatches(ctx, remainingTxs); err != nil {
+				return proposals.OffsetInvariantError(
+					h.lane.Name(),
+					0,
+					fmt.Errorf("failed to verify no matches: %w", err),
+				)
+			}
+
+			return nil
+		}
+
+		bidTx := partialProposal[0]
+		if !h.lane.Match(ctx, bidTx) {
+			return proposals.NewInvariantError(
+				h.lane.Name(),
+				0,
+				proposals.InvariantMatch,
+				fmt.Errorf("transaction at index 0 does not belong to lane %s", h.lane.Name()),
+			)
+		}
+
+		bidInfo, err := h.factory.GetAuctionBidInfo(bidTx)
+		if err != nil {
+			return proposals.NewInvariantError(
+				h.lane.Name(),
+				0,
+				proposals.InvariantBundle,
+				fmt.Errorf("failed to get bid info from auction bid tx for lane %s: %w", h.lane.Name(), err),
+			)
+		}
+
+		if bidInfo == nil {
+			return proposals.NewInvariantError(
+				h.lane.Name(),
+				0,
+				proposals.InvariantBundle,
+				fmt.Errorf("bid info is nil"),
+			)
+		}
+
+		// Check that exactly the bundled transactions were included.
+		if bundleSize := len(bidInfo.Transactions) + 1; bundleSize != len(partialProposal) {
+			return proposals.NewInvariantError(
+				h.lane.Name(),
+				0,
+				proposals.InvariantBundle,
+				fmt.Errorf(
+					"expected %d transactions in lane %s but got %d",
+					bundleSize,
+					h.lane.Name(),
+					len(partialProposal),
+				),
+			)
+		}
+
+		// Ensure the transactions in the proposal match the bundled transactions in the bid transaction.
+		bundle := partialProposal[1:]
+		for index, bundledTxBz := range bidInfo.Transactions {
+			bundledTx, err := h.factory.WrapBundleTransaction(bundledTxBz)
+			if err != nil {
+				return proposals.NewInvariantError(
+					h.lane.Name(),
+					0,
+					proposals.InvariantBundle,
+					fmt.Errorf("invalid bid tx; failed to decode bundled tx: %w", err),
+				)
+			}
+
+			expectedTxBz, err := h.lane.TxEncoder()(bundledTx)
+			if err != nil {
+				return proposals.NewInvariantError(
+					h.lane.Name(),
+					0,
+					proposals.InvariantBundle,
+					fmt.Errorf("invalid bid tx; failed to encode bundled tx: %w", err),
+				)
+			}
+
+			actualTxBz, err := h.lane.TxEncoder()(bundle[index])
+			if err != nil {
+				return proposals.NewInvariantError(
+					h.lane.Name(),
+					index+1,
+					proposals.InvariantBundle,
+					fmt.Errorf("invalid bid tx; failed to encode tx: %w", err),
+				)
+			}
+
+			// Verify that the bundled transaction matches the transaction in the block proposal.
+			if !bytes.Equal(actualTxBz, expectedTxBz) {
+				return proposals.NewInvariantError(
+					h.lane.Name(),
+					index+1,
+					proposals.InvariantBundle,
+					fmt.Errorf("invalid bid tx; bundled tx does not match tx in block proposal"),
+				)
+			}
+
+			if h.lane.Match(ctx, bundle[index]) {
+				return proposals.NewInvariantError(
+					h.lane.Name(),
+					index+1,
+					proposals.InvariantBundle,
+					fmt.Errorf("invalid bid tx; bundled tx is another bid transaction"),
+				)
+			}
+		}
+
+		return nil
+	}
+}
+
 // VerifyBidBasic will verify that the bid transaction and all of its bundled
 // transactions respect the basic invariants of the lane (e.g. size, gas limit).
 func (h *ProposalHandler) VerifyBidBasic(
@@ -199,29 +397,22 @@ func (h *ProposalHandler) VerifyBidBasic(
 		return nil, fmt.Errorf("invalid bid tx; bid tx is already in the proposal")
 	}
 
+	bundle, bundleInfos, err := h.getBundle(ctx, txInfo.Hash, bidInfo.Transactions)
+	if err != nil {
+		return nil, err
+	}
+
 	totalSize := txInfo.Size
 	totalGasLimit := txInfo.GasLimit
-	bundle := make([]sdk.Tx, len(bidInfo.Transactions))
 
 	// Verify size and gas limit of the bundled transactions.
-	for index, bundledTxBz := range bidInfo.Transactions {
-		bundledTx, err := h.factory.WrapBundleTransaction(bundledTxBz)
-		if err != nil {
-			return nil, fmt.Errorf("invalid bid tx; failed to decode bundled tx: %w", err)
-		}
-
-		bundledTxInfo, err := h.lane.GetTxInfo(ctx, bundledTx)
-		if err != nil {
-			return nil, fmt.Errorf("err retrieving transaction info: %s", err)
-		}
-
+	for _, bundledTxInfo := range bundleInfos {
 		if proposal.Contains(bundledTxInfo.Hash) {
 			return nil, fmt.Errorf("invalid bid tx; bundled tx is already in the proposal")
 		}
 
 		totalSize += bundledTxInfo.Size
 		totalGasLimit += bundledTxInfo.GasLimit
-		bundle[index] = bundledTx
 	}
 
 	if totalSize > limit.MaxTxBytes {
@@ -240,6 +431,15 @@ func (h *ProposalHandler) VerifyBidBasic(
 		)
 	}
 
+	// The bid transaction and its bundle are included together.
+	if numTxs := uint64(len(bundle) + 1); limit.MaxTxs > 0 && numTxs > limit.MaxTxs {
+		return nil, fmt.Errorf(
+			"partial proposal includes too many transactions: %d > %d",
+			numTxs,
+			limit.MaxTxs,
+		)
+	}
+
 	return bundle, nil
 }
 
@@ -273,3 +473,37 @@ func (h *ProposalHandler) VerifyBidTx(ctx sdk.Context, bidTx sdk.Tx, bundle []sd
 
 	return nil
 }
+
+// getBundle returns the decoded bundled transactions of the bid transaction with the
+// given hash along with their information. The bundle is decoded once and cached
+// until the bid is no longer in the lane.
+func (h *ProposalHandler) getBundle(
+	ctx sdk.Context,
+	bidHash string,
+	bundledTxsBz [][]byte,
+) ([]sdk.Tx, []utils.TxWithInfo, error) {
+	if bundle, bundleInfos, ok := h.bundles.Get(bidHash); ok {
+		return bundle, bundleInfos, nil
+	}
+
+	bundle := make([]sdk.Tx, len(bundledTxsBz))
+	bundleInfos := make([]utils.TxWithInfo, len(bundledTxsBz))
+	for index, bundledTxBz := range bundledTxsBz {
+		bundledTx, err := h.factory.WrapBundleTransaction(bundledTxBz)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid bid tx; failed to decode bundled tx: %w", err)
+		}
+
+		bundledTxInfo, err := h.lane.GetTxInfo(ctx, bundledTx)
+		if err != nil {
+			return nil, nil, fmt.Errorf("err retrieving transaction info: %s", err)
+		}
+
+		bundle[index] = bundledTx
+		bundleInfos[index] = bundledTxInfo
+	}
+
+	h.bundles.Set(bidHash, bundle, bundleInfos)
+
+	return bundle, bundleInfos, nil
+}
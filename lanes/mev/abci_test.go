@@ -1,11 +1,15 @@
 package mev_test
 
 import (
+	"bytes"
+
 	log "cosmossdk.io/log"
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	signer_extraction "github.com/skip-mev/block-sdk/v2/adapters/signer_extraction_adapter"
 	"github.com/skip-mev/block-sdk/v2/block"
+	"github.com/skip-mev/block-sdk/v2/block/base"
 	"github.com/skip-mev/block-sdk/v2/block/proposals"
 	"github.com/skip-mev/block-sdk/v2/block/utils"
 	"github.com/skip-mev/block-sdk/v2/lanes/mev"
@@ -224,6 +228,118 @@ func (s *MEVTestSuite) TestPrepareLane() {
 		s.Require().Equal(uint64(0), proposal.Info.GasLimit)
 		s.Require().Equal(0, lane.CountTx())
 	})
+
+	s.Run("removes a bid whose bundled tx panics and selects the next bid", func() {
+		bidTx1, _, err := testutils.CreateAuctionTx(
+			s.EncCfg.TxConfig,
+			s.Accounts[0],
+			sdk.NewCoin(s.GasTokenDenom, math.NewInt(100)),
+			0,
+			0,
+			nil,
+			100,
+		)
+		s.Require().NoError(err)
+
+		bidTx2, bundle, err := testutils.CreateAuctionTx(
+			s.EncCfg.TxConfig,
+			s.Accounts[1],
+			sdk.NewCoin(s.GasTokenDenom, math.NewInt(200)),
+			0,
+			0,
+			s.Accounts[1:2],
+			100,
+		)
+		s.Require().NoError(err)
+
+		poisonBz, err := s.EncCfg.TxConfig.TxEncoder()(bundle[0])
+		s.Require().NoError(err)
+
+		// The ante handler panics for the bundled tx of the highest bid.
+		anteHandler := s.SetUpAnteHandler(map[sdk.Tx]bool{bidTx1: true, bidTx2: true})
+		config := base.NewLaneConfig(
+			log.NewNopLogger(),
+			s.EncCfg.TxConfig.TxEncoder(),
+			s.EncCfg.TxConfig.TxDecoder(),
+			func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+				if bz, err := s.EncCfg.TxConfig.TxEncoder()(tx); err == nil && bytes.Equal(bz, poisonBz) {
+					panic("poisonous tx")
+				}
+
+				return anteHandler(ctx, tx, simulate)
+			},
+			signer_extraction.NewDefaultAdapter(),
+			math.LegacyOneDec(),
+		)
+		lane := mev.NewMEVLane(config, s.Config, s.Config.MatchHandler())
+		s.Require().NoError(lane.Insert(s.Ctx, bidTx1))
+		s.Require().NoError(lane.Insert(s.Ctx, bidTx2))
+
+		proposal := proposals.NewProposal(log.NewNopLogger(), 20000, 100000)
+
+		proposal, err = lane.PrepareLane(s.Ctx, proposal, block.NoOpPrepareLanesHandler())
+		s.Require().NoError(err)
+
+		txBzs, err := utils.GetEncodedTxs(s.EncCfg.TxConfig.TxEncoder(), []sdk.Tx{bidTx1})
+		s.Require().NoError(err)
+		s.Require().Equal(txBzs, proposal.Txs)
+		s.Require().True(lane.Contains(bidTx1))
+		s.Require().False(lane.Contains(bidTx2))
+	})
+
+	s.Run("removes a bid whose match handler panics and selects the next bid", func() {
+		bidTx1, _, err := testutils.CreateAuctionTx(
+			s.EncCfg.TxConfig,
+			s.Accounts[0],
+			sdk.NewCoin(s.GasTokenDenom, math.NewInt(100)),
+			0,
+			0,
+			nil,
+			100,
+		)
+		s.Require().NoError(err)
+
+		bidTx2, _, err := testutils.CreateAuctionTx(
+			s.EncCfg.TxConfig,
+			s.Accounts[1],
+			sdk.NewCoin(s.GasTokenDenom, math.NewInt(200)),
+			0,
+			0,
+			nil,
+			100,
+		)
+		s.Require().NoError(err)
+
+		config := base.NewLaneConfig(
+			log.NewNopLogger(),
+			s.EncCfg.TxConfig.TxEncoder(),
+			s.EncCfg.TxConfig.TxDecoder(),
+			s.SetUpAnteHandler(map[sdk.Tx]bool{bidTx1: true, bidTx2: true}),
+			signer_extraction.NewDefaultAdapter(),
+			math.LegacyOneDec(),
+		)
+		matchHandler := s.Config.MatchHandler()
+		lane := mev.NewMEVLane(config, s.Config, func(ctx sdk.Context, tx sdk.Tx) bool {
+			if tx == bidTx2 {
+				panic("poisonous tx")
+			}
+
+			return matchHandler(ctx, tx)
+		})
+		s.Require().NoError(lane.Insert(s.Ctx, bidTx1))
+		s.Require().NoError(lane.Insert(s.Ctx, bidTx2))
+
+		proposal := proposals.NewProposal(log.NewNopLogger(), 20000, 100000)
+
+		proposal, err = lane.PrepareLane(s.Ctx, proposal, block.NoOpPrepareLanesHandler())
+		s.Require().NoError(err)
+
+		txBzs, err := utils.GetEncodedTxs(s.EncCfg.TxConfig.TxEncoder(), []sdk.Tx{bidTx1})
+		s.Require().NoError(err)
+		s.Require().Equal(txBzs, proposal.Txs)
+		s.Require().True(lane.Contains(bidTx1))
+		s.Require().False(lane.Contains(bidTx2))
+	})
 }
 
 func (s *MEVTestSuite) TestProcessLane() {
@@ -533,6 +649,111 @@ func (s *MEVTestSuite) TestProcessLane() {
 	})
 }
 
+func (s *MEVTestSuite) TestProcessLaneBasic() {
+	s.Ctx = s.Ctx.WithExecMode(sdk.ExecModeProcessProposal)
+
+	bidTx, bundle, err := testutils.CreateAuctionTx(
+		s.EncCfg.TxConfig,
+		s.Accounts[0],
+		sdk.NewCoin(s.GasTokenDenom, math.NewInt(100)),
+		0,
+		0,
+		s.Accounts[0:2],
+		100,
+	)
+	s.Require().NoError(err)
+
+	otherTx, err := testutils.CreateRandomTx(s.EncCfg.TxConfig, s.Accounts[2], 0, 1, 0, 100)
+	s.Require().NoError(err)
+
+	cases := []struct {
+		name            string
+		partialProposal []sdk.Tx
+		remainingTxs    []sdk.Tx
+		expectErr       bool
+	}{
+		{
+			"empty partial proposal",
+			nil,
+			[]sdk.Tx{otherTx},
+			false,
+		},
+		{
+			"empty partial proposal with a bid tx in the remaining txs",
+			nil,
+			[]sdk.Tx{otherTx, bidTx},
+			true,
+		},
+		{
+			"bid tx with its bundle",
+			[]sdk.Tx{bidTx, bundle[0], bundle[1]},
+			[]sdk.Tx{otherTx},
+			false,
+		},
+		{
+			"bid tx with a missing bundled tx",
+			[]sdk.Tx{bidTx, bundle[0]},
+			[]sdk.Tx{bundle[1]},
+			true,
+		},
+		{
+			"bid tx with mismatching txs in bundle",
+			[]sdk.Tx{bidTx, bundle[1], bundle[0]},
+			nil,
+			true,
+		},
+		{
+			"bid tx with an additional tx",
+			[]sdk.Tx{bidTx, bundle[0], bundle[1], otherTx},
+			nil,
+			true,
+		},
+		{
+			"partial proposal that does not start with a bid tx",
+			[]sdk.Tx{otherTx},
+			nil,
+			true,
+		},
+	}
+
+	for _, tc := range cases {
+		s.Run(tc.name, func() {
+			// Stateless verification does not execute any of the transactions.
+			lane := s.InitLane(math.LegacyOneDec(), map[sdk.Tx]bool{}, false)
+
+			err := lane.ProcessLaneBasic(s.Ctx, tc.partialProposal, tc.remainingTxs)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+}
+
+func (s *MEVTestSuite) TestCountPartialProposal() {
+	bidTx, bundle, err := testutils.CreateAuctionTx(
+		s.EncCfg.TxConfig,
+		s.Accounts[0],
+		sdk.NewCoin(s.GasTokenDenom, math.NewInt(100)),
+		0,
+		0,
+		s.Accounts[0:2],
+		100,
+	)
+	s.Require().NoError(err)
+
+	otherTx, err := testutils.CreateRandomTx(s.EncCfg.TxConfig, s.Accounts[2], 0, 1, 0, 100)
+	s.Require().NoError(err)
+
+	lane := s.InitLane(math.LegacyOneDec(), map[sdk.Tx]bool{}, false)
+
+	s.Require().Equal(0, lane.CountPartialProposal(s.Ctx, nil))
+	s.Require().Equal(0, lane.CountPartialProposal(s.Ctx, []sdk.Tx{otherTx, bidTx}))
+	s.Require().Equal(3, lane.CountPartialProposal(s.Ctx, []sdk.Tx{bidTx, bundle[0], bundle[1], otherTx}))
+	s.Require().Equal(2, lane.CountPartialProposal(s.Ctx, []sdk.Tx{bidTx, bundle[0]}))
+}
+
 func (s *MEVTestSuite) TestVerifyBidBasic() {
 	lane := s.InitLane(math.LegacyOneDec(), nil, false)
 	proposal := proposals.NewProposal(log.NewNopLogger(), 200, 100)
@@ -632,6 +853,41 @@ func (s *MEVTestSuite) TestVerifyBidBasic() {
 		_, err = handler.VerifyBidBasic(s.Ctx, bidTx, proposal, limits)
 		s.Require().Error(err)
 	})
+
+	s.Run("reuses the decoded bundle of a bid", func() {
+		bidTx, expectedBundle, err := testutils.CreateAuctionTx(
+			s.EncCfg.TxConfig,
+			s.Accounts[0],
+			sdk.NewCoin(s.GasTokenDenom, math.NewInt(100)),
+			0,
+			0,
+			s.Accounts[0:2],
+			100,
+		)
+		s.Require().NoError(err)
+
+		proposal := proposals.NewProposal(log.NewNopLogger(), 1000000, 1000)
+		limits := proposal.GetLaneLimits(lane.GetMaxBlockSpace())
+
+		bundle, err := handler.VerifyBidBasic(s.Ctx, bidTx, proposal, limits)
+		s.Require().NoError(err)
+		s.compare(bundle, expectedBundle)
+
+		cachedBundle, err := handler.VerifyBidBasic(s.Ctx, bidTx, proposal, limits)
+		s.Require().NoError(err)
+		s.Require().Len(cachedBundle, len(bundle))
+
+		for i, tx := range bundle {
+			s.Require().True(tx == cachedBundle[i])
+
+			txBz, err := s.EncCfg.TxConfig.TxEncoder()(tx)
+			s.Require().NoError(err)
+
+			txInfo, found := handler.TxInfoCache().TxInfo(tx)
+			s.Require().True(found)
+			s.Require().Equal(txBz, txInfo.TxBytes)
+		}
+	})
 }
 
 func (s *MEVTestSuite) TestVerifyBidTx() {
@@ -0,0 +1,130 @@
+package mev
+
+import (
+	"reflect"
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/block-sdk/v2/block/base"
+	"github.com/skip-mev/block-sdk/v2/block/utils"
+)
+
+var _ base.TxInfoCache = (*BundleCache)(nil)
+
+type (
+	// BundleCache caches the decoded bundled transactions of bid transactions along
+	// with their information, by the hash of the bid transaction. This way, the bundled
+	// transactions of a bid are only decoded (and encoded) once while the bid is in the
+	// mempool, instead of every time a proposal is built. BundleCache is safe for
+	// concurrent use.
+	BundleCache struct {
+		mtx sync.RWMutex
+
+		// bundles are the cached bundles by the hash of their bid transaction.
+		bundles map[string]cachedBundle
+
+		// txInfos are the information of the cached bundled transactions by
+		// transaction pointer.
+		txInfos map[sdk.Tx]utils.TxWithInfo
+	}
+
+	// cachedBundle is the decoded bundle of a bid transaction.
+	cachedBundle struct {
+		txs     []sdk.Tx
+		txInfos []utils.TxWithInfo
+	}
+)
+
+// NewBundleCache returns a new, empty bundle cache.
+func NewBundleCache() *BundleCache {
+	return &BundleCache{
+		bundles: make(map[string]cachedBundle),
+		txInfos: make(map[sdk.Tx]utils.TxWithInfo),
+	}
+}
+
+// TxInfo returns the information of a bundled transaction that was decoded by the
+// cache, if any.
+func (c *BundleCache) TxInfo(tx sdk.Tx) (utils.TxWithInfo, bool) {
+	if !comparableTx(tx) {
+		return utils.TxWithInfo{}, false
+	}
+
+	c.mtx.RLock()
+	defer c.mtx.RUnlock()
+
+	txInfo, ok := c.txInfos[tx]
+	return txInfo, ok
+}
+
+// Get returns the cached bundle of the bid transaction with the given hash, if any.
+func (c *BundleCache) Get(bidHash string) ([]sdk.Tx, []utils.TxWithInfo, bool) {
+	c.mtx.RLock()
+	defer c.mtx.RUnlock()
+
+	bundle, ok := c.bundles[bidHash]
+	return bundle.txs, bundle.txInfos, ok
+}
+
+// Set caches the bundle of the bid transaction with the given hash. Bundles with
+// transactions that are not pointers are not cached.
+func (c *BundleCache) Set(bidHash string, txs []sdk.Tx, txInfos []utils.TxWithInfo) {
+	for _, tx := range txs {
+		if !comparableTx(tx) {
+			return
+		}
+	}
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	c.remove(bidHash)
+
+	c.bundles[bidHash] = cachedBundle{txs: txs, txInfos: txInfos}
+	for i, tx := range txs {
+		c.txInfos[tx] = txInfos[i]
+	}
+}
+
+// Prune removes the bundles of the bid transactions for which keep returns false,
+// e.g. bids that are no longer in the mempool.
+func (c *BundleCache) Prune(keep func(bidHash string) bool) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	for bidHash := range c.bundles {
+		if !keep(bidHash) {
+			c.remove(bidHash)
+		}
+	}
+}
+
+// Len returns the number of cached bundles.
+func (c *BundleCache) Len() int {
+	c.mtx.RLock()
+	defer c.mtx.RUnlock()
+
+	return len(c.bundles)
+}
+
+// remove removes the bundle of the bid transaction with the given hash. It must be
+// called with the lock held.
+func (c *BundleCache) remove(bidHash string) {
+	bundle, ok := c.bundles[bidHash]
+	if !ok {
+		return
+	}
+
+	for _, tx := range bundle.txs {
+		delete(c.txInfos, tx)
+	}
+
+	delete(c.bundles, bidHash)
+}
+
+// comparableTx returns true if the transaction is a pointer, which can be safely used
+// as a map key.
+func comparableTx(tx sdk.Tx) bool {
+	return tx != nil && reflect.TypeOf(tx).Kind() == reflect.Ptr
+}
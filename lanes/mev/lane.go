@@ -1,6 +1,9 @@
 package mev
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/block-sdk/v2/block"
 	"github.com/skip-mev/block-sdk/v2/block/base"
 )
 
@@ -26,6 +29,8 @@ type (
 	}
 )
 
+var _ block.PartialProposalCounter = (*MEVLane)(nil)
+
 // NewMEVLane returns a new TOB lane.
 func NewMEVLane(
 	cfg base.LaneConfig,
@@ -51,6 +56,8 @@ func NewMEVLane(
 	baseLane.WithOptions(
 		base.WithPrepareLaneHandler(handler.PrepareLaneHandler()),
 		base.WithProcessLaneHandler(handler.ProcessLaneHandler()),
+		base.WithProcessLaneBasicHandler(handler.ProcessLaneBasicHandler()),
+		base.WithTxInfoCache(handler.TxInfoCache()),
 	)
 
 	return &MEVLane{
@@ -58,3 +65,19 @@ func NewMEVLane(
 		Factory:  factory,
 	}
 }
+
+// CountPartialProposal returns the number of leading transactions of txs that make up the
+// lane's partial proposal, i.e. the bid transaction (if the first transaction is one) followed
+// by its bundled transactions (see ProcessLaneHandler).
+func (l *MEVLane) CountPartialProposal(ctx sdk.Context, txs []sdk.Tx) int {
+	if len(txs) == 0 || !l.Match(ctx, txs[0]) {
+		return 0
+	}
+
+	bidInfo, err := l.GetAuctionBidInfo(txs[0])
+	if err != nil || bidInfo == nil {
+		return 1
+	}
+
+	return min(1+len(bidInfo.Transactions), len(txs))
+}
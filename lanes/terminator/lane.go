@@ -40,7 +40,10 @@ const (
 //	 snd    \  \      \        /
 type Terminator struct{}
 
-var _ block.Lane = (*Terminator)(nil)
+var (
+	_ block.Lane                = (*Terminator)(nil)
+	_ block.ParallelProcessLane = (*Terminator)(nil)
+)
 
 // PrepareLane is a no-op
 func (t Terminator) PrepareLane(_ sdk.Context, proposal proposals.Proposal, _ block.PrepareLanesHandler) (proposals.Proposal, error) {
@@ -50,12 +53,36 @@ func (t Terminator) PrepareLane(_ sdk.Context, proposal proposals.Proposal, _ bl
 // ProcessLane is a no-op
 func (t Terminator) ProcessLane(_ sdk.Context, p proposals.Proposal, txs []sdk.Tx, _ block.ProcessLanesHandler) (proposals.Proposal, error) {
 	if len(txs) > 0 {
-		return p, fmt.Errorf("terminator lane should not have any transactions")
+		return p, proposals.NewInvariantError(
+			t.Name(),
+			len(p.Txs),
+			proposals.InvariantMatch,
+			fmt.Errorf("terminator lane should not have any transactions"),
+		)
 	}
 
 	return p, nil
 }
 
+// ProcessLaneBasic is a no-op
+func (t Terminator) ProcessLaneBasic(_ sdk.Context, partialProposal []sdk.Tx, _ []sdk.Tx) error {
+	if len(partialProposal) > 0 {
+		return proposals.NewInvariantError(
+			t.Name(),
+			0,
+			proposals.InvariantMatch,
+			fmt.Errorf("terminator lane should not have any transactions"),
+		)
+	}
+
+	return nil
+}
+
+// VerifyTx is a no-op
+func (t Terminator) VerifyTx(sdk.Context, sdk.Tx, bool) error {
+	return nil
+}
+
 // GetMaxBlockSpace is a no-op
 func (t Terminator) GetMaxBlockSpace() math.LegacyDec {
 	return math.LegacyZeroDec()
@@ -64,6 +91,11 @@ func (t Terminator) GetMaxBlockSpace() math.LegacyDec {
 // SetMaxBlockSpace is a no-op
 func (t Terminator) SetMaxBlockSpace(_ math.LegacyDec) {}
 
+// GetBlockSpace is a no-op
+func (t Terminator) GetBlockSpace() proposals.BlockSpace {
+	return proposals.NewBlockSpace(math.LegacyZeroDec())
+}
+
 // Logger is a no-op
 func (t Terminator) Logger() log.Logger {
 	return log.NewNopLogger()
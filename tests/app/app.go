@@ -48,6 +48,7 @@ import (
 	service "github.com/skip-mev/block-sdk/v2/block/service"
 	"github.com/skip-mev/block-sdk/v2/block/utils"
 	auctionkeeper "github.com/skip-mev/block-sdk/v2/x/auction/keeper"
+	blocksdkkeeper "github.com/skip-mev/block-sdk/v2/x/blocksdk/keeper"
 )
 
 const (
@@ -89,10 +90,14 @@ type TestApp struct {
 	ConsensusParamsKeeper consensuskeeper.Keeper
 	CircuitBreakerKeeper  circuitkeeper.Keeper
 	auctionkeeper         auctionkeeper.Keeper
+	blocksdkkeeper        blocksdkkeeper.Keeper
 	FeeGrantKeeper        feegrantkeeper.Keeper
 
 	// custom checkTx handler
 	checkTxHandler checktx.CheckTx
+
+	// custom finalizeBlock handler
+	finalizeBlockHandler abci.FinalizeBlock
 }
 
 func init() {
@@ -170,6 +175,7 @@ func New(
 		&app.AuthzKeeper,
 		&app.GroupKeeper,
 		&app.auctionkeeper,
+		&app.blocksdkkeeper,
 		&app.ConsensusParamsKeeper,
 		&app.FeeGrantKeeper,
 		&app.CircuitBreakerKeeper,
@@ -214,14 +220,20 @@ func New(
 	// STEP 4: Construct a mempool based off the lanes. Note that the order of the lanes
 	// matters. Blocks are constructed from the top lane to the bottom lane. The top lane
 	// is the first lane in the array and the bottom lane is the last lane in the array.
-	mempool, err := block.NewLanedMempool(
+	// If lanes are stored on-chain in the block sdk module, the order and max block space
+	// of the lanes will be replaced with what is in state when proposals are built.
+	mempool, err := block.NewLanedMempoolWithLaneFetcher(
 		app.Logger(),
 		[]block.Lane{mevLane, freeLane, defaultLane},
+		app.blocksdkkeeper,
 	)
 	if err != nil {
 		panic(err)
 	}
 
+	// Ensure that the lanes stored on-chain match the lanes of the mempool.
+	app.blocksdkkeeper.SetRegisteredLanes(mevLane.Name(), freeLane.Name(), defaultLane.Name())
+
 	// The application's mempool is now powered by the Block SDK!
 	app.App.SetMempool(mempool)
 
@@ -280,6 +292,10 @@ func New(
 	app.App.SetPrepareProposal(proposalHandler.PrepareProposalHandler())
 	app.App.SetProcessProposal(proposalHandler.ProcessProposalHandler())
 
+	// Report the proposal info (if the proposal handler includes it in proposals) as a
+	// successful transaction when the block is finalized.
+	app.finalizeBlockHandler = proposalHandler.FinalizeBlockHandler(app.BaseApp.FinalizeBlock)
+
 	cacheDecoder, err := utils.NewDefaultCacheTxDecoder(app.txConfig.TxDecoder())
 	if err != nil {
 		panic(err)
@@ -300,6 +316,7 @@ func New(
 		cacheDecoder.TxDecoder(),
 		mevCheckTx.CheckTx(),
 		app.BaseApp,
+		checktx.WithAccountKeeper(app.AccountKeeper),
 	)
 
 	app.SetCheckTx(checkTxHandler.CheckTx())
@@ -349,6 +366,12 @@ func (app *TestApp) SetCheckTx(handler checktx.CheckTx) {
 	app.checkTxHandler = handler
 }
 
+// FinalizeBlock executes the block through the Block SDK's finalize block handler (see
+// abci.ProposalHandler.FinalizeBlockHandler).
+func (app *TestApp) FinalizeBlock(req *cometabci.RequestFinalizeBlock) (*cometabci.ResponseFinalizeBlock, error) {
+	return app.finalizeBlockHandler(req)
+}
+
 // Name returns the name of the App
 func (app *TestApp) Name() string { return app.BaseApp.Name() }
 
@@ -428,7 +451,7 @@ func (app *TestApp) RegisterTxService(clientCtx client.Context) {
 	if !ok {
 		panic("mempool is not a block.Mempool")
 	}
-	service.RegisterMempoolService(app.GRPCQueryRouter(), mempool)
+	service.RegisterMempoolService(app.GRPCQueryRouter(), mempool, app.txConfig.TxEncoder())
 }
 
 // GetMaccPerms returns a copy of the module account permissions
@@ -67,8 +67,11 @@ import (
 	_ "github.com/cosmos/cosmos-sdk/x/staking"      // import for side-effects
 
 	auctionmodulev1 "github.com/skip-mev/block-sdk/v2/api/sdk/auction/module/v1"
+	blocksdkmodulev1 "github.com/skip-mev/block-sdk/v2/api/sdk/blocksdk/module/v1"
 	_ "github.com/skip-mev/block-sdk/v2/x/auction" // import for side-effects
 	auctiontypes "github.com/skip-mev/block-sdk/v2/x/auction/types"
+	_ "github.com/skip-mev/block-sdk/v2/x/blocksdk" // import for side-effects
+	blocksdktypes "github.com/skip-mev/block-sdk/v2/x/blocksdk/types"
 )
 
 var (
@@ -114,6 +117,7 @@ var (
 						genutiltypes.ModuleName,
 						authz.ModuleName,
 						auctiontypes.ModuleName,
+						blocksdktypes.ModuleName,
 						feegrant.ModuleName,
 					},
 					EndBlockers: []string{
@@ -123,6 +127,7 @@ var (
 						genutiltypes.ModuleName,
 						group.ModuleName,
 						auctiontypes.ModuleName,
+						blocksdktypes.ModuleName,
 						feegrant.ModuleName,
 					},
 					OverrideStoreKeys: []*runtimev1alpha1.StoreKeyConfig{
@@ -152,6 +157,7 @@ var (
 						consensustypes.ModuleName,
 						circuittypes.ModuleName,
 						auctiontypes.ModuleName,
+						blocksdktypes.ModuleName,
 						feegrant.ModuleName,
 					},
 					// When ExportGenesis is not specified, the export genesis module order
@@ -248,6 +254,10 @@ var (
 				Name:   auctiontypes.ModuleName,
 				Config: appconfig.WrapAny(&auctionmodulev1.Module{}),
 			},
+			{
+				Name:   blocksdktypes.ModuleName,
+				Config: appconfig.WrapAny(&blocksdkmodulev1.Module{}),
+			},
 		},
 	}),
 		depinject.Supply(
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math/rand"
 	"testing"
+	"time"
 
 	storetypes "cosmossdk.io/store/types"
 	txsigning "cosmossdk.io/x/tx/signing"
@@ -24,9 +25,12 @@ import (
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/cosmos/gogoproto/proto"
 
+	signerextraction "github.com/skip-mev/block-sdk/v2/adapters/signer_extraction_adapter"
 	auctiontypes "github.com/skip-mev/block-sdk/v2/x/auction/types"
 )
 
+var _ signerextraction.TxWithUnordered = UnorderedTx{}
+
 type EncodingConfig struct {
 	InterfaceRegistry types.InterfaceRegistry
 	Codec             codec.Codec
@@ -174,6 +178,66 @@ func CreateRandomTx(txCfg client.TxConfig, account Account, nonce, numberMsgs, t
 	return txBuilder.GetTx(), nil
 }
 
+// UnorderedTx wraps a transaction to make it an unordered transaction, which the version of
+// the Cosmos SDK used by the tests does not support natively.
+type UnorderedTx struct {
+	authsigning.Tx
+
+	TimeoutTimestamp time.Time
+}
+
+func (UnorderedTx) GetUnordered() bool {
+	return true
+}
+
+func (tx UnorderedTx) GetTimeoutTimeStamp() time.Time {
+	return tx.TimeoutTimestamp
+}
+
+// CreateUnorderedTx creates an unordered transaction with a single message. The timeout
+// timestamp is also set as the memo of the transaction such that the unordered transactions
+// of an account can be told apart.
+func CreateUnorderedTx(txCfg client.TxConfig, account Account, timeout time.Time, gasLimit uint64, fees ...sdk.Coin) (UnorderedTx, error) {
+	txBuilder := txCfg.NewTxBuilder()
+	if err := txBuilder.SetMsgs(&banktypes.MsgSend{
+		FromAddress: account.Address.String(),
+		ToAddress:   account.Address.String(),
+	}); err != nil {
+		return UnorderedTx{}, err
+	}
+
+	sigV2 := signing.SignatureV2{
+		PubKey: account.PrivKey.PubKey(),
+		Data: &signing.SingleSignatureData{
+			SignMode:  signing.SignMode_SIGN_MODE_DIRECT,
+			Signature: nil,
+		},
+	}
+	if err := txBuilder.SetSignatures(sigV2); err != nil {
+		return UnorderedTx{}, err
+	}
+
+	txBuilder.SetMemo(timeout.UTC().String())
+
+	txBuilder.SetFeeAmount(fees)
+
+	txBuilder.SetGasLimit(gasLimit)
+
+	return UnorderedTx{Tx: txBuilder.GetTx(), TimeoutTimestamp: timeout}, nil
+}
+
+// UnorderedTxEncoder returns a TxEncoder that encodes unordered transactions as the
+// transaction they wrap.
+func UnorderedTxEncoder(txEncoder sdk.TxEncoder) sdk.TxEncoder {
+	return func(tx sdk.Tx) ([]byte, error) {
+		if unorderedTx, ok := tx.(UnorderedTx); ok {
+			return txEncoder(unorderedTx.Tx)
+		}
+
+		return txEncoder(tx)
+	}
+}
+
 func CreateRandomTxBz(txCfg client.TxConfig, account Account, nonce, numberMsgs, timeout, gasLimit uint64) ([]byte, error) {
 	tx, err := CreateRandomTx(txCfg, account, nonce, numberMsgs, timeout, gasLimit)
 	if err != nil {
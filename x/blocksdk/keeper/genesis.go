@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/block-sdk/v2/x/blocksdk/types"
+)
+
+// InitGenesis initializes the blocksdk module's state from a given genesis state.
+func (k Keeper) InitGenesis(ctx sdk.Context, gs types.GenesisState) {
+	if err := k.ValidateLanes(gs.Lanes); err != nil {
+		panic(err)
+	}
+
+	// Set the lane configurations.
+	for _, lane := range gs.Lanes {
+		if err := k.SetLane(ctx, lane); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// ExportGenesis returns a GenesisState for a given context.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	// Get the lane configurations.
+	lanes, err := k.GetLanes(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	return types.NewGenesisState(lanes)
+}
@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/block-sdk/v2/x/blocksdk/types"
+)
+
+var _ types.QueryServer = QueryServer{}
+
+// QueryServer defines the blocksdk module's gRPC querier service.
+type QueryServer struct {
+	keeper Keeper
+}
+
+// NewQueryServer creates a new gRPC query server for the blocksdk module.
+func NewQueryServer(keeper Keeper) *QueryServer {
+	return &QueryServer{keeper: keeper}
+}
+
+// Lane queries the lane configuration with the given id.
+func (q QueryServer) Lane(c context.Context, req *types.QueryLaneRequest) (*types.QueryLaneResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	lane, err := q.keeper.GetLane(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryLaneResponse{Lane: lane}, nil
+}
+
+// Lanes queries all of the lane configurations.
+func (q QueryServer) Lanes(c context.Context, _ *types.QueryLanesRequest) (*types.QueryLanesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	lanes, err := q.keeper.GetLanes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryLanesResponse{Lanes: lanes}, nil
+}
@@ -0,0 +1,26 @@
+package keeper_test
+
+import (
+	"github.com/skip-mev/block-sdk/v2/x/blocksdk/types"
+)
+
+func (s *KeeperTestSuite) TestQueryLane() {
+	s.Run("can query a lane", func() {
+		res, err := s.queryServer.Lane(s.ctx, &types.QueryLaneRequest{Id: "mev"})
+		s.Require().NoError(err)
+		s.Require().Equal(s.defaultLanes()[0], res.Lane)
+	})
+
+	s.Run("errors on an unknown lane", func() {
+		_, err := s.queryServer.Lane(s.ctx, &types.QueryLaneRequest{Id: "unknown"})
+		s.Require().Error(err)
+	})
+}
+
+func (s *KeeperTestSuite) TestQueryLanes() {
+	s.Run("can query all lanes", func() {
+		res, err := s.queryServer.Lanes(s.ctx, &types.QueryLanesRequest{})
+		s.Require().NoError(err)
+		s.Require().Equal(s.defaultLanes(), res.Lanes)
+	})
+}
@@ -0,0 +1,179 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/prefix"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/block-sdk/v2/x/blocksdk/types"
+)
+
+type Keeper struct {
+	cdc      codec.BinaryCodec
+	storeKey storetypes.StoreKey
+
+	// The address that is capable of executing a MsgUpdateLane message.
+	// Typically this will be the governance module's address.
+	authority string
+
+	// registered holds the ids of the lanes registered in the application's mempool. It
+	// is shared by all copies of the keeper so that it can be set once the lanes have been
+	// constructed (see SetRegisteredLanes).
+	registered *registeredLanes
+}
+
+// registeredLanes holds the ids of the lanes registered in the application's mempool.
+type registeredLanes struct {
+	ids []string
+}
+
+// NewKeeper returns a new x/blocksdk keeper.
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeKey storetypes.StoreKey,
+	authority string,
+) Keeper {
+	// Ensure that the authority address is valid.
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		panic(err)
+	}
+
+	return Keeper{
+		cdc:        cdc,
+		storeKey:   storeKey,
+		authority:  authority,
+		registered: &registeredLanes{},
+	}
+}
+
+// Logger returns a blocksdk module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// GetAuthority returns the address that is capable of executing a MsgUpdateLane message.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// SetRegisteredLanes sets the ids of the lanes registered in the application's mempool. Once
+// set, the lanes stored on-chain (at genesis or with MsgUpdateLane) must match the registered
+// lanes. This should be called when the application is constructed.
+func (k Keeper) SetRegisteredLanes(ids ...string) {
+	k.registered.ids = ids
+}
+
+// ValidateLanes validates a set of lane configurations (see types.Lanes.ValidateBasic). If
+// the registered lanes are set (see SetRegisteredLanes), the lanes must also match them.
+func (k Keeper) ValidateLanes(lanes types.Lanes) error {
+	if err := lanes.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if len(k.registered.ids) == 0 {
+		return nil
+	}
+
+	return lanes.ValidateRegistered(k.registered.ids)
+}
+
+// GetLane returns the lane configuration with the given id.
+func (k Keeper) GetLane(ctx sdk.Context, id string) (types.Lane, error) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.LaneKey(id))
+	if len(bz) == 0 {
+		return types.Lane{}, fmt.Errorf("lane %s not found", id)
+	}
+
+	lane := types.Lane{}
+	if err := lane.Unmarshal(bz); err != nil {
+		return types.Lane{}, err
+	}
+
+	return lane, nil
+}
+
+// GetLanes returns all of the lane configurations ordered by their order field.
+func (k Keeper) GetLanes(ctx sdk.Context) ([]types.Lane, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixLane)
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	lanes := make(types.Lanes, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		lane := types.Lane{}
+		if err := lane.Unmarshal(iterator.Value()); err != nil {
+			return nil, err
+		}
+
+		lanes = append(lanes, lane)
+	}
+
+	return lanes.Sort(), nil
+}
+
+// SetLane sets the lane configuration in state. It does not validate the lane
+// against the other lanes in state; use UpdateLane to do so.
+func (k Keeper) SetLane(ctx sdk.Context, lane types.Lane) error {
+	store := ctx.KVStore(k.storeKey)
+
+	bz, err := lane.Marshal()
+	if err != nil {
+		return err
+	}
+
+	store.Set(types.LaneKey(lane.Id), bz)
+
+	return nil
+}
+
+// UpdateLane updates the configuration of an existing lane. If the order of the lane
+// changes, the lane is moved to the given position and the orders of the other lanes
+// are shifted accordingly. The resulting set of lanes must be valid.
+func (k Keeper) UpdateLane(ctx sdk.Context, lane types.Lane) error {
+	lanes, err := k.GetLanes(ctx)
+	if err != nil {
+		return err
+	}
+
+	current, found := types.Lanes(lanes).GetLane(lane.Id)
+	if !found {
+		return fmt.Errorf("lane %s not found", lane.Id)
+	}
+
+	if lane.Order >= uint64(len(lanes)) {
+		return fmt.Errorf("order of lane %s must be less than the number of lanes (%d), got %d", lane.Id, len(lanes), lane.Order)
+	}
+
+	// Move the lane to its new position.
+	updated := make(types.Lanes, 0, len(lanes))
+	for _, l := range lanes {
+		if l.Id != current.Id {
+			updated = append(updated, l)
+		}
+	}
+
+	updated = append(updated[:lane.Order], append(types.Lanes{lane}, updated[lane.Order:]...)...)
+	for i := range updated {
+		updated[i].Order = uint64(i)
+	}
+
+	if err := k.ValidateLanes(updated); err != nil {
+		return err
+	}
+
+	for _, l := range updated {
+		if err := k.SetLane(ctx, l); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
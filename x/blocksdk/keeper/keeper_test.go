@@ -0,0 +1,183 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	testutils "github.com/skip-mev/block-sdk/v2/testutils"
+	"github.com/skip-mev/block-sdk/v2/x/blocksdk/keeper"
+	"github.com/skip-mev/block-sdk/v2/x/blocksdk/types"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	blocksdkKeeper   keeper.Keeper
+	encCfg           testutils.EncodingConfig
+	ctx              sdk.Context
+	key              *storetypes.KVStoreKey
+	authorityAccount sdk.AccAddress
+
+	msgServer   types.MsgServer
+	queryServer types.QueryServer
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	s.encCfg = testutils.CreateTestEncodingConfig()
+	s.key = storetypes.NewKVStoreKey(types.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(s.T(), s.key, storetypes.NewTransientStoreKey("transient_test"))
+	s.ctx = testCtx.Ctx
+
+	s.authorityAccount = sdk.AccAddress([]byte("authority"))
+	s.blocksdkKeeper = keeper.NewKeeper(
+		s.encCfg.Codec,
+		s.key,
+		s.authorityAccount.String(),
+	)
+
+	s.blocksdkKeeper.InitGenesis(s.ctx, *types.NewGenesisState(s.defaultLanes()))
+
+	s.msgServer = keeper.NewMsgServerImpl(s.blocksdkKeeper)
+	s.queryServer = keeper.NewQueryServer(s.blocksdkKeeper)
+}
+
+func (s *KeeperTestSuite) defaultLanes() []types.Lane {
+	return []types.Lane{
+		types.NewLane("mev", math.LegacyMustNewDecFromStr("0.2"), 0),
+		types.NewLane("free", math.LegacyMustNewDecFromStr("0.2"), 1),
+		types.NewLane("default", math.LegacyZeroDec(), 2),
+	}
+}
+
+func (s *KeeperTestSuite) TestGetLanes() {
+	s.Run("returns lanes ordered by their order", func() {
+		lanes, err := s.blocksdkKeeper.GetLanes(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(s.defaultLanes(), lanes)
+	})
+
+	s.Run("can get a single lane", func() {
+		lane, err := s.blocksdkKeeper.GetLane(s.ctx, "free")
+		s.Require().NoError(err)
+		s.Require().Equal(s.defaultLanes()[1], lane)
+	})
+
+	s.Run("errors when the lane does not exist", func() {
+		_, err := s.blocksdkKeeper.GetLane(s.ctx, "unknown")
+		s.Require().Error(err)
+	})
+}
+
+func (s *KeeperTestSuite) TestUpdateLane() {
+	s.Run("can update the max block space of a lane", func() {
+		s.SetupTest()
+
+		lane := types.NewLane("mev", math.LegacyMustNewDecFromStr("0.5"), 0)
+		s.Require().NoError(s.blocksdkKeeper.UpdateLane(s.ctx, lane))
+
+		lanes, err := s.blocksdkKeeper.GetLanes(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(lane, lanes[0])
+	})
+
+	s.Run("moving a lane shifts the other lanes", func() {
+		s.SetupTest()
+
+		lane := types.NewLane("default", math.LegacyZeroDec(), 0)
+		s.Require().NoError(s.blocksdkKeeper.UpdateLane(s.ctx, lane))
+
+		lanes, err := s.blocksdkKeeper.GetLanes(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(
+			[]types.Lane{
+				types.NewLane("default", math.LegacyZeroDec(), 0),
+				types.NewLane("mev", math.LegacyMustNewDecFromStr("0.2"), 1),
+				types.NewLane("free", math.LegacyMustNewDecFromStr("0.2"), 2),
+			},
+			lanes,
+		)
+	})
+
+	s.Run("errors when the lane does not exist", func() {
+		s.SetupTest()
+
+		lane := types.NewLane("unknown", math.LegacyZeroDec(), 0)
+		s.Require().Error(s.blocksdkKeeper.UpdateLane(s.ctx, lane))
+	})
+
+	s.Run("errors when the order is out of range", func() {
+		s.SetupTest()
+
+		lane := types.NewLane("mev", math.LegacyMustNewDecFromStr("0.2"), 3)
+		s.Require().Error(s.blocksdkKeeper.UpdateLane(s.ctx, lane))
+	})
+
+	s.Run("errors and leaves state untouched when the resulting lanes are invalid", func() {
+		s.SetupTest()
+
+		lane := types.NewLane("mev", math.LegacyMustNewDecFromStr("0.9"), 0)
+		s.Require().Error(s.blocksdkKeeper.UpdateLane(s.ctx, lane))
+
+		lanes, err := s.blocksdkKeeper.GetLanes(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(s.defaultLanes(), lanes)
+	})
+}
+
+func (s *KeeperTestSuite) TestGenesis() {
+	s.Run("exports the lanes set at genesis", func() {
+		s.SetupTest()
+
+		gs := s.blocksdkKeeper.ExportGenesis(s.ctx)
+		s.Require().Equal(s.defaultLanes(), gs.Lanes)
+	})
+
+	s.Run("panics on invalid genesis lanes", func() {
+		s.SetupTest()
+
+		gs := types.NewGenesisState([]types.Lane{
+			types.NewLane("mev", math.LegacyMustNewDecFromStr("0.2"), 0),
+			types.NewLane("free", math.LegacyMustNewDecFromStr("0.2"), 0),
+		})
+		s.Require().Panics(func() {
+			s.blocksdkKeeper.InitGenesis(s.ctx, *gs)
+		})
+	})
+
+	s.Run("accepts genesis lanes matching the registered lanes", func() {
+		s.SetupTest()
+		s.blocksdkKeeper.SetRegisteredLanes("mev", "free", "default")
+
+		s.Require().NotPanics(func() {
+			s.blocksdkKeeper.InitGenesis(s.ctx, *types.NewGenesisState(s.defaultLanes()))
+		})
+	})
+
+	s.Run("panics on genesis lanes that are not registered", func() {
+		s.SetupTest()
+		s.blocksdkKeeper.SetRegisteredLanes("mev", "default")
+
+		s.Require().Panics(func() {
+			s.blocksdkKeeper.InitGenesis(s.ctx, *types.NewGenesisState(s.defaultLanes()))
+		})
+	})
+
+	s.Run("panics when a registered lane is missing from genesis", func() {
+		s.SetupTest()
+		s.blocksdkKeeper.SetRegisteredLanes("mev", "free", "other")
+
+		s.Require().Panics(func() {
+			s.blocksdkKeeper.InitGenesis(s.ctx, *types.NewGenesisState(s.defaultLanes()))
+		})
+	})
+}
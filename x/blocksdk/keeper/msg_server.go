@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/block-sdk/v2/x/blocksdk/types"
+)
+
+var _ types.MsgServer = MsgServer{}
+
+// MsgServer is the wrapper for the blocksdk module's msg service.
+type MsgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the blocksdk MsgServer interface.
+func NewMsgServerImpl(keeper Keeper) *MsgServer {
+	return &MsgServer{Keeper: keeper}
+}
+
+// UpdateLane updates the on-chain configuration of an existing lane.
+func (m MsgServer) UpdateLane(goCtx context.Context, msg *types.MsgUpdateLane) (*types.MsgUpdateLaneResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// ensure that the message signer is the authority
+	if msg.Authority != m.Keeper.GetAuthority() {
+		return nil, fmt.Errorf("this message can only be executed by the authority; expected %s, got %s", m.Keeper.GetAuthority(), msg.Authority)
+	}
+
+	if err := m.Keeper.UpdateLane(ctx, msg.Lane); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateLaneResponse{}, nil
+}
@@ -0,0 +1,84 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"time"
+
+	"cosmossdk.io/math"
+
+	testutils "github.com/skip-mev/block-sdk/v2/testutils"
+	"github.com/skip-mev/block-sdk/v2/x/blocksdk/types"
+)
+
+func (s *KeeperTestSuite) TestMsgUpdateLane() {
+	rng := rand.New(rand.NewSource(time.Now().Unix()))
+	account := testutils.RandomAccounts(rng, 1)[0]
+
+	testCases := []struct {
+		name string
+		msg  *types.MsgUpdateLane
+
+		pass      bool
+		passBasic bool
+	}{
+		{
+			name: "invalid lane id",
+			msg: &types.MsgUpdateLane{
+				Authority: s.authorityAccount.String(),
+				Lane:      types.NewLane("", math.LegacyMustNewDecFromStr("0.2"), 0),
+			},
+			passBasic: false,
+			pass:      false,
+		},
+		{
+			name: "invalid max block space",
+			msg: &types.MsgUpdateLane{
+				Authority: s.authorityAccount.String(),
+				Lane:      types.NewLane("mev", math.LegacyMustNewDecFromStr("1.1"), 0),
+			},
+			passBasic: false,
+			pass:      false,
+		},
+		{
+			name: "invalid authority address",
+			msg: &types.MsgUpdateLane{
+				Authority: account.Address.String(),
+				Lane:      types.NewLane("mev", math.LegacyMustNewDecFromStr("0.3"), 0),
+			},
+			passBasic: true,
+			pass:      false,
+		},
+		{
+			name: "valid update",
+			msg: &types.MsgUpdateLane{
+				Authority: s.authorityAccount.String(),
+				Lane:      types.NewLane("mev", math.LegacyMustNewDecFromStr("0.3"), 1),
+			},
+			passBasic: true,
+			pass:      true,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			if tc.passBasic {
+				s.Require().NoError(tc.msg.ValidateBasic())
+			} else {
+				s.Require().Error(tc.msg.ValidateBasic())
+			}
+
+			_, err := s.msgServer.UpdateLane(s.ctx, tc.msg)
+			if tc.pass {
+				s.Require().NoError(err)
+
+				lane, err := s.blocksdkKeeper.GetLane(s.ctx, tc.msg.Lane.Id)
+				s.Require().NoError(err)
+				s.Require().Equal(tc.msg.Lane, lane)
+			} else {
+				s.Require().Error(err)
+			}
+		})
+	}
+}
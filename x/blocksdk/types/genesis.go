@@ -0,0 +1,40 @@
+package types
+
+import (
+	"encoding/json"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// NewGenesisState creates a new GenesisState instance.
+func NewGenesisState(lanes []Lane) *GenesisState {
+	return &GenesisState{
+		Lanes: lanes,
+	}
+}
+
+// DefaultGenesisState returns the default GenesisState instance. By default no
+// lanes are stored on-chain, in which case the lane configurations provided to the
+// mempool at construction are used.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Lanes: []Lane{},
+	}
+}
+
+// Validate performs basic validation of the blocksdk module genesis state.
+func (gs GenesisState) Validate() error {
+	return Lanes(gs.Lanes).ValidateBasic()
+}
+
+// GetGenesisStateFromAppState returns x/blocksdk GenesisState given raw application
+// genesis state.
+func GetGenesisStateFromAppState(cdc codec.Codec, appState map[string]json.RawMessage) GenesisState {
+	var genesisState GenesisState
+
+	if appState[ModuleName] != nil {
+		cdc.MustUnmarshalJSON(appState[ModuleName], &genesisState)
+	}
+
+	return genesisState
+}
@@ -0,0 +1,30 @@
+package types
+
+const (
+	// ModuleName is the name of the blocksdk module
+	ModuleName = "blocksdk"
+
+	// StoreKey is the default store key for the blocksdk module
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for the blocksdk module
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for the blocksdk module
+	QuerierRoute = ModuleName
+)
+
+const (
+	prefixLane = iota + 1
+)
+
+// KeyPrefixLane is the store key prefix for the blocksdk module's lanes.
+var KeyPrefixLane = []byte{prefixLane}
+
+// LaneKey returns the store key for the lane with the given id.
+func LaneKey(id string) []byte {
+	key := make([]byte, 0, len(KeyPrefixLane)+len(id))
+	key = append(key, KeyPrefixLane...)
+
+	return append(key, []byte(id)...)
+}
@@ -0,0 +1,135 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+
+	"cosmossdk.io/math"
+)
+
+// Lanes defines a set of lane configurations.
+type Lanes []Lane
+
+// NewLane returns a new lane configuration.
+func NewLane(id string, maxBlockSpace math.LegacyDec, order uint64) Lane {
+	return Lane{
+		Id:            id,
+		MaxBlockSpace: maxBlockSpace,
+		Order:         order,
+	}
+}
+
+// ValidateBasic performs stateless validation of a single lane configuration.
+func (l Lane) ValidateBasic() error {
+	if l.Id == "" {
+		return fmt.Errorf("lane id cannot be empty")
+	}
+
+	if l.MaxBlockSpace.IsNil() || l.MaxBlockSpace.IsNegative() || l.MaxBlockSpace.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("max block space of lane %s must be set to a value between 0 and 1", l.Id)
+	}
+
+	return nil
+}
+
+// ValidateBasic validates a set of lane configurations. ValidateBasic ensures the
+// following:
+//   - Each lane is valid and has a unique id.
+//   - The lane orders start at 0 and are contiguous.
+//   - The sum of the lane max block space percentages is less than or equal to 1.
+//   - Only one lane can have unlimited (zero) max block space.
+//   - There is no unused block space.
+//
+// An empty set of lanes is valid.
+func (l Lanes) ValidateBasic() error {
+	if len(l) == 0 {
+		return nil
+	}
+
+	sum := math.LegacyZeroDec()
+	seenZeroMaxBlockSpace := false
+	seenIDs := make(map[string]struct{}, len(l))
+	seenOrders := make(map[uint64]struct{}, len(l))
+
+	for _, lane := range l {
+		if err := lane.ValidateBasic(); err != nil {
+			return err
+		}
+
+		if _, seen := seenIDs[lane.Id]; seen {
+			return fmt.Errorf("duplicate lane id %s", lane.Id)
+		}
+
+		if lane.Order >= uint64(len(l)) {
+			return fmt.Errorf("order of lane %s must be less than the number of lanes (%d), got %d", lane.Id, len(l), lane.Order)
+		}
+
+		if _, seen := seenOrders[lane.Order]; seen {
+			return fmt.Errorf("duplicate lane order %d", lane.Order)
+		}
+
+		if seenZeroMaxBlockSpace && lane.MaxBlockSpace.IsZero() {
+			return fmt.Errorf("only one lane can have unlimited max block space")
+		} else if lane.MaxBlockSpace.IsZero() {
+			seenZeroMaxBlockSpace = true
+		}
+
+		sum = sum.Add(lane.MaxBlockSpace)
+		seenIDs[lane.Id] = struct{}{}
+		seenOrders[lane.Order] = struct{}{}
+	}
+
+	switch {
+	// Ensure that the sum of the lane max block space percentages is less than
+	// or equal to 1.
+	case sum.GT(math.LegacyOneDec()):
+		return fmt.Errorf("sum of lane max block space percentages must be less than or equal to 1, got %s", sum)
+	// Ensure that there is no unused block space.
+	case sum.LT(math.LegacyOneDec()) && !seenZeroMaxBlockSpace:
+		return fmt.Errorf("sum of total block space percentages will be less than 1")
+	}
+
+	return nil
+}
+
+// ValidateRegistered ensures that the lanes match the lanes registered in the application's
+// mempool, identified by the given ids: every lane must be registered and every registered
+// lane must be configured. Otherwise, the lanes stored on-chain would be ignored when
+// proposals are built. An empty set of lanes is valid.
+func (l Lanes) ValidateRegistered(ids []string) error {
+	if len(l) == 0 {
+		return nil
+	}
+
+	if len(l) != len(ids) {
+		return fmt.Errorf("expected %d lanes matching the registered lanes, got %d", len(ids), len(l))
+	}
+
+	for _, id := range ids {
+		if _, found := l.GetLane(id); !found {
+			return fmt.Errorf("registered lane %s is not configured", id)
+		}
+	}
+
+	return nil
+}
+
+// Sort sorts the lanes by their order in place and returns them.
+func (l Lanes) Sort() Lanes {
+	sort.SliceStable(l, func(i, j int) bool {
+		return l[i].Order < l[j].Order
+	})
+
+	return l
+}
+
+// GetLane returns the lane with the given id and whether it was found.
+func (l Lanes) GetLane(id string) (Lane, bool) {
+	for _, lane := range l {
+		if lane.Id == id {
+			return lane, true
+		}
+	}
+
+	return Lane{}, false
+}
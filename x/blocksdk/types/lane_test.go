@@ -0,0 +1,113 @@
+package types_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+
+	"github.com/skip-mev/block-sdk/v2/x/blocksdk/types"
+)
+
+func TestLanesValidateBasic(t *testing.T) {
+	cases := []struct {
+		description string
+		lanes       types.Lanes
+		expectPass  bool
+	}{
+		{
+			description: "empty lanes are valid",
+			lanes:       types.Lanes{},
+			expectPass:  true,
+		},
+		{
+			description: "valid lanes that sum to 1",
+			lanes: types.Lanes{
+				types.NewLane("mev", math.LegacyMustNewDecFromStr("0.5"), 0),
+				types.NewLane("default", math.LegacyMustNewDecFromStr("0.5"), 1),
+			},
+			expectPass: true,
+		},
+		{
+			description: "valid lanes with an unlimited lane",
+			lanes: types.Lanes{
+				types.NewLane("mev", math.LegacyMustNewDecFromStr("0.2"), 1),
+				types.NewLane("default", math.LegacyZeroDec(), 0),
+			},
+			expectPass: true,
+		},
+		{
+			description: "empty lane id",
+			lanes: types.Lanes{
+				types.NewLane("", math.LegacyOneDec(), 0),
+			},
+			expectPass: false,
+		},
+		{
+			description: "nil max block space",
+			lanes: types.Lanes{
+				{Id: "mev"},
+			},
+			expectPass: false,
+		},
+		{
+			description: "duplicate lane ids",
+			lanes: types.Lanes{
+				types.NewLane("mev", math.LegacyMustNewDecFromStr("0.5"), 0),
+				types.NewLane("mev", math.LegacyMustNewDecFromStr("0.5"), 1),
+			},
+			expectPass: false,
+		},
+		{
+			description: "duplicate lane orders",
+			lanes: types.Lanes{
+				types.NewLane("mev", math.LegacyMustNewDecFromStr("0.5"), 0),
+				types.NewLane("default", math.LegacyMustNewDecFromStr("0.5"), 0),
+			},
+			expectPass: false,
+		},
+		{
+			description: "order out of range",
+			lanes: types.Lanes{
+				types.NewLane("mev", math.LegacyMustNewDecFromStr("0.5"), 0),
+				types.NewLane("default", math.LegacyMustNewDecFromStr("0.5"), 2),
+			},
+			expectPass: false,
+		},
+		{
+			description: "more than one unlimited lane",
+			lanes: types.Lanes{
+				types.NewLane("mev", math.LegacyZeroDec(), 0),
+				types.NewLane("default", math.LegacyZeroDec(), 1),
+			},
+			expectPass: false,
+		},
+		{
+			description: "sum greater than 1",
+			lanes: types.Lanes{
+				types.NewLane("mev", math.LegacyMustNewDecFromStr("0.6"), 0),
+				types.NewLane("default", math.LegacyMustNewDecFromStr("0.5"), 1),
+			},
+			expectPass: false,
+		},
+		{
+			description: "unused block space",
+			lanes: types.Lanes{
+				types.NewLane("mev", math.LegacyMustNewDecFromStr("0.2"), 0),
+				types.NewLane("default", math.LegacyMustNewDecFromStr("0.5"), 1),
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.description, func(t *testing.T) {
+			err := tc.lanes.ValidateBasic()
+			if tc.expectPass && err != nil {
+				t.Errorf("expected lanes to be valid, got %s", err)
+			}
+			if !tc.expectPass && err == nil {
+				t.Errorf("expected lanes to be invalid")
+			}
+		})
+	}
+}
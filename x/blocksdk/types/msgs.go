@@ -0,0 +1,37 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ sdk.Msg = &MsgUpdateLane{}
+
+// NewMsgUpdateLane returns a new MsgUpdateLane message.
+func NewMsgUpdateLane(authority sdk.AccAddress, lane Lane) *MsgUpdateLane {
+	return &MsgUpdateLane{
+		Authority: authority.String(),
+		Lane:      lane,
+	}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgUpdateLane) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgUpdateLane message.
+func (m MsgUpdateLane) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m MsgUpdateLane) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errors.Wrap(err, "invalid authority address")
+	}
+
+	return m.Lane.ValidateBasic()
+}
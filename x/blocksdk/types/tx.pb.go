@@ -0,0 +1,600 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: sdk/blocksdk/v1/tx.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgUpdateLane defines a request type for updating the configuration of a
+// lane.
+type MsgUpdateLane struct {
+	// authority is the address of the account that is authorized to update the
+	// x/blocksdk lanes.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// lane is the new configuration of the lane. The lane is identified by its
+	// id, which must already exist in state.
+	Lane Lane `protobuf:"bytes,2,opt,name=lane,proto3" json:"lane"`
+}
+
+func (m *MsgUpdateLane) Reset()         { *m = MsgUpdateLane{} }
+func (m *MsgUpdateLane) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateLane) ProtoMessage()    {}
+func (*MsgUpdateLane) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc6ccd9f56097321, []int{0}
+}
+func (m *MsgUpdateLane) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateLane) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateLane.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateLane) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateLane.Merge(m, src)
+}
+func (m *MsgUpdateLane) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateLane) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateLane.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateLane proto.InternalMessageInfo
+
+func (m *MsgUpdateLane) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateLane) GetLane() Lane {
+	if m != nil {
+		return m.Lane
+	}
+	return Lane{}
+}
+
+// MsgUpdateLaneResponse defines the Msg/UpdateLane response type.
+type MsgUpdateLaneResponse struct {
+}
+
+func (m *MsgUpdateLaneResponse) Reset()         { *m = MsgUpdateLaneResponse{} }
+func (m *MsgUpdateLaneResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateLaneResponse) ProtoMessage()    {}
+func (*MsgUpdateLaneResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc6ccd9f56097321, []int{1}
+}
+func (m *MsgUpdateLaneResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateLaneResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateLaneResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateLaneResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateLaneResponse.Merge(m, src)
+}
+func (m *MsgUpdateLaneResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateLaneResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateLaneResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateLaneResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgUpdateLane)(nil), "sdk.blocksdk.v1.MsgUpdateLane")
+	proto.RegisterType((*MsgUpdateLaneResponse)(nil), "sdk.blocksdk.v1.MsgUpdateLaneResponse")
+}
+
+func init() { proto.RegisterFile("sdk/blocksdk/v1/tx.proto", fileDescriptor_fc6ccd9f56097321) }
+
+var fileDescriptor_fc6ccd9f56097321 = []byte{
+	// 351 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x51, 0x3f, 0x4f, 0xc2, 0x40,
+	0x14, 0xef, 0x29, 0x9a, 0x70, 0xc6, 0x18, 0x1b, 0x08, 0xb5, 0xc3, 0x41, 0x18, 0x0c, 0x21, 0xa1,
+	0x17, 0x30, 0x31, 0xd1, 0x4d, 0x26, 0x07, 0x59, 0x50, 0x17, 0x17, 0xd3, 0xd2, 0xcb, 0xd1, 0x94,
+	0xf6, 0x9a, 0xbe, 0x83, 0xc0, 0x66, 0x1c, 0x9d, 0xfc, 0x08, 0x7e, 0x04, 0x12, 0xfd, 0x10, 0x8c,
+	0xc4, 0xc9, 0xc9, 0x18, 0x18, 0xf0, 0x63, 0x98, 0xb6, 0x20, 0x82, 0xc6, 0xe5, 0xf2, 0xde, 0xfb,
+	0xfd, 0xb9, 0xf7, 0xbb, 0xc3, 0x1a, 0xd8, 0x2e, 0xb5, 0x3a, 0xa2, 0xe5, 0x46, 0x45, 0xaf, 0x4a,
+	0x65, 0xdf, 0x08, 0x42, 0x21, 0x85, 0xba, 0x07, 0xb6, 0x6b, 0x2c, 0x10, 0xa3, 0x57, 0xd5, 0x33,
+	0x5c, 0x70, 0x11, 0x63, 0x34, 0xaa, 0x12, 0x9a, 0x4e, 0xd6, 0x0d, 0xbe, 0x25, 0x09, 0x7e, 0xd0,
+	0x12, 0xe0, 0x09, 0xb8, 0x4d, 0x84, 0x49, 0x33, 0x87, 0x72, 0x49, 0x47, 0x3d, 0xe0, 0x91, 0xd0,
+	0x03, 0x3e, 0x07, 0xf6, 0x4d, 0xcf, 0xf1, 0x05, 0x8d, 0xcf, 0x64, 0x54, 0x7c, 0x46, 0x78, 0xb7,
+	0x01, 0xfc, 0x3a, 0xb0, 0x4d, 0xc9, 0x2e, 0x4c, 0x9f, 0xa9, 0xc7, 0x38, 0x6d, 0x76, 0x65, 0x5b,
+	0x84, 0x8e, 0x1c, 0x68, 0xa8, 0x80, 0x4a, 0xe9, 0xba, 0xf6, 0xfa, 0x52, 0xc9, 0xcc, 0xaf, 0x38,
+	0xb3, 0xed, 0x90, 0x01, 0x5c, 0xca, 0xd0, 0xf1, 0x79, 0x73, 0x49, 0x55, 0x29, 0x4e, 0x75, 0x4c,
+	0x9f, 0x69, 0x1b, 0x05, 0x54, 0xda, 0xa9, 0x65, 0x8d, 0xb5, 0x98, 0x46, 0x64, 0x5e, 0x4f, 0x8d,
+	0xde, 0xf3, 0x4a, 0x33, 0x26, 0x9e, 0x9e, 0x7c, 0x3e, 0xe5, 0x95, 0xfb, 0xd9, 0xb0, 0xbc, 0x34,
+	0x79, 0x98, 0x0d, 0xcb, 0xc5, 0x58, 0x54, 0x89, 0x52, 0xf7, 0x97, 0x0f, 0xb0, 0xb2, 0x63, 0x31,
+	0x87, 0xb3, 0x2b, 0x83, 0x26, 0x83, 0x40, 0xf8, 0xc0, 0x6a, 0x16, 0xde, 0x6c, 0x00, 0x57, 0xaf,
+	0x30, 0xfe, 0x91, 0x88, 0xfc, 0xda, 0x65, 0x45, 0xac, 0x1f, 0xfe, 0x8f, 0x2f, 0xcc, 0xf5, 0xad,
+	0xbb, 0xd9, 0xb0, 0x8c, 0xea, 0xe7, 0xa3, 0x09, 0x41, 0xe3, 0x09, 0x41, 0x1f, 0x13, 0x82, 0x1e,
+	0xa7, 0x44, 0x19, 0x4f, 0x89, 0xf2, 0x36, 0x25, 0xca, 0x8d, 0xc1, 0x1d, 0xd9, 0xee, 0x5a, 0x46,
+	0x4b, 0x78, 0x14, 0x5c, 0x27, 0xa8, 0x78, 0xac, 0x47, 0xff, 0x8c, 0x23, 0x07, 0x01, 0x03, 0x6b,
+	0x3b, 0xfe, 0x83, 0xa3, 0xaf, 0x01, 0x00, 0x95, 0x95, 0xbb, 0xc3, 0x2d, 0x02, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// UpdateLane defines a governance operation for updating the on-chain
+	// configuration of a lane. The authority is hard-coded to the x/gov module
+	// account.
+	UpdateLane(ctx context.Context, in *MsgUpdateLane, opts ...grpc.CallOption) (*MsgUpdateLaneResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) UpdateLane(ctx context.Context, in *MsgUpdateLane, opts ...grpc.CallOption) (*MsgUpdateLaneResponse, error) {
+	out := new(MsgUpdateLaneResponse)
+	err := c.cc.Invoke(ctx, "/sdk.blocksdk.v1.Msg/UpdateLane", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// UpdateLane defines a governance operation for updating the on-chain
+	// configuration of a lane. The authority is hard-coded to the x/gov module
+	// account.
+	UpdateLane(context.Context, *MsgUpdateLane) (*MsgUpdateLaneResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) UpdateLane(ctx context.Context, req *MsgUpdateLane) (*MsgUpdateLaneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateLane not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_UpdateLane_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateLane)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateLane(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sdk.blocksdk.v1.Msg/UpdateLane",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateLane(ctx, req.(*MsgUpdateLane))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "sdk.blocksdk.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateLane",
+			Handler:    _Msg_UpdateLane_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "sdk/blocksdk/v1/tx.proto",
+}
+
+func (m *MsgUpdateLane) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateLane) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateLane) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Lane.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateLaneResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateLaneResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateLaneResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateLane) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Lane.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateLaneResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateLane) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateLane: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateLane: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lane", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Lane.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateLaneResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateLaneResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateLaneResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)